@@ -0,0 +1,148 @@
+package jisx0208
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	aozoraOpen  = "※［＃"
+	aozoraClose = "］"
+
+	// AozoraGeta is the description used when no description of a gaiji is given.
+	AozoraGeta = "〓"
+)
+
+// ToAozora returns a copy of the string s with each rune outside JIS X 0208 written in
+// Aozora Bunko gaiji notation, e.g. ※［＃「髟／友」、U+9AEE］ or ※［＃「〓」、第3水準1-85-22］.
+// JIS X 0213 characters are noted by men-ku-ten, others by Unicode code point.
+// The description in 「」 is given by describe, which may be nil; if it returns an empty string,
+// AozoraGeta is used. Invalid UTF-8 bytes are copied as is.
+func ToAozora(s string, describe func(r rune) string) string {
+	return toAozora(s, describe, Is)
+}
+
+// ToAozora returns a copy of the string s with each invalid rune written in Aozora Bunko gaiji notation.
+// See the package function ToAozora for details.
+func (d *Discriminator) ToAozora(s string, describe func(r rune) string) string {
+	return toAozora(s, describe, d.Is)
+}
+
+// FromAozora returns a copy of the string s with each Aozora Bunko gaiji notation that
+// refers to a Unicode code point or a JIS X 0213 men-ku-ten replaced by the character.
+// Notations that cannot be resolved are left as is.
+func FromAozora(s string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, aozoraOpen)
+		if i < 0 {
+			break
+		}
+		j := strings.Index(s[i+len(aozoraOpen):], aozoraClose)
+		if j < 0 {
+			break
+		}
+		body := s[i+len(aozoraOpen) : i+len(aozoraOpen)+j]
+		end := i + len(aozoraOpen) + j + len(aozoraClose)
+		if r, ok := parseAozora(body); ok {
+			b.WriteString(s[:i])
+			b.WriteRune(r)
+		} else {
+			b.WriteString(s[:end])
+		}
+		s = s[end:]
+	}
+	if b.Len() == 0 {
+		return s
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func toAozora(s string, describe func(r rune) string, is func(rune) bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		r, wid := utf8.DecodeRuneInString(s[i:])
+		if (r == utf8.RuneError && wid == 1) || is(r) {
+			b.WriteString(s[i : i+wid])
+			i += wid
+			continue
+		}
+		desc := ""
+		if describe != nil {
+			desc = describe(r)
+		}
+		if desc == "" {
+			desc = AozoraGeta
+		}
+		b.WriteString(aozoraOpen)
+		b.WriteString("「")
+		b.WriteString(desc)
+		b.WriteString("」、")
+		b.WriteString(aozoraCode(r))
+		b.WriteString(aozoraClose)
+		i += wid
+	}
+	return b.String()
+}
+
+// aozoraCode returns the code part of the notation: 第3水準1-85-22 or 第4水準2-1-1 for JIS X 0213 kanji,
+// 1-2-22 for JIS X 0213 non-kanji, U+9AD9 otherwise.
+func aozoraCode(r rune) string {
+	c, ok := JISX0213(r)
+	if !ok {
+		return fmt.Sprintf("U+%04X", r)
+	}
+	if lv := c.Level(); lv != 0 {
+		return fmt.Sprintf("第%d水準%v", lv, c)
+	}
+	return c.String()
+}
+
+// parseAozora returns the character referred by the body of the gaiji notation.
+func parseAozora(body string) (rune, bool) {
+	if strings.HasPrefix(body, "「") {
+		i := strings.LastIndex(body, "」")
+		if i < 0 {
+			return 0, false
+		}
+		body = body[i+len("」"):]
+	}
+	for _, v := range strings.Split(body, "、") {
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, "U+") || strings.HasPrefix(v, "u+") {
+			n, err := strconv.ParseUint(v[2:], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(n)) {
+				return 0, false
+			}
+			return rune(n), true
+		}
+		v = strings.TrimPrefix(v, "第3水準")
+		v = strings.TrimPrefix(v, "第4水準")
+		if c, ok := parseMenKuTen(v); ok {
+			return c.Rune()
+		}
+	}
+	return 0, false
+}
+
+func parseMenKuTen(s string) (MenKuTen, bool) {
+	f := strings.Split(s, "-")
+	if len(f) != 3 {
+		return MenKuTen{}, false
+	}
+	var n [3]int
+	for i, v := range f {
+		x, err := strconv.Atoi(v)
+		if err != nil || x < 1 || x > 94 {
+			return MenKuTen{}, false
+		}
+		n[i] = x
+	}
+	if n[0] > 2 {
+		return MenKuTen{}, false
+	}
+	return MenKuTen{Men: n[0], Ku: n[1], Ten: n[2]}, true
+}
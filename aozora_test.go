@@ -0,0 +1,140 @@
+package jisx0208
+
+import (
+	"testing"
+)
+
+func TestToAozora(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		describe func(r rune) string
+		want     string
+	}{
+		{
+			name: "JIS X 0208",
+			s:    "人魚は、南の方の海にばかり棲んでいるのではありません。",
+			want: "人魚は、南の方の海にばかり棲んでいるのではありません。",
+		},
+		{
+			name: "JIS X 0213 level 3",
+			s:    "𠮟る",
+			want: "※［＃「〓」、第3水準1-47-52］る",
+		},
+		{
+			name: "JIS X 0213 level 4",
+			s:    "胅",
+			want: "※［＃「〓」、第4水準2-85-22］",
+		},
+		{
+			name: "JIS X 0213 non-kanji",
+			s:    "〻",
+			want: "※［＃「〓」、1-2-22］",
+		},
+		{
+			name: "unicode",
+			s:    "髙島屋",
+			want: "※［＃「〓」、U+9AD9］島屋",
+		},
+		{
+			name: "describe",
+			s:    "髙島屋",
+			describe: func(r rune) string {
+				if r == '髙' {
+					return "はしごだか"
+				}
+				return ""
+			},
+			want: "※［＃「はしごだか」、U+9AD9］島屋",
+		},
+		{
+			name: "invalid utf8",
+			s:    "abc\xFF",
+			want: "abc\xFF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToAozora(tt.s, tt.describe); got != tt.want {
+				t.Errorf("ToAozora() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscriminator_ToAozora(t *testing.T) {
+	d := NewDiscriminator(Allow('髙'), Disallow('魚'))
+	want := "人※［＃「〓」、U+9B5A］は髙島屋※［＃「〓」、第3水準1-47-52］"
+	if got := d.ToAozora("人魚は髙島屋𠮟", nil); got != want {
+		t.Errorf("ToAozora() = %v, want %v", got, want)
+	}
+}
+
+func TestFromAozora(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "unicode",
+			s:    "※［＃「髟／友」、U+9AEE、1-2］",
+			want: "髮",
+		},
+		{
+			name: "level 3",
+			s:    "※［＃「口＋七」、第3水準1-47-52］る",
+			want: "𠮟る",
+		},
+		{
+			name: "level 4",
+			s:    "※［＃「月＋失」、第4水準2-85-22］",
+			want: "胅",
+		},
+		{
+			name: "non-kanji",
+			s:    "※［＃二の字点、1-2-22］",
+			want: "〻",
+		},
+		{
+			name: "description with comma",
+			s:    "※［＃「にんべん、尚」、U+5018］",
+			want: "倘",
+		},
+		{
+			name: "unresolved",
+			s:    "※［＃「てへん＋劣」、159-上-8］です",
+			want: "※［＃「てへん＋劣」、159-上-8］です",
+		},
+		{
+			name: "unclosed",
+			s:    "a※［＃「〓」、U+9AD9",
+			want: "a※［＃「〓」、U+9AD9",
+		},
+		{
+			name: "not gaiji",
+			s:    "［＃ここから２字下げ］本文",
+			want: "［＃ここから２字下げ］本文",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromAozora(tt.s); got != tt.want {
+				t.Errorf("FromAozora() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAozora_RoundTrip(t *testing.T) {
+	s := "髙﨑閒度德鷗彅栁琰炅璉㟢妤𠮷🙅 人魚は、南の方の海にばかり棲んでいるのではありません。"
+	enc := ToAozora(s, nil)
+	for _, r := range enc {
+		if !Is(r) {
+			t.Fatalf("ToAozora() = %v, contains %c", enc, r)
+		}
+	}
+	if got := FromAozora(enc); got != s {
+		t.Errorf("FromAozora(ToAozora()) = %v, want %v", got, s)
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runCheck reports the characters of the files that are not valid, without changing them.
+// Directories are checked recursively. The positions of the violations are in the bytes of
+// the files, even if they are in another encoding than UTF-8. It exits with status 1 if it finds any violations,
+// and with status 2 if some files cannot be read.
+//
+//	jisx0208 check [-q] [-from encoding] [-level 1] [-allow runes] [-include glob] [file|dir|glob ...]
+func runCheck(args []string) error {
+	var (
+		p   policy
+		in  inputFlags
+		enc encodingFlags
+	)
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	quiet := fs.Bool("q", false, "print nothing and only exit with the status")
+	format := fs.String("format", "text", "output `format`: text, json, jsonl or sarif")
+	p.register(fs)
+	in.register(fs)
+	enc.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 check [-q] [-format text|json|jsonl|sarif] [flags] [file|dir|glob ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	from, _, err := enc.encodings()
+	if err != nil {
+		return err
+	}
+	var r reporter = discardReporter{}
+	if !*quiet {
+		if r, err = newReporter(os.Stdout, *format); err != nil {
+			return err
+		}
+	}
+	inputs, err := collectInputs(fs.Args(), in.filter)
+	if err != nil {
+		return err
+	}
+	type result struct {
+		v   []violation
+		err error
+	}
+	var total, failed int
+	parallel(len(inputs), in.workers, func(i int) result {
+		b, err := readFile(inputs[i].name)
+		if err != nil || inputs[i].walked && isBinary(b) {
+			return result{err: err}
+		}
+		return result{v: checkEncoded(displayName(inputs[i].name), b, from, d)}
+	}, func(i int, res result) bool {
+		if res.err != nil {
+			failed++
+			fmt.Fprintln(os.Stderr, errorf(inputs[i].name, res.err))
+			return true
+		}
+		total += len(res.v)
+		if err = r.report(res.v); err != nil {
+			return false
+		}
+		return !*quiet || total == 0
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.close(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return &exitError{code: 2, err: fmt.Errorf("%d files could not be read", failed)}
+	}
+	if total == 0 {
+		return nil
+	}
+	if *quiet {
+		return &exitError{code: 1}
+	}
+	return violationsFound(total)
+}
+
+// violation is a character that is not valid, or a run of invalid UTF-8 bytes.
+type violation struct {
+	path string
+	// line and column are 1-based; column counts characters.
+	line, column int
+	// width is the number of columns.
+	width int
+	// offset and size are the byte offset and the byte length in the file.
+	offset, size int
+	// rune is the character, or utf8.RuneError for invalid bytes.
+	rune rune
+	// bytes is the invalid bytes in the charset.
+	bytes   []byte
+	charset string
+	// explanation is the reason why the character is not valid.
+	explanation jisx0208.Explanation
+	// encoding is the encoding detected for the file that is not UTF-8, in which case
+	// the violation is of the whole file and line is 0.
+	encoding string
+}
+
+// String returns the violation in the form of `file:line:col: U+9AD9 '髙' not in JIS X 0208`.
+func (v violation) String() string {
+	if v.encoding != "" {
+		return fmt.Sprintf("%s: %s", v.path, v.message())
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", v.path, v.line, v.column, v.message())
+}
+
+// reason returns the reason why the character is not valid, e.g. "IBM extended character".
+func (v violation) reason() string {
+	if v.encoding != "" {
+		return "not UTF-8"
+	}
+	if v.bytes != nil {
+		return "invalid " + v.charset
+	}
+	return v.explanation.Reason.String()
+}
+
+func (v violation) message() string {
+	switch {
+	case v.encoding != "":
+		return fmt.Sprintf("not UTF-8, looks like %s", v.encoding)
+	case v.bytes != nil:
+		return fmt.Sprintf("invalid %s bytes % X", v.charset, v.bytes)
+	case v.explanation.Reason == jisx0208.ReasonDisallowed:
+		return fmt.Sprintf("%U %q disallowed", v.rune, v.rune)
+	}
+	return fmt.Sprintf("%U %q not in JIS X 0208", v.rune, v.rune)
+}
+
+// check returns the violations in b, as ToValid of the discriminator replaces them:
+// each invalid character and each run of invalid UTF-8 bytes. If b is not UTF-8 but is valid
+// in another encoding, it returns a single violation of the file instead.
+func check(path string, b []byte, d *jisx0208.Discriminator) []violation {
+	if !utf8.Valid(b) {
+		if g := jisx0208.Detect(b)[0]; g.Encoding != "UTF-8" && g.Invalid == 0 {
+			return []violation{{path: path, encoding: g.Encoding}}
+		}
+	}
+	var (
+		ret          []violation
+		line, column = 1, 1
+	)
+	for i := 0; i < len(b); {
+		c := b[i]
+		if c < utf8.RuneSelf {
+			if c == '\n' {
+				line, column = line+1, 1
+			} else {
+				column++
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(b[i:])
+		if size == 1 {
+			start := i
+			for i < len(b) && b[i] >= utf8.RuneSelf {
+				if _, size := utf8.DecodeRune(b[i:]); size != 1 {
+					break
+				}
+				i++
+			}
+			ret = append(ret, violation{path: path, line: line, column: column, width: i - start, offset: start, size: i - start, rune: utf8.RuneError, bytes: b[start:i], charset: "UTF-8"})
+			column += i - start
+			continue
+		}
+		if !d.Is(r) {
+			ret = append(ret, violation{path: path, line: line, column: column, width: 1, offset: i, size: size, rune: r, explanation: d.Explain(r)})
+		}
+		column++
+		i += size
+	}
+	return ret
+}
+
+// checkEncoded returns the violations in b in the encoding, which is detected if it is nil,
+// with the positions in b. The bytes that cannot be decoded are reported as invalid bytes.
+func checkEncoded(path string, b []byte, e *textEncoding, d *jisx0208.Discriminator) []violation {
+	if e == nil {
+		e = detectEncoding(b)
+	}
+	if e == encodingUTF8 {
+		return check(path, b, d)
+	}
+	text, spans := e.decode(b)
+	ret := check(path, text, d)
+	for i, v := range ret {
+		start, end := spans[v.offset].start, spans[v.offset+v.size-1].end
+		ret[i].offset, ret[i].size = start, end-start
+		if v.rune == utf8.RuneError {
+			ret[i].bytes, ret[i].charset, ret[i].explanation = b[start:end], e.name, jisx0208.Explanation{}
+		}
+	}
+	return ret
+}
+
+// stdin is the name of the standard input in the arguments and in the reports.
+const stdin = "-"
+
+// expandArgs returns the files of the arguments with the glob patterns expanded,
+// or the standard input if no arguments are given.
+func expandArgs(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return []string{stdin}, nil
+	}
+	var ret []string
+	for _, v := range args {
+		if !strings.ContainsAny(v, "*?[") {
+			ret = append(ret, v)
+			continue
+		}
+		m, err := filepath.Glob(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v, err)
+		}
+		if len(m) == 0 {
+			return nil, fmt.Errorf("%s: no matching files", v)
+		}
+		ret = append(ret, m...)
+	}
+	return ret, nil
+}
+
+// displayName returns the name of the file in the reports.
+func displayName(name string) string {
+	if name == stdin {
+		return "<stdin>"
+	}
+	return name
+}
+
+// readFile returns the content of the file, or of the standard input.
+func readFile(name string) ([]byte, error) {
+	if name == stdin {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(name)
+}
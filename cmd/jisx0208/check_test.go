@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		d    *jisx0208.Discriminator
+		want []string
+	}{
+		{
+			name: "valid",
+			b:    "abc\nあいう\n",
+			d:    jisx0208.NewDiscriminator(),
+			want: nil,
+		},
+		{
+			name: "not in JIS X 0208",
+			b:    "abc\r\n髙橋 ①\n",
+			d:    jisx0208.NewDiscriminator(),
+			want: []string{
+				"a.txt:2:1: U+9AD9 '髙' not in JIS X 0208",
+				"a.txt:2:4: U+2460 '①' not in JIS X 0208",
+			},
+		},
+		{
+			name: "invalid UTF-8",
+			b:    "a\xff\xfeb\xff",
+			d:    jisx0208.NewDiscriminator(),
+			want: []string{
+				"a.txt:1:2: invalid UTF-8 bytes FF FE",
+				"a.txt:1:5: invalid UTF-8 bytes FF",
+			},
+		},
+		{
+			name: "disallowed",
+			b:    "あい髙",
+			d:    jisx0208.NewDiscriminator(jisx0208.Allow('髙'), jisx0208.Disallow('い')),
+			want: []string{
+				"a.txt:1:2: U+3044 'い' disallowed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range check("a.txt", []byte(tt.b), tt.d) {
+				got = append(got, v.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandArgs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt", "c.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	got, err := expandArgs([]string{filepath.Join(dir, "*.txt"), "literal"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt"), "literal"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandArgs() = %q, want %q", got, want)
+	}
+	if got, _ := expandArgs(nil); !reflect.DeepEqual(got, []string{stdin}) {
+		t.Errorf("expandArgs(nil) = %q, want %q", got, []string{stdin})
+	}
+	if _, err := expandArgs([]string{filepath.Join(dir, "*.go")}); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestViolationsFound(t *testing.T) {
+	for n, want := range map[int]string{1: "1 violation found", 2: "2 violations found"} {
+		if got := violationsFound(n).Error(); got != want {
+			t.Errorf("violationsFound(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of lines of context in unified diffs.
+const diffContext = 3
+
+// edit is a line of an edit script: ' ' for an equal line, '-' for a deletion and '+' for an insertion.
+type edit struct {
+	op   byte
+	line string
+}
+
+// unifiedDiff returns the unified diff from a to b, or "" if they are equal.
+func unifiedDiff(oldName, newName string, a, b []byte) string {
+	if string(a) == string(b) {
+		return ""
+	}
+	var (
+		edits = diffLines(splitLines(string(a)), splitLines(string(b)))
+		ret   strings.Builder
+	)
+	fmt.Fprintf(&ret, "--- %s\n+++ %s\n", oldName, newName)
+	// ai and bi are the 0-based line numbers of a and b at each edit
+	ai, bi := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		ai[i+1], bi[i+1] = ai[i], bi[i]
+		if e.op != '+' {
+			ai[i+1]++
+		}
+		if e.op != '-' {
+			bi[i+1]++
+		}
+	}
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			i++
+			continue
+		}
+		// a hunk extends while the equal lines between the changes are at most twice the context
+		start, end := i, i
+		for j := i; j < len(edits); j++ {
+			if edits[j].op != ' ' {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		if start -= diffContext; start < 0 {
+			start = 0
+		}
+		if end += diffContext; end > len(edits) {
+			end = len(edits)
+		}
+		fmt.Fprintf(&ret, "@@ -%s +%s @@\n", hunkRange(ai[start], ai[end]-ai[start]), hunkRange(bi[start], bi[end]-bi[start]))
+		for _, e := range edits[start:end] {
+			ret.WriteByte(e.op)
+			ret.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				ret.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return ret.String()
+}
+
+// hunkRange returns the range of a hunk from the 0-based line start.
+func hunkRange(start, n int) string {
+	switch n {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+// splitLines returns the lines of s, each with its line break.
+func splitLines(s string) []string {
+	var ret []string
+	for s != "" {
+		i := strings.IndexByte(s, '\n') + 1
+		if i == 0 {
+			i = len(s)
+		}
+		ret = append(ret, s[:i])
+		s = s[i:]
+	}
+	return ret
+}
+
+// diffLines returns the shortest edit script from a to b by the Myers algorithm.
+// Lines of the same number, which the fixes of characters keep, are compared pairwise.
+func diffLines(a, b []string) []edit {
+	var ret []edit
+	if len(a) == len(b) {
+		for i := range a {
+			if a[i] == b[i] {
+				ret = append(ret, edit{op: ' ', line: a[i]})
+			} else {
+				ret = append(ret, edit{op: '-', line: a[i]}, edit{op: '+', line: b[i]})
+			}
+		}
+		return ret
+	}
+	// the common prefix and suffix are out of the search
+	p := 0
+	for p < len(a) && p < len(b) && a[p] == b[p] {
+		ret = append(ret, edit{op: ' ', line: a[p]})
+		p++
+	}
+	s := 0
+	for s < len(a)-p && s < len(b)-p && a[len(a)-1-s] == b[len(b)-1-s] {
+		s++
+	}
+	ret = append(ret, myers(a[p:len(a)-s], b[p:len(b)-s])...)
+	for _, v := range a[len(a)-s:] {
+		ret = append(ret, edit{op: ' ', line: v})
+	}
+	return ret
+}
+
+func myers(a, b []string) []edit {
+	var (
+		n, m  = len(a), len(b)
+		off   = n + m + 1
+		v     = make([]int, 2*off+1)
+		trace [][]int
+		d     int
+	)
+search:
+	for d = 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[off+k-1] < v[off+k+1] {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+	var ret []edit
+	x, y := n, m
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		prev := k - 1
+		if k == -d || k != d && v[off+k-1] < v[off+k+1] {
+			prev = k + 1
+		}
+		px := v[off+prev]
+		py := px - prev
+		for x > px && y > py {
+			ret = append(ret, edit{op: ' ', line: a[x-1]})
+			x, y = x-1, y-1
+		}
+		if x == px {
+			ret = append(ret, edit{op: '+', line: b[y-1]})
+			y--
+		} else {
+			ret = append(ret, edit{op: '-', line: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		ret = append(ret, edit{op: ' ', line: a[x-1]})
+		x, y = x-1, y-1
+	}
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+	return ret
+}
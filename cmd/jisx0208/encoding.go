@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// textEncoding is an encoding of the input and the output.
+type textEncoding struct {
+	name string
+	// decoding is the lenient decoder, which decodes vendor extensions and halfwidth katakana
+	// to report them; nil for UTF-8.
+	decoding encoding.Encoding
+	// encoding is the encoder of JIS X 0208; nil for UTF-8.
+	encoding *jisx0208.Encoding
+}
+
+var (
+	encodingUTF8      = &textEncoding{name: "UTF-8"}
+	encodingShiftJIS  = &textEncoding{name: "Shift_JIS", decoding: japanese.ShiftJIS, encoding: jisx0208.ShiftJIS}
+	encodingEUCJP     = &textEncoding{name: "EUC-JP", decoding: japanese.EUCJP, encoding: jisx0208.EUCJP}
+	encodingISO2022JP = &textEncoding{name: "ISO-2022-JP", decoding: iso2022jp{japanese.ISO2022JP}, encoding: jisx0208.ISO2022JP}
+)
+
+// iso2022jp is the lenient decoding of ISO-2022-JP that skips the announcer ESC & @ of
+// JIS X 0208-1990, which jisx0208.ISO2022JP writes before 凜 and 熙 but x/text does not know.
+type iso2022jp struct {
+	encoding.Encoding
+}
+
+// NewDecoder returns the decoder of x/text preceded by skipAnnouncer.
+func (e iso2022jp) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: transform.Chain(skipAnnouncer{}, e.Encoding.NewDecoder())}
+}
+
+// skipAnnouncer is the transformer that removes ESC & @ from the input.
+type skipAnnouncer struct {
+	transform.NopResetter
+}
+
+const announcer = "\x1b&@"
+
+// Transform implements transform.Transformer.
+func (skipAnnouncer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if src[nSrc] == announcer[0] {
+			rest := string(src[nSrc:])
+			if strings.HasPrefix(rest, announcer) {
+				nSrc += len(announcer)
+				continue
+			}
+			if !atEOF && strings.HasPrefix(announcer, rest) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+		if nDst >= len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		dst[nDst] = src[nSrc]
+		nDst++
+		nSrc++
+	}
+	return nDst, nSrc, nil
+}
+
+// encodingAuto is the name of the encoding detected from the input.
+const encodingAuto = "auto"
+
+// lookupEncoding returns the encoding of the name, or nil for auto.
+func lookupEncoding(name string) (*textEncoding, error) {
+	switch strings.ToLower(strings.ReplaceAll(name, "-", "_")) {
+	case "utf_8", "utf8":
+		return encodingUTF8, nil
+	case "shift_jis", "sjis":
+		return encodingShiftJIS, nil
+	case "euc_jp", "eucjp":
+		return encodingEUCJP, nil
+	case "iso_2022_jp", "jis":
+		return encodingISO2022JP, nil
+	case encodingAuto:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unknown encoding %q, want utf-8, shift_jis, euc-jp, iso-2022-jp or auto", name)
+}
+
+// detectEncoding returns the most likely encoding of b.
+func detectEncoding(b []byte) *textEncoding {
+	e, _ := lookupEncoding(jisx0208.Detect(b)[0].Encoding)
+	return e
+}
+
+// encodingFlags is the flags of the input and the output encodings.
+type encodingFlags struct {
+	from, to string
+}
+
+// register defines the flag of the input encoding in the flag set.
+func (f *encodingFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.from, "from", "utf-8", "input `encoding`: utf-8, shift_jis, euc-jp, iso-2022-jp or auto")
+}
+
+// registerTo defines the flag of the output encoding in the flag set, whose empty default
+// means the input encoding.
+func (f *encodingFlags) registerTo(fs *flag.FlagSet, to string) {
+	usage := "output `encoding`: utf-8, shift_jis, euc-jp or iso-2022-jp"
+	if to == "" {
+		usage += " (default the input encoding)"
+	}
+	fs.StringVar(&f.to, "to", to, usage)
+}
+
+// encodings returns the input encoding, nil for auto, and the output encoding, nil for the input encoding.
+func (f *encodingFlags) encodings() (from, to *textEncoding, err error) {
+	if from, err = lookupEncoding(f.from); err != nil {
+		return nil, nil, err
+	}
+	if f.to == "" {
+		return from, nil, nil
+	}
+	if to, err = lookupEncoding(f.to); err != nil {
+		return nil, nil, err
+	}
+	if to == nil {
+		return nil, nil, fmt.Errorf("invalid output encoding %q", f.to)
+	}
+	return from, to, nil
+}
+
+// newReader returns the reader of r decoded into UTF-8.
+func (e *textEncoding) newReader(r io.Reader) io.Reader {
+	if e.decoding == nil {
+		return r
+	}
+	return transform.NewReader(r, e.decoding.NewDecoder())
+}
+
+// newWriter returns the writer that encodes UTF-8 into the encoding, replacing the characters
+// that cannot be encoded or are invalid under the discriminator. It must be closed.
+func (e *textEncoding) newWriter(w io.Writer, d *jisx0208.Discriminator, replacement string) io.WriteCloser {
+	if e.encoding == nil {
+		return nopCloser{w}
+	}
+	return transform.NewWriter(w, e.encoding.WithReplacement(d, replacement).NewEncoder())
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// encode returns the text in UTF-8 encoded into the encoding, as newWriter does.
+func (e *textEncoding) encode(b []byte, d *jisx0208.Discriminator, replacement string) ([]byte, error) {
+	if e.encoding == nil {
+		return b, nil
+	}
+	ret, _, err := transform.Bytes(e.encoding.WithReplacement(d, replacement).NewEncoder(), b)
+	return ret, err
+}
+
+// span is the byte range of a character in the input.
+type span struct {
+	start, end int
+}
+
+// decode returns b decoded into UTF-8 with the span in b of the character of each byte of the text.
+// Bytes that cannot be decoded become U+FFFD. For UTF-8, b is returned as is with nil spans.
+func (e *textEncoding) decode(b []byte) ([]byte, []span) {
+	if e.decoding == nil {
+		return b, nil
+	}
+	var (
+		ret     = make([]byte, 0, len(b)*3/2)
+		spans   = make([]span, 0, len(b)*3/2)
+		dec     = e.decoding.NewDecoder()
+		prefix  []byte // escape sequence of the state of ISO-2022-JP
+		twoByte bool
+	)
+	for i := 0; i < len(b); {
+		var n int
+		switch e {
+		case encodingShiftJIS:
+			n = 1
+			if c := b[i]; (c >= 0x81 && c <= 0x9F || c >= 0xE0 && c <= 0xFC) && i+1 < len(b) {
+				n = 2
+			}
+		case encodingEUCJP:
+			switch c := b[i]; {
+			case c == 0x8F:
+				n = 3
+			case c == 0x8E || c >= 0xA1 && c <= 0xFE:
+				n = 2
+			default:
+				n = 1
+			}
+			if i+n > len(b) {
+				n = 1
+			}
+		case encodingISO2022JP:
+			if esc, ok := iso2022jpEscape(b[i:]); ok {
+				switch esc := string(esc); esc {
+				case announcer:
+				case "\x1b(B":
+					prefix, twoByte = nil, false
+				default:
+					prefix, twoByte = []byte(esc), esc[1] == '$'
+				}
+				i += len(esc)
+				continue
+			}
+			n = 1
+			if twoByte && b[i] > ' ' && b[i] < 0x7F && i+1 < len(b) {
+				n = 2
+			}
+		}
+		v, err := dec.Bytes(append(append([]byte(nil), prefix...), b[i:i+n]...))
+		if r, _ := utf8.DecodeRune(v); err != nil || len(v) == 0 || r == utf8.RuneError {
+			// an invalid lead byte, whose next byte is decoded on its own
+			v, n = []byte(string(utf8.RuneError)), 1
+		}
+		for range v {
+			spans = append(spans, span{start: i, end: i + n})
+		}
+		ret = append(ret, v...)
+		i += n
+	}
+	return ret, spans
+}
+
+// iso2022jpEscape returns the escape sequence at the beginning of b that switches the state of ISO-2022-JP.
+func iso2022jpEscape(b []byte) ([]byte, bool) {
+	for _, esc := range []string{"\x1b(B", "\x1b(J", "\x1b(I", "\x1b$@", "\x1b$B", announcer} {
+		if strings.HasPrefix(string(b), esc) {
+			return b[:len(esc)], true
+		}
+	}
+	return nil, false
+}
+
+// peekReader returns the reader of r in the encoding detected from the beginning of r, which
+// may be nil for auto.
+func peekReader(r io.Reader, e *textEncoding) (io.Reader, *textEncoding) {
+	if e != nil {
+		return r, e
+	}
+	br := bufio.NewReaderSize(r, 64<<10)
+	b, _ := br.Peek(64 << 10)
+	return br, detectEncoding(b)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestCheckEncoded(t *testing.T) {
+	type position struct {
+		line, column, offset, size int
+		message                    string
+	}
+	tests := []struct {
+		name     string
+		encoding *textEncoding
+		b        string
+		want     []position
+	}{
+		{
+			name:     "shift_jis",
+			encoding: encodingShiftJIS,
+			b:        "\xfb\xfc\x8b\xb4\r\n\x87\x40a\x82",
+			want: []position{
+				{line: 1, column: 1, offset: 0, size: 2, message: "U+9AD9 '髙' not in JIS X 0208"},
+				{line: 2, column: 1, offset: 6, size: 2, message: "U+2460 '①' not in JIS X 0208"},
+				{line: 2, column: 3, offset: 9, size: 1, message: "invalid Shift_JIS bytes 82"},
+			},
+		},
+		{
+			name:     "euc-jp",
+			encoding: encodingEUCJP,
+			b:        "\xa4\xa2\x8e\xb1\x8f\xb0\xa1",
+			want: []position{
+				{line: 1, column: 2, offset: 2, size: 2, message: "U+FF71 'ｱ' not in JIS X 0208"},
+				{line: 1, column: 3, offset: 4, size: 3, message: "U+4E02 '丂' not in JIS X 0208"},
+			},
+		},
+		{
+			name:     "iso-2022-jp",
+			encoding: encodingISO2022JP,
+			b:        "a\x1b$B$\"\x7f\x7f\x1b(B\n\x1b(I1\x1b(B",
+			want: []position{
+				{line: 1, column: 3, offset: 6, size: 1, message: "invalid ISO-2022-JP bytes 7F"},
+				{line: 1, column: 4, offset: 7, size: 1, message: "invalid ISO-2022-JP bytes 7F"},
+				{line: 2, column: 1, offset: 15, size: 1, message: "U+FF71 'ｱ' not in JIS X 0208"},
+			},
+		},
+		{
+			name:     "auto",
+			encoding: nil,
+			b:        "\x82\xa0\x82\xa2\x82\xa4\x87\x40",
+			want: []position{
+				{line: 1, column: 4, offset: 6, size: 2, message: "U+2460 '①' not in JIS X 0208"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []position
+			for _, v := range checkEncoded("a.txt", []byte(tt.b), tt.encoding, jisx0208.NewDiscriminator()) {
+				got = append(got, position{line: v.line, column: v.column, offset: v.offset, size: v.size, message: v.message()})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkEncoded() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextEncoding_Encode(t *testing.T) {
+	d := jisx0208.NewDiscriminator()
+	tests := []struct {
+		encoding *textEncoding
+		s        string
+		want     []byte
+	}{
+		{encoding: encodingUTF8, s: "髙橋", want: []byte("髙橋")},
+		{encoding: encodingShiftJIS, s: "髙橋", want: []byte("?\x8b\xb4")},
+		{encoding: encodingEUCJP, s: "橋a", want: []byte("\xb6\xb6a")},
+		{encoding: encodingISO2022JP, s: "橋", want: []byte("\x1b$@66\x1b(B")},
+	}
+	for _, tt := range tests {
+		got, err := tt.encoding.encode([]byte(tt.s), d, "?")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: encode(%q) = %q, want %q", tt.encoding.name, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestTextEncoding_RoundTrip(t *testing.T) {
+	// the output of -to iso-2022-jp is read by -from iso-2022-jp, and the spans of check and fix
+	// agree with the filter
+	const s = "凜と熙\n"
+	d := jisx0208.NewDiscriminator()
+	var b bytes.Buffer
+	w := encodingISO2022JP.newWriter(&b, d, "□")
+	if _, err := io.WriteString(w, s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, r := range map[string]io.Reader{
+		"reader":          bytes.NewReader(b.Bytes()),
+		"one byte reader": iotest.OneByteReader(bytes.NewReader(b.Bytes())),
+	} {
+		var got bytes.Buffer
+		if err := copyValid(&got, encodingISO2022JP.newReader(r), d, "□"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.String() != s {
+			t.Errorf("%s: newReader() = %q, want %q", name, got.String(), s)
+		}
+	}
+	if got, _ := encodingISO2022JP.decode(b.Bytes()); string(got) != s {
+		t.Errorf("decode() = %q, want %q", got, s)
+	}
+}
+
+func TestLookupEncoding(t *testing.T) {
+	tests := []struct {
+		name string
+		want *textEncoding
+	}{
+		{name: "UTF-8", want: encodingUTF8},
+		{name: "sjis", want: encodingShiftJIS},
+		{name: "Shift_JIS", want: encodingShiftJIS},
+		{name: "euc-jp", want: encodingEUCJP},
+		{name: "ISO-2022-JP", want: encodingISO2022JP},
+		{name: "auto", want: nil},
+	}
+	for _, tt := range tests {
+		got, err := lookupEncoding(tt.name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("lookupEncoding(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := lookupEncoding("latin1"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runFilter writes the text argument, or the standard input, with the invalid characters replaced.
+// The standard input is decoded from the -from encoding, and the output is encoded into the -to
+// encoding, where the characters that cannot be encoded are replaced too.
+//
+//	jisx0208 [-from encoding] [-to encoding] [-r replacement] [-level 1] [-allow runes] [text]
+func runFilter(args []string) error {
+	var (
+		p   policy
+		enc encodingFlags
+	)
+	fs := flag.NewFlagSet("jisx0208", flag.ContinueOnError)
+	p.registerReplacement(fs, "□")
+	p.register(fs)
+	enc.register(fs)
+	enc.registerTo(fs, "utf-8")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 [flags] [text]")
+		fmt.Fprintln(fs.Output(), "       jisx0208 check|fix|info|mail|stats|table [flags] ...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	from, to, err := enc.encodings()
+	if err != nil {
+		return err
+	}
+	var r io.Reader = os.Stdin
+	if fs.NArg() >= 1 {
+		r, from = strings.NewReader(fs.Arg(0)+"\n"), encodingUTF8
+	}
+	r, from = peekReader(r, from)
+	w := to.newWriter(os.Stdout, d, p.replacement)
+	if err := copyValid(w, from.newReader(r), d, p.replacement); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// copyValid copies r to w with the invalid characters replaced as ToValid of the discriminator does,
+// streaming the input of any line length: the other bytes, including line breaks and the lack of
+// a final newline, are written as is.
+func copyValid(w io.Writer, r io.Reader, d *jisx0208.Discriminator, replacement string) error {
+	var (
+		br      = bufio.NewReaderSize(r, 64<<10)
+		bw      = bufio.NewWriterSize(w, 64<<10)
+		invalid bool // the previous byte is of invalid UTF-8 bytes
+	)
+	for {
+		c, size, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case c == utf8.RuneError && size == 1:
+			if !invalid {
+				bw.WriteString(replacement)
+			}
+			invalid = true
+			continue
+		case c < utf8.RuneSelf || d.Is(c):
+			bw.WriteRune(c)
+		default:
+			bw.WriteString(replacement)
+		}
+		invalid = false
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestCopyValid(t *testing.T) {
+	d := jisx0208.NewDiscriminator(jisx0208.Disallow('魚'))
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{name: "empty", s: ""},
+		{name: "crlf", s: "髙橋\r\nさん\r\n"},
+		{name: "no final newline", s: "人魚\n①"},
+		{name: "invalid UTF-8", s: "a\xff\xfe\xfdb\xff\n\xe3\x81"},
+		{name: "replacement character", s: "�\xff"},
+		{name: "long line", s: strings.Repeat("あ髙", 100000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := d.ToValid(tt.s, "□")
+			var b bytes.Buffer
+			if err := copyValid(&b, strings.NewReader(tt.s), d, "□"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if b.String() != want {
+				t.Errorf("copyValid() = %q, want %q", truncate(b.String()), truncate(want))
+			}
+			b.Reset()
+			if err := copyValid(&b, iotest.OneByteReader(strings.NewReader(tt.s)), d, "□"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if b.String() != want {
+				t.Errorf("copyValid(one byte reader) = %q, want %q", truncate(b.String()), truncate(want))
+			}
+		})
+	}
+}
+
+func TestCopyValid_Valid(t *testing.T) {
+	s := "line1\r\nline2\n\n" + strings.Repeat("x", 200000) + "\nあいう"
+	var b bytes.Buffer
+	if err := copyValid(&b, strings.NewReader(s), jisx0208.NewDiscriminator(), "□"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.String() != s {
+		t.Errorf("copyValid() changed the valid input")
+	}
+}
+
+func truncate(s string) string {
+	if len(s) > 64 {
+		return s[:64] + "..."
+	}
+	return s
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runFix replaces the violations that check reports in the files. By default, it writes
+// the fixed files to stdout; with -w, it rewrites the files in place, and with -d, it prints
+// the unified diff of the fixes in UTF-8 instead. The files are written in the -to encoding,
+// which is the -from encoding by default.
+//
+//	jisx0208 fix [-w [-bak] | -d] [-fold] [-from encoding] [-to encoding] [-r replacement] [-level 1] [-allow runes] [file|dir|glob ...]
+func runFix(args []string) error {
+	var (
+		p   policy
+		in  inputFlags
+		enc encodingFlags
+	)
+	fs := flag.NewFlagSet("fix", flag.ContinueOnError)
+	write := fs.Bool("w", false, "write the fixes to the files in place")
+	bak := fs.Bool("bak", false, "keep the original of each file rewritten by -w as file.bak")
+	diff := fs.Bool("d", false, "print the unified diff of the fixes without writing the files")
+	fold := fs.Bool("fold", false, "replace characters with their JIS X 0208 alternatives where known, e.g. 髙 with 高")
+	p.registerReplacement(fs, jisx0208.AozoraGeta)
+	p.register(fs)
+	in.register(fs)
+	enc.register(fs)
+	enc.registerTo(fs, "")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 fix [-w [-bak] | -d] [-fold] [flags] [file|dir|glob ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *write && *diff {
+		return errors.New("fix: -w and -d are exclusive")
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	from, to, err := enc.encodings()
+	if err != nil {
+		return err
+	}
+	inputs, err := collectInputs(fs.Args(), in.filter)
+	if err != nil {
+		return err
+	}
+	type result struct {
+		b, fixed []byte
+		err      error
+	}
+	var failed int
+	parallel(len(inputs), in.workers, func(i int) result {
+		name := inputs[i].name
+		b, err := readFile(name)
+		if err != nil || inputs[i].walked && isBinary(b) {
+			return result{err: err}
+		}
+		from, to := from, to
+		if from == nil {
+			from = detectEncoding(b)
+		}
+		if to == nil {
+			to = from
+		}
+		text, _ := from.decode(b)
+		fixed, err := fix(text, d, p.replacement, *fold)
+		if err != nil || *diff {
+			return result{b: text, fixed: fixed, err: err}
+		}
+		out, err := to.encode(fixed, d, p.replacement)
+		if err != nil || !*write || string(out) == string(b) {
+			return result{fixed: out, err: err}
+		}
+		if name == stdin {
+			return result{err: errors.New("cannot write the standard input")}
+		}
+		return result{err: writeFileAtomic(name, out, b, *bak)}
+	}, func(i int, res result) bool {
+		switch {
+		case res.err != nil:
+			failed++
+			fmt.Fprintln(os.Stderr, errorf(inputs[i].name, res.err))
+		case *diff:
+			name := filepath.ToSlash(displayName(inputs[i].name))
+			fmt.Print(unifiedDiff("a/"+name, "b/"+name, res.b, res.fixed))
+		case !*write:
+			os.Stdout.Write(res.fixed)
+		}
+		return true
+	})
+	if failed > 0 {
+		return &exitError{code: 2, err: fmt.Errorf("%d files could not be fixed", failed)}
+	}
+	return nil
+}
+
+// fix returns b with the violations that check reports replaced with the replacement, or with
+// the alternatives valid under the discriminator if fold is true. It fails if b is not UTF-8
+// but valid in another encoding, rather than replacing every character.
+func fix(b []byte, d *jisx0208.Discriminator, replacement string, fold bool) ([]byte, error) {
+	v := check("", b, d)
+	if len(v) == 1 && v[0].encoding != "" {
+		return nil, errors.New(v[0].message())
+	}
+	if len(v) == 0 {
+		return b, nil
+	}
+	ret := make([]byte, 0, len(b))
+	last := 0
+	for _, v := range v {
+		ret = append(ret, b[last:v.offset]...)
+		s := replacement
+		if alt := v.explanation.Alternative; fold && alt != "" && isValid(alt, d) {
+			s = alt
+		}
+		ret = append(ret, s...)
+		last = v.offset + v.size
+	}
+	return append(ret, b[last:]...), nil
+}
+
+func isValid(s string, d *jisx0208.Discriminator) bool {
+	for _, r := range s {
+		if !d.Is(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeFileAtomic replaces the file with b through a temporary file in the same directory,
+// keeping the permissions, and writes the original content to file.bak if bak is true.
+func writeFileAtomic(name string, b, orig []byte, bak bool) error {
+	info, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+	fp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := fp.Name()
+	defer os.Remove(tmp) // fails after the rename
+	if _, err := fp.Write(b); err != nil {
+		fp.Close()
+		return err
+	}
+	if err := fp.Chmod(info.Mode().Perm()); err != nil {
+		fp.Close()
+		return err
+	}
+	if err := fp.Sync(); err != nil {
+		fp.Close()
+		return err
+	}
+	if err := fp.Close(); err != nil {
+		return err
+	}
+	if bak {
+		if err := os.WriteFile(name+".bak", orig, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, name)
+}
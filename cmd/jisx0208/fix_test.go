@@ -0,0 +1,206 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestFix(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		d    *jisx0208.Discriminator
+		fold bool
+		want string
+	}{
+		{
+			name: "valid",
+			b:    "あいう\r\n",
+			d:    jisx0208.NewDiscriminator(),
+			want: "あいう\r\n",
+		},
+		{
+			name: "replace",
+			b:    "髙橋\xff\xfe①\r\nend",
+			d:    jisx0208.NewDiscriminator(),
+			want: "〓橋〓〓\r\nend",
+		},
+		{
+			name: "fold",
+			b:    "髙橋①〜😀",
+			d:    jisx0208.NewDiscriminator(),
+			fold: true,
+			want: "高橋〓～〓",
+		},
+		{
+			name: "fold to disallowed",
+			b:    "髙",
+			d:    jisx0208.NewDiscriminator(jisx0208.Disallow('高')),
+			fold: true,
+			want: "〓",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fix([]byte(tt.b), tt.d, "〓", tt.fold)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("fix() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFix_NotUTF8(t *testing.T) {
+	if _, err := fix([]byte("\x82\xa0\x82\xa2\n"), jisx0208.NewDiscriminator(), "〓", false); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "a.txt")
+	// the mode differs from 0o600 of temporary files, and is set after the umask applies
+	const mode = 0o644
+	if err := os.WriteFile(name, []byte("髙"), mode); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.Chmod(name, mode); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := writeFileAtomic(name, []byte("高"), []byte("髙"), true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, want := range map[string]string{name: "高", name + ".bak": "髙"} {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(b) != want {
+			t.Errorf("%s = %q, want %q", name, b, want)
+		}
+		if runtime.GOOS == "windows" { // which has no permission bits but read-only
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.Mode().Perm() != mode {
+			t.Errorf("%s mode = %v, want %v", name, info.Mode().Perm(), os.FileMode(mode))
+		}
+	}
+	entries, err := os.ReadDir(filepath.Dir(name))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("got %d files, want no temporary files left", len(entries))
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{
+			name: "equal",
+			a:    "a\n",
+			b:    "a\n",
+			want: "",
+		},
+		{
+			name: "two hunks",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:    "1\nX\n3\n4\n5\n6\n7\n8\n9\n10\n11\nY\n",
+			want: `--- a/x
++++ b/x
+@@ -1,5 +1,5 @@
+ 1
+-2
++X
+ 3
+ 4
+ 5
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++Y
+`,
+		},
+		{
+			name: "merged hunk",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:    "X\n2\n3\n4\n5\n6\n7\nY\n",
+			want: `--- a/x
++++ b/x
+@@ -1,8 +1,8 @@
+-1
++X
+ 2
+ 3
+ 4
+ 5
+ 6
+ 7
+-8
++Y
+`,
+		},
+		{
+			name: "no newline at end",
+			a:    "a\nb",
+			b:    "a\nc",
+			want: `--- a/x
++++ b/x
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`,
+		},
+		{
+			name: "insertion and deletion",
+			a:    "a\nb\nc\n",
+			b:    "a\nc\nd\ne\n",
+			want: `--- a/x
++++ b/x
+@@ -1,3 +1,4 @@
+ a
+-b
+ c
++d
++e
+`,
+		},
+		{
+			name: "empty",
+			a:    "",
+			b:    "a\n",
+			want: `--- a/x
++++ b/x
+@@ -0,0 +1 @@
++a
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedDiff("a/x", "b/x", []byte(tt.a), []byte(tt.b))
+			if got != tt.want {
+				t.Errorf("unifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runInfo prints the code points of the characters given as characters, code points U+XXXX,
+// kuten 25-66 or men-kuten 1-25-66, and JIS, Shift_JIS or EUC-JP codes 0xXXXX.
+//
+//	jisx0208 info [-level 1] [-allow runes] 髙 高 U+9AD8 1-25-66 0x8D82
+func runInfo(args []string) error {
+	var p policy
+	fs := flag.NewFlagSet("info", flag.ContinueOnError)
+	p.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 info [flags] char|U+XXXX|ku-ten|men-ku-ten|0xXXXX ...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	var (
+		entries []infoEntry
+		failed  int
+	)
+	for _, arg := range fs.Args() {
+		v, err := parseInfoArg(arg)
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "%s: %v\n", arg, err)
+			continue
+		}
+		entries = append(entries, v...)
+	}
+	if err := writeInfo(os.Stdout, entries, d); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return &exitError{code: 2, err: fmt.Errorf("%d arguments could not be looked up", failed)}
+	}
+	return nil
+}
+
+// infoEntry is a character to look up with the input that denotes it.
+type infoEntry struct {
+	input string
+	rune  rune
+}
+
+var (
+	codePointPattern = regexp.MustCompile(`^[Uu]\+([0-9A-Fa-f]{1,6})$`)
+	kutenPattern     = regexp.MustCompile(`^(?:([12])-)?(\d{1,2})-(\d{1,2})$`)
+	codePattern      = regexp.MustCompile(`^0[xX]([0-9A-Fa-f]{4}|[0-9A-Fa-f]{6})$`)
+)
+
+// parseInfoArg returns the characters that the argument denotes. A code of two bytes may denote
+// a character in each of JIS, Shift_JIS and EUC-JP, including vendor extensions.
+func parseInfoArg(s string) ([]infoEntry, error) {
+	if m := codePointPattern.FindStringSubmatch(s); m != nil {
+		v, _ := strconv.ParseUint(m[1], 16, 32)
+		if !utf8.ValidRune(rune(v)) {
+			return nil, errors.New("invalid code point")
+		}
+		return []infoEntry{{input: s, rune: rune(v)}}, nil
+	}
+	if m := kutenPattern.FindStringSubmatch(s); m != nil {
+		ku, _ := strconv.Atoi(m[2])
+		ten, _ := strconv.Atoi(m[3])
+		if r, ok := (jisx0208.Kuten{Ku: ku, Ten: ten}).Rune(); ok && m[1] != "2" {
+			return []infoEntry{{input: s, rune: r}}, nil
+		}
+		if m[1] != "" {
+			men, _ := strconv.Atoi(m[1])
+			if r, ok := (jisx0208.MenKuTen{Men: men, Ku: ku, Ten: ten}).Rune(); ok {
+				return []infoEntry{{input: s, rune: r}}, nil
+			}
+		}
+		return nil, errors.New("unassigned code point")
+	}
+	if m := codePattern.FindStringSubmatch(s); m != nil {
+		v, _ := strconv.ParseUint(m[1], 16, 32)
+		ret := decodeCode(s, uint32(v), len(m[1])/2)
+		if len(ret) == 0 {
+			return nil, errors.New("unassigned code in JIS, Shift_JIS and EUC-JP")
+		}
+		return ret, nil
+	}
+	if l := strings.ToLower(s); len(l) > 2 && (strings.HasPrefix(l, "0x") || strings.HasPrefix(l, "u+")) {
+		return nil, errors.New("invalid code, want U+XXXX or 0x followed by 2 or 3 bytes")
+	}
+	if !utf8.ValidString(s) {
+		return nil, errors.New("invalid UTF-8")
+	}
+	var ret []infoEntry
+	for _, r := range s {
+		ret = append(ret, infoEntry{input: string(r), rune: r})
+	}
+	return ret, nil
+}
+
+// decodeCode returns the characters of the code of n bytes in JIS, Shift_JIS and EUC-JP.
+func decodeCode(s string, c uint32, n int) []infoEntry {
+	var ret []infoEntry
+	add := func(name string, r rune) {
+		for _, v := range ret {
+			if v.rune == r {
+				return
+			}
+		}
+		ret = append(ret, infoEntry{input: s + " (" + name + ")", rune: r})
+	}
+	if n == 2 {
+		if k, ok := jisx0208.KutenFromJIS(uint16(c)); ok && c&0x8080 == 0 {
+			if r, ok := k.Rune(); ok {
+				add("JIS", r)
+			}
+		}
+	}
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(c >> (8 * (n - 1 - i)))
+	}
+	for _, e := range []*textEncoding{encodingShiftJIS, encodingEUCJP} {
+		if v, err := e.decoding.NewDecoder().Bytes(b); err == nil {
+			if r, size := utf8.DecodeRune(v); r != utf8.RuneError && size == len(v) && r >= utf8.RuneSelf {
+				add(e.name, r)
+			}
+		}
+	}
+	return ret
+}
+
+// writeInfo writes the table of the characters, followed by the explanations of the characters
+// that are not valid. EDITION is the edition in which the code point was assigned to the character,
+// and GLYPH the latest edition in which its example glyph was changed.
+func writeInfo(w io.Writer, entries []infoEntry, d *jisx0208.Discriminator) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "INPUT\tCHAR\tUNICODE\tKUTEN\tJIS\tSJIS\tEUC\tLEVEL\tROW\tEDITION\tGLYPH\tRangeTable\tLevel1RangeTable\tLevel2RangeTable")
+	for _, e := range entries {
+		r := e.rune
+		cols := []string{e.input, quoteChar(r), fmt.Sprintf("%U", r), "-", "-", "-", "-", "-", "-", "-", "-",
+			yesNo(jisx0208.Is(r)), yesNo(jisx0208.IsLevel1(r)), yesNo(jisx0208.IsLevel2(r))}
+		if k, ok := jisx0208.KutenOf(r); ok {
+			cols[3] = k.String()
+			cols[4] = fmt.Sprintf("0x%04X", k.JIS())
+			cols[5] = fmt.Sprintf("0x%04X", k.ShiftJIS())
+			cols[6] = fmt.Sprintf("0x%04X", k.EUCJP())
+			cols[8] = rowCategory(k.Ku)
+			if e, ok := jisx0208.EditionOf(r); ok {
+				cols[9] = strconv.Itoa(int(e))
+			}
+			if e, ok := jisx0208.GlyphChangeOf(r); ok {
+				cols[10] = strconv.Itoa(int(e))
+			}
+		}
+		switch {
+		case jisx0208.IsLevel1(r):
+			cols[7] = "1"
+		case jisx0208.IsLevel2(r):
+			cols[7] = "2"
+		}
+		fmt.Fprintln(tw, strings.Join(cols, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if x := d.Explain(e.rune); !x.Valid() {
+			if _, err := fmt.Fprintln(w, x); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rowCategory returns the category of the characters in the row of JIS X 0208.
+func rowCategory(ku int) string {
+	switch {
+	case ku <= 2:
+		return "symbols"
+	case ku == 3:
+		return "alphanumerics"
+	case ku == 4:
+		return "hiragana"
+	case ku == 5:
+		return "katakana"
+	case ku == 6:
+		return "Greek"
+	case ku == 7:
+		return "Cyrillic"
+	case ku == 8:
+		return "box drawing"
+	case ku >= 16 && ku <= 47:
+		return "level 1 kanji"
+	case ku >= 48 && ku <= 84:
+		return "level 2 kanji"
+	}
+	return "unassigned"
+}
+
+// quoteChar returns the character as is if it is printable, otherwise "-".
+func quoteChar(r rune) string {
+	if strconv.IsPrint(r) {
+		return string(r)
+	}
+	return "-"
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestParseInfoArg(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    []infoEntry
+		wantErr bool
+	}{
+		{name: "characters", arg: "高橋", want: []infoEntry{{input: "高", rune: '高'}, {input: "橋", rune: '橋'}}},
+		{name: "code point", arg: "U+9AD8", want: []infoEntry{{input: "U+9AD8", rune: '高'}}},
+		{name: "kuten", arg: "25-66", want: []infoEntry{{input: "25-66", rune: '高'}}},
+		{name: "men-kuten in JIS X 0208", arg: "1-25-66", want: []infoEntry{{input: "1-25-66", rune: '高'}}},
+		{name: "men-kuten in JIS X 0213", arg: "1-85-22", want: []infoEntry{{input: "1-85-22", rune: '昳'}}},
+		{name: "Shift_JIS", arg: "0x8D82", want: []infoEntry{{input: "0x8D82 (Shift_JIS)", rune: '高'}}},
+		{name: "IBM extension", arg: "0xFBFC", want: []infoEntry{{input: "0xFBFC (Shift_JIS)", rune: '髙'}, {input: "0xFBFC (EUC-JP)", rune: '\u9115'}}},
+		{name: "JIS", arg: "0x3441", want: []infoEntry{{input: "0x3441 (JIS)", rune: '漢'}}},
+		{name: "Shift_JIS and EUC-JP", arg: "0xE0A1", want: []infoEntry{{input: "0xE0A1 (Shift_JIS)", rune: '爍'}, {input: "0xE0A1 (EUC-JP)", rune: '燹'}}},
+		{name: "unassigned kuten", arg: "99-1", wantErr: true},
+		{name: "invalid code", arg: "0xZZ", wantErr: true},
+		{name: "invalid code point", arg: "U+D800", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseInfoArg(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInfoArg(%q) error = %v, wantErr %v", tt.arg, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseInfoArg(%q) = %+v, want %+v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteInfo(t *testing.T) {
+	var b bytes.Buffer
+	if err := writeInfo(&b, []infoEntry{{input: "高", rune: '高'}, {input: "偉", rune: '偉'}, {input: "髙", rune: '髙'}}, jisx0208.NewDiscriminator()); err != nil {
+		t.Fatalf("writeInfo() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("writeInfo() = %q, want 5 lines", b.String())
+	}
+	for _, want := range []string{"U+9AD8", "25-66", "0x3962", "0x8D82", "0xB9E2", "level 1 kanji", "1978"} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("writeInfo() line %q does not contain %q", lines[1], want)
+		}
+	}
+	// the glyph of 偉 was changed in 1990, but its code point has been assigned since 1978;
+	// ROW "level 1 kanji" takes three fields
+	if f := strings.Fields(lines[2]); len(f) < 15 || f[11] != "1978" || f[12] != "1990" {
+		t.Errorf("writeInfo() line %q, want EDITION 1978 and GLYPH 1990", lines[2])
+	}
+	if f := strings.Fields(lines[1]); len(f) < 15 || f[12] != "-" {
+		t.Errorf("writeInfo() line %q, want no GLYPH", lines[1])
+	}
+	if want := "U+9AD9 '髙': IBM extended character (Shift_JIS 0xFBFC), alternative '高'"; lines[4] != want {
+		t.Errorf("writeInfo() explanation = %q, want %q", lines[4], want)
+	}
+}
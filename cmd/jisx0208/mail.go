@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ikawaha/jisx0208"
+	"github.com/ikawaha/jisx0208/mail"
+)
+
+// runMail checks that the mail messages can be sent in ISO-2022-JP.
+//
+//	jisx0208 mail [-rewrite] [-r replacement] [-level 1] [-allow runes] [file.eml ...]
+func runMail(args []string) error {
+	var p policy
+	fs := flag.NewFlagSet("mail", flag.ContinueOnError)
+	rewrite := fs.Bool("rewrite", false, "write the message rewritten into ISO-2022-JP to stdout")
+	p.registerReplacement(fs, jisx0208.AozoraGeta)
+	p.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 mail [-rewrite] [flags] [file.eml ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	c := mail.NewChecker(mail.Discriminator(d), mail.Replacement(p.replacement))
+	files := fs.Args()
+	if *rewrite {
+		if len(files) > 1 {
+			return errors.New("mail: -rewrite takes a single message")
+		}
+		return checkMail(files, func(name string, r io.Reader) (int, error) {
+			v, err := c.Rewrite(os.Stdout, r)
+			report(os.Stderr, name, v)
+			return 0, err
+		})
+	}
+	return checkMail(files, func(name string, r io.Reader) (int, error) {
+		v, err := c.Check(r)
+		report(os.Stdout, name, v)
+		return len(v), err
+	})
+}
+
+// checkMail applies f to the files, or to stdin if no files are given,
+// and fails if f finds violations.
+func checkMail(files []string, f func(name string, r io.Reader) (int, error)) error {
+	if len(files) == 0 {
+		n, err := f("<stdin>", os.Stdin)
+		if err == nil && n > 0 {
+			err = violationsFound(n)
+		}
+		return err
+	}
+	var total int
+	for _, name := range files {
+		fp, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		n, err := f(name, fp)
+		fp.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		total += n
+	}
+	if total > 0 {
+		return violationsFound(total)
+	}
+	return nil
+}
+
+func report(w io.Writer, name string, v []mail.Violation) {
+	for _, v := range v {
+		fmt.Fprintf(w, "%s: %v\n", name, v)
+	}
+}
@@ -1,29 +1,70 @@
 package main
 
 import (
-	"bufio"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
-
-	"github.com/ikawaha/jisx0208"
 )
 
+// The command exits with status 1 if violations are found, and 2 on errors.
 func main() {
-	if err := run(os.Args[1:]); err != nil {
-		fmt.Fprintf(os.Stderr, "%v", err)
-		os.Exit(1)
+	err := run(os.Args[1:])
+	var e *exitError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.As(err, &e):
+		if e.err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", e.err)
+		}
+		os.Exit(e.code)
+	default:
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(2)
+	}
+}
+
+// exitError is an error with the exit status of the command. It prints nothing if err is nil.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("exit status %d", e.code)
 	}
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// violationsFound returns the error of n violations found, which exits with status 1.
+func violationsFound(n int) error {
+	if n == 1 {
+		return &exitError{code: 1, err: errors.New("1 violation found")}
+	}
+	return &exitError{code: 1, err: fmt.Errorf("%d violations found", n)}
 }
 
 func run(args []string) error {
 	if len(args) >= 1 {
-		fmt.Println(jisx0208.ToValid(args[0], "□"))
-		return nil
-	}
-	fp := os.Stdin
-	s := bufio.NewScanner(fp)
-	for s.Scan() {
-		fmt.Println(jisx0208.ToValid(s.Text(), "□"))
+		switch args[0] {
+		case "check":
+			return runCheck(args[1:])
+		case "fix":
+			return runFix(args[1:])
+		case "info":
+			return runInfo(args[1:])
+		case "mail":
+			return runMail(args[1:])
+		case "stats":
+			return runStats(args[1:])
+		case "table":
+			return runTable(args[1:])
+		}
 	}
-	return s.Err()
+	return runFilter(args)
 }
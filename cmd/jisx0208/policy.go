@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// policy is the flags of the valid characters and the replacement, shared by the subcommands.
+type policy struct {
+	replacement string
+	level       int
+	allow       runeList
+	disallow    runeList
+	allowFiles  stringList
+}
+
+// register defines the flags of the valid characters in the flag set.
+func (p *policy) register(fs *flag.FlagSet) {
+	fs.IntVar(&p.level, "level", 0, "restrict kanji to JIS level `n` (1), or 0 for both levels")
+	fs.Var(&p.allow, "allow", "allow the `runes`, e.g. 髙﨑 or U+9AD9,U+FA11 (repeatable)")
+	fs.Var(&p.disallow, "disallow", "disallow the `runes` in JIS X 0208 (repeatable)")
+	fs.Var(&p.allowFiles, "allow-file", "allow the runes in the `file`, one per line (repeatable)")
+}
+
+// registerReplacement defines the flag of the replacement in the flag set.
+func (p *policy) registerReplacement(fs *flag.FlagSet, replacement string) {
+	fs.StringVar(&p.replacement, "r", replacement, "replacement of invalid characters, which may be empty")
+}
+
+// discriminator returns the discriminator of the policy.
+func (p *policy) discriminator() (*jisx0208.Discriminator, error) {
+	var options []jisx0208.Option
+	switch p.level {
+	case 0:
+	case 1:
+		options = append(options, jisx0208.DisallowTable(jisx0208.Level2RangeTable))
+	default:
+		return nil, fmt.Errorf("invalid level %d, want 1 or 0", p.level)
+	}
+	allow := p.allow
+	for _, name := range p.allowFiles {
+		v, err := readRuneFile(name)
+		if err != nil {
+			return nil, err
+		}
+		allow = append(allow, v...)
+	}
+	options = append(options, jisx0208.Allow(allow...), jisx0208.Disallow(p.disallow...))
+	return jisx0208.NewDiscriminator(options...), nil
+}
+
+// runeList is a flag of runes, which accumulates over the flags.
+type runeList []rune
+
+func (l *runeList) String() string {
+	if l == nil {
+		return ""
+	}
+	return string(*l)
+}
+
+func (l *runeList) Set(s string) error {
+	for _, v := range strings.Split(s, ",") {
+		r, err := parseRunes(v)
+		if err != nil {
+			return err
+		}
+		*l = append(*l, r...)
+	}
+	return nil
+}
+
+// stringList is a flag of strings, which accumulates over the flags.
+type stringList []string
+
+func (l *stringList) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+// parseRunes returns the rune of the code point notation U+XXXX, or the runes of s otherwise.
+func parseRunes(s string) ([]rune, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > 2 && (s[:2] == "U+" || s[:2] == "u+") {
+		v, err := strconv.ParseUint(s[2:], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(v)) {
+			return nil, fmt.Errorf("invalid code point %q", s)
+		}
+		return []rune{rune(v)}, nil
+	}
+	if !utf8.ValidString(s) {
+		return nil, fmt.Errorf("invalid UTF-8 %q", s)
+	}
+	return []rune(s), nil
+}
+
+// readRuneFile returns the runes in the file, one per line in the form of a character or U+XXXX.
+// Blank lines and lines starting with # are ignored.
+func readRuneFile(name string) ([]rune, error) {
+	fp, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+	var ret []rune
+	s := bufio.NewScanner(fp)
+	for line := 1; s.Scan(); line++ {
+		v := strings.TrimSpace(s.Text())
+		if v == "" || strings.HasPrefix(v, "#") {
+			continue
+		}
+		r, err := parseRunes(v)
+		if err == nil && len(r) != 1 {
+			err = errors.New("want one rune")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+		ret = append(ret, r...)
+	}
+	return ret, s.Err()
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicy_Discriminator(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "allow.txt")
+	if err := os.WriteFile(file, []byte("# names\n﨑\n\nU+9AD9\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name string
+		args []string
+		s    string
+		want string
+	}{
+		{name: "default", args: nil, s: "髙橋弌あ", want: "□橋弌あ"},
+		{name: "replacement", args: []string{"-r", ""}, s: "髙橋", want: "橋"},
+		{name: "level 1", args: []string{"-level", "1"}, s: "橋弌", want: "橋□"},
+		{name: "allow", args: []string{"-allow", "髙,U+FA11"}, s: "髙﨑", want: "髙﨑"},
+		{name: "disallow", args: []string{"-disallow", "あい", "-disallow", "う"}, s: "あいうえ", want: "□□□え"},
+		{name: "allow file", args: []string{"-allow-file", file}, s: "髙﨑①", want: "髙﨑□"},
+		{name: "allow over level", args: []string{"-level", "1", "-allow", "弌"}, s: "弌", want: "弌"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p policy
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			p.registerReplacement(fs, "□")
+			p.register(fs)
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			d, err := p.discriminator()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := d.ToValid(tt.s, p.replacement); got != tt.want {
+				t.Errorf("ToValid(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicy_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "level", args: []string{"-level", "2"}},
+		{name: "code point", args: []string{"-allow", "U+ZZZZ"}},
+		{name: "missing file", args: []string{"-allow-file", filepath.Join(t.TempDir(), "missing")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p policy
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			p.registerReplacement(fs, "□")
+			p.register(fs)
+			if err := fs.Parse(tt.args); err != nil {
+				return
+			}
+			if _, err := p.discriminator(); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
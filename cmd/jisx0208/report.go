@@ -0,0 +1,290 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// reporter writes the violations in a format.
+type reporter interface {
+	// report writes the violations of a file.
+	report(v []violation) error
+	// close writes the rest of the output.
+	close() error
+}
+
+// newReporter returns the reporter of the format: text, json, jsonl or sarif.
+func newReporter(w io.Writer, format string) (reporter, error) {
+	switch format {
+	case "text":
+		return &textReporter{w: w}, nil
+	case "json":
+		return &jsonReporter{w: w, results: []jsonResult{}}, nil
+	case "jsonl":
+		return &jsonReporter{w: w, lines: true}, nil
+	case "sarif":
+		return &sarifReporter{w: w, results: []sarifResult{}}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, want text, json, jsonl or sarif", format)
+}
+
+type discardReporter struct{}
+
+func (discardReporter) report([]violation) error { return nil }
+func (discardReporter) close() error             { return nil }
+
+// textReporter writes a violation per line in the form of file:line:col: message.
+type textReporter struct {
+	w io.Writer
+}
+
+func (r *textReporter) report(v []violation) error {
+	for _, v := range v {
+		if _, err := fmt.Fprintln(r.w, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *textReporter) close() error {
+	return nil
+}
+
+// jsonResult is a violation in JSON.
+type jsonResult struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Offset int    `json:"offset"`
+	// Rune and CodePoint are empty for invalid UTF-8 bytes, which are in Bytes in hexadecimal.
+	Rune      string `json:"rune,omitempty"`
+	CodePoint string `json:"code_point,omitempty"`
+	Bytes     string `json:"bytes,omitempty"`
+	// Encoding is the encoding detected for the file that is not UTF-8, whose line is 0.
+	Encoding   string `json:"encoding,omitempty"`
+	Reason     string `json:"reason"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+func newJSONResult(v violation) jsonResult {
+	ret := jsonResult{
+		Path:       v.path,
+		Line:       v.line,
+		Column:     v.column,
+		Offset:     v.offset,
+		Reason:     v.reason(),
+		Suggestion: v.explanation.Alternative,
+	}
+	switch {
+	case v.encoding != "":
+		ret.Encoding = v.encoding
+	case v.bytes != nil:
+		ret.Bytes = fmt.Sprintf("% X", v.bytes)
+	default:
+		ret.Rune = string(v.rune)
+		ret.CodePoint = fmt.Sprintf("%U", v.rune)
+	}
+	return ret
+}
+
+// jsonReporter writes the violations in a JSON array, or in JSON Lines if lines is true.
+type jsonReporter struct {
+	w       io.Writer
+	lines   bool
+	results []jsonResult
+}
+
+func (r *jsonReporter) report(v []violation) error {
+	for _, v := range v {
+		if !r.lines {
+			r.results = append(r.results, newJSONResult(v))
+			continue
+		}
+		b, err := json.Marshal(newJSONResult(v))
+		if err != nil {
+			return err
+		}
+		if _, err := r.w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *jsonReporter) close() error {
+	if r.lines {
+		return nil
+	}
+	return writeJSON(r.w, r.results)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// The SARIF 2.1.0 log, of the properties that the reporter writes.
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	sarifRun struct {
+		Tool       sarifTool     `json:"tool"`
+		ColumnKind string        `json:"columnKind"`
+		Results    []sarifResult `json:"results"`
+	}
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+	sarifRule struct {
+		ID               string       `json:"id"`
+		ShortDescription sarifMessage `json:"shortDescription"`
+	}
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		RuleIndex int             `json:"ruleIndex"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+		Fixes     []sarifFix      `json:"fixes,omitempty"`
+	}
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+	sarifRegion struct {
+		StartLine   int `json:"startLine,omitempty"`
+		StartColumn int `json:"startColumn,omitempty"`
+		EndColumn   int `json:"endColumn,omitempty"`
+		ByteOffset  int `json:"byteOffset"`
+		ByteLength  int `json:"byteLength"`
+	}
+	sarifFix struct {
+		Description     sarifMessage          `json:"description"`
+		ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+	}
+	sarifArtifactChange struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Replacements     []sarifReplacement    `json:"replacements"`
+	}
+	sarifReplacement struct {
+		DeletedRegion   sarifRegion  `json:"deletedRegion"`
+		InsertedContent sarifMessage `json:"insertedContent"`
+	}
+)
+
+// sarifRules are the rules of the violations, in the order of the rule indexes.
+var sarifRules = []sarifRule{
+	{ID: "not-in-jisx0208", ShortDescription: sarifMessage{Text: "Character not in JIS X 0208"}},
+	{ID: "disallowed", ShortDescription: sarifMessage{Text: "Character disallowed"}},
+	{ID: "invalid-bytes", ShortDescription: sarifMessage{Text: "Invalid bytes in the encoding"}},
+	{ID: "not-utf8", ShortDescription: sarifMessage{Text: "File not in UTF-8"}},
+}
+
+// sarifReporter writes the violations in a SARIF 2.1.0 log.
+type sarifReporter struct {
+	w       io.Writer
+	results []sarifResult
+}
+
+func (r *sarifReporter) report(v []violation) error {
+	for _, v := range v {
+		r.results = append(r.results, newSARIFResult(v))
+	}
+	return nil
+}
+
+func (r *sarifReporter) close() error {
+	return writeJSON(r.w, sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "jisx0208",
+				InformationURI: "https://github.com/ikawaha/jisx0208",
+				Rules:          sarifRules,
+			}},
+			// columns count characters, not UTF-16 code units
+			ColumnKind: "unicodeCodePoints",
+			Results:    r.results,
+		}},
+	})
+}
+
+func newSARIFResult(v violation) sarifResult {
+	index := 0
+	switch {
+	case v.encoding != "":
+		index = 3
+	case v.bytes != nil:
+		index = 2
+	case v.explanation.Reason == jisx0208.ReasonDisallowed:
+		index = 1
+	}
+	artifact := sarifArtifactLocation{URI: fileURI(v.path)}
+	loc := sarifPhysicalLocation{ArtifactLocation: artifact}
+	if v.encoding == "" {
+		loc.Region = &sarifRegion{
+			StartLine:   v.line,
+			StartColumn: v.column,
+			EndColumn:   v.column + v.width,
+			ByteOffset:  v.offset,
+			ByteLength:  v.size,
+		}
+	}
+	ret := sarifResult{
+		RuleID:    sarifRules[index].ID,
+		RuleIndex: index,
+		Level:     "error",
+		Message:   sarifMessage{Text: v.message()},
+		Locations: []sarifLocation{{PhysicalLocation: loc}},
+	}
+	if s := v.explanation.Alternative; s != "" {
+		ret.Fixes = []sarifFix{{
+			Description: sarifMessage{Text: fmt.Sprintf("Replace with '%s'", s)},
+			ArtifactChanges: []sarifArtifactChange{{
+				ArtifactLocation: artifact,
+				Replacements: []sarifReplacement{{
+					DeletedRegion:   sarifRegion{ByteOffset: v.offset, ByteLength: v.size},
+					InsertedContent: sarifMessage{Text: s},
+				}},
+			}},
+		}}
+	}
+	return ret
+}
+
+// fileURI returns the relative URI reference of the file path.
+func fileURI(path string) string {
+	u := url.URL{Path: filepath.ToSlash(path)}
+	ret := u.String()
+	if strings.HasPrefix(path, "/") {
+		return "file://" + ret
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func testViolations() []violation {
+	return check("dir/a b.txt", []byte("ok\n髙\xffあ"), jisx0208.NewDiscriminator(jisx0208.Disallow('あ')))
+}
+
+func TestReporter_JSONL(t *testing.T) {
+	var b bytes.Buffer
+	r, err := newReporter(&b, "jsonl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.report(testViolations()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		`{"path":"dir/a b.txt","line":2,"column":1,"offset":3,"rune":"髙","code_point":"U+9AD9","reason":"IBM extended character","suggestion":"高"}`,
+		`{"path":"dir/a b.txt","line":2,"column":2,"offset":6,"bytes":"FF","reason":"invalid UTF-8"}`,
+		`{"path":"dir/a b.txt","line":2,"column":3,"offset":7,"rune":"あ","code_point":"U+3042","reason":"disallowed"}`,
+	}
+	if got := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestReporter_JSON(t *testing.T) {
+	for _, v := range [][]violation{nil, testViolations()} {
+		var b bytes.Buffer
+		r, err := newReporter(&b, "json")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := r.report(v); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := r.close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var got []jsonResult
+		if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got == nil || len(got) != len(v) {
+			t.Errorf("got %d results, want %d in an array", len(got), len(v))
+		}
+	}
+}
+
+func TestReporter_SARIF(t *testing.T) {
+	var b bytes.Buffer
+	r, err := newReporter(&b, "sarif")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.report(testViolations()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got sarifLog
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("got version %q and %d runs", got.Version, len(got.Runs))
+	}
+	results := got.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	tests := []struct {
+		ruleID string
+		region sarifRegion
+		fixes  int
+	}{
+		{ruleID: "not-in-jisx0208", region: sarifRegion{StartLine: 2, StartColumn: 1, EndColumn: 2, ByteOffset: 3, ByteLength: 3}, fixes: 1},
+		{ruleID: "invalid-bytes", region: sarifRegion{StartLine: 2, StartColumn: 2, EndColumn: 3, ByteOffset: 6, ByteLength: 1}, fixes: 0},
+		{ruleID: "disallowed", region: sarifRegion{StartLine: 2, StartColumn: 3, EndColumn: 4, ByteOffset: 7, ByteLength: 3}, fixes: 0},
+	}
+	for i, tt := range tests {
+		v := results[i]
+		if v.RuleID != tt.ruleID || sarifRules[v.RuleIndex].ID != tt.ruleID {
+			t.Errorf("result %d: rule %q (%d), want %q", i, v.RuleID, v.RuleIndex, tt.ruleID)
+		}
+		loc := v.Locations[0].PhysicalLocation
+		if loc.ArtifactLocation.URI != "dir/a%20b.txt" {
+			t.Errorf("result %d: uri %q, want %q", i, loc.ArtifactLocation.URI, "dir/a%20b.txt")
+		}
+		if loc.Region == nil || *loc.Region != tt.region {
+			t.Errorf("result %d: region %+v, want %+v", i, loc.Region, &tt.region)
+		}
+		if len(v.Fixes) != tt.fixes {
+			t.Errorf("result %d: %d fixes, want %d", i, len(v.Fixes), tt.fixes)
+		}
+	}
+}
+
+func TestNewReporter_Error(t *testing.T) {
+	if _, err := newReporter(nil, "xml"); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runStats reports what the files contain: the number of characters of each category, and
+// the characters that are not valid, most frequent first, with examples of where they occur.
+// The characters of JIS X 0208 are counted in their categories whatever the policy is, and those
+// the policy rejects are counted again as disallowed. Directories are read recursively as check reads them.
+//
+//	jisx0208 stats [-top n] [-examples n] [-format text|json] [-from encoding] [-level 1] [file|dir|glob ...]
+func runStats(args []string) error {
+	var (
+		p   policy
+		in  inputFlags
+		enc encodingFlags
+	)
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	top := fs.Int("top", 10, "report the `n` most frequent invalid characters, or all if n is negative")
+	examples := fs.Int("examples", 3, "report up to `n` locations of each invalid character")
+	format := fs.String("format", "text", "output `format`: text or json")
+	p.register(fs)
+	in.register(fs)
+	enc.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 stats [-top n] [-examples n] [-format text|json] [flags] [file|dir|glob ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q, want text or json", *format)
+	}
+	d, err := p.discriminator()
+	if err != nil {
+		return err
+	}
+	from, _, err := enc.encodings()
+	if err != nil {
+		return err
+	}
+	inputs, err := collectInputs(fs.Args(), in.filter)
+	if err != nil {
+		return err
+	}
+	type result struct {
+		counts [numCategories]int
+		v      []violation
+		err    error
+	}
+	var (
+		s      = stats{examples: *examples}
+		failed int
+	)
+	parallel(len(inputs), in.workers, func(i int) result {
+		b, err := readFile(inputs[i].name)
+		if err != nil || inputs[i].walked && isBinary(b) {
+			return result{err: err}
+		}
+		var res result
+		res.counts, res.v, res.err = count(displayName(inputs[i].name), b, from, d)
+		return res
+	}, func(i int, res result) bool {
+		if res.err != nil {
+			failed++
+			fmt.Fprintln(os.Stderr, errorf(inputs[i].name, res.err))
+			return true
+		}
+		s.add(res.counts, res.v)
+		return true
+	})
+	if *format == "json" {
+		err = s.writeJSON(os.Stdout, *top)
+	} else {
+		err = s.writeText(os.Stdout, *top)
+	}
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return &exitError{code: 2, err: fmt.Errorf("%d files could not be read", failed)}
+	}
+	return nil
+}
+
+// category is a category of characters.
+type category int
+
+const (
+	categoryASCII category = iota
+	categoryKana
+	categorySymbol
+	categoryLevel1
+	categoryLevel2
+	// categoryAllowed is the characters out of JIS X 0208 that the discriminator allows.
+	categoryAllowed
+	// categoryOutOfSet is the characters out of JIS X 0208 that the discriminator rejects.
+	categoryOutOfSet
+	// categoryInvalid is the runs of bytes that cannot be decoded.
+	categoryInvalid
+	// categoryDisallowed is the characters of JIS X 0208 that the discriminator rejects,
+	// which are also counted in their categories above and so not in the total.
+	categoryDisallowed
+	numCategories
+)
+
+var categoryNames = [numCategories]string{"ASCII", "kana", "symbols", "level 1", "level 2", "allowed", "out-of-set", "invalid bytes", "disallowed"}
+
+var categoryKeys = [numCategories]string{"ascii", "kana", "symbols", "level1", "level2", "allowed", "out_of_set", "invalid", "disallowed"}
+
+// categoryOf returns the category of the rune r, which is ASCII or in JIS X 0208.
+func categoryOf(r rune) category {
+	switch {
+	case r < utf8.RuneSelf:
+		return categoryASCII
+	case jisx0208.IsLevel1(r):
+		return categoryLevel1
+	case jisx0208.IsLevel2(r):
+		return categoryLevel2
+	}
+	if k, _ := jisx0208.KutenOf(r); k.Ku == 4 || k.Ku == 5 {
+		return categoryKana
+	}
+	return categorySymbol
+}
+
+// count returns the number of characters of each category in b, and the violations as check
+// reports them, which are the disallowed and out-of-set characters and the invalid bytes. It fails
+// on a file that is not in the encoding.
+func count(path string, b []byte, e *textEncoding, d *jisx0208.Discriminator) ([numCategories]int, []violation, error) {
+	var ret [numCategories]int
+	if e == nil {
+		e = detectEncoding(b)
+	}
+	v := checkEncoded(path, b, e, d)
+	if len(v) == 1 && v[0].encoding != "" {
+		return ret, nil, errors.New(v[0].message())
+	}
+	text, _ := e.decode(b)
+	for _, r := range string(text) {
+		// as in ToValid, ASCII is never out of the set
+		if _, ok := jisx0208.KutenOf(r); ok || r < utf8.RuneSelf {
+			ret[categoryOf(r)]++
+		} else if d.Is(r) {
+			ret[categoryAllowed]++
+		}
+	}
+	for _, v := range v {
+		switch _, ok := jisx0208.KutenOf(v.rune); {
+		case v.bytes != nil:
+			ret[categoryInvalid]++
+		case ok:
+			ret[categoryDisallowed]++
+		default:
+			ret[categoryOutOfSet]++
+		}
+	}
+	return ret, v, nil
+}
+
+// stats is the statistics of the files.
+type stats struct {
+	files  int
+	counts [numCategories]int
+	runes  map[rune]*runeStats
+	// examples is the maximum number of the examples of each rune.
+	examples int
+}
+
+// runeStats is the statistics of a rune that is not valid.
+type runeStats struct {
+	rune        rune
+	count       int
+	explanation jisx0208.Explanation
+	examples    []violation
+}
+
+func (s *stats) add(counts [numCategories]int, v []violation) {
+	s.files++
+	for i, n := range counts {
+		s.counts[i] += n
+	}
+	for _, v := range v {
+		if v.bytes != nil {
+			continue
+		}
+		if s.runes == nil {
+			s.runes = map[rune]*runeStats{}
+		}
+		rs, ok := s.runes[v.rune]
+		if !ok {
+			rs = &runeStats{rune: v.rune, explanation: v.explanation}
+			s.runes[v.rune] = rs
+		}
+		rs.count++
+		if len(rs.examples) < s.examples {
+			rs.examples = append(rs.examples, v)
+		}
+	}
+}
+
+// total returns the number of the characters and the runs of invalid bytes.
+func (s *stats) total() int {
+	var ret int
+	for _, n := range s.counts[:categoryDisallowed] {
+		ret += n
+	}
+	return ret
+}
+
+// top returns the n most frequent runes that are not valid, or all of them if n is negative.
+// Runes of the same frequency are in the order of the code points.
+func (s *stats) top(n int) []*runeStats {
+	ret := make([]*runeStats, 0, len(s.runes))
+	for _, v := range s.runes {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].count != ret[j].count {
+			return ret[i].count > ret[j].count
+		}
+		return ret[i].rune < ret[j].rune
+	})
+	if n >= 0 && n < len(ret) {
+		ret = ret[:n]
+	}
+	return ret
+}
+
+// writeText writes the counts of the categories and the top n runes that are not valid in tables.
+func (s *stats) writeText(w io.Writer, n int) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	total := s.total()
+	fmt.Fprintln(tw, "CATEGORY\tCOUNT\tPERCENT")
+	for i, v := range s.counts[:categoryDisallowed] {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", categoryNames[i], v, percent(v, total))
+	}
+	fmt.Fprintf(tw, "total\t%d\t%s\n", total, percent(total, total))
+	v := s.counts[categoryDisallowed]
+	fmt.Fprintf(tw, "%s\t%d\t%s\n", categoryNames[categoryDisallowed], v, percent(v, total))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	top := s.top(n)
+	if len(top) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CODE POINT\tCHAR\tCOUNT\tREASON\tSUGGESTION\tEXAMPLES")
+	for _, v := range top {
+		fmt.Fprintf(tw, "%U\t%s\t%d\t%s\t%s\t", v.rune, quoteChar(v.rune), v.count, v.explanation.Reason, v.explanation.Alternative)
+		for i, e := range v.examples {
+			if i > 0 {
+				fmt.Fprint(tw, ", ")
+			}
+			fmt.Fprintf(tw, "%s:%d:%d", e.path, e.line, e.column)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// percent returns n in percent of total, e.g. "12.5%".
+func percent(n, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
+
+// The statistics in JSON.
+type (
+	jsonStats struct {
+		Files      int            `json:"files"`
+		Characters int            `json:"characters"`
+		Categories map[string]int `json:"categories"`
+		OutOfSet   []jsonRune     `json:"out_of_set"`
+	}
+	jsonRune struct {
+		Rune       string         `json:"rune"`
+		CodePoint  string         `json:"code_point"`
+		Count      int            `json:"count"`
+		Reason     string         `json:"reason"`
+		Suggestion string         `json:"suggestion,omitempty"`
+		Examples   []jsonLocation `json:"examples"`
+	}
+	jsonLocation struct {
+		Path   string `json:"path"`
+		Line   int    `json:"line"`
+		Column int    `json:"column"`
+		Offset int    `json:"offset"`
+	}
+)
+
+// writeJSON writes the counts of the categories and the top n runes that are not valid in a JSON object.
+func (s *stats) writeJSON(w io.Writer, n int) error {
+	ret := jsonStats{
+		Files:      s.files,
+		Characters: s.total(),
+		Categories: make(map[string]int, numCategories),
+		OutOfSet:   []jsonRune{},
+	}
+	for i, v := range s.counts {
+		ret.Categories[categoryKeys[i]] = v
+	}
+	for _, v := range s.top(n) {
+		r := jsonRune{
+			Rune:       string(v.rune),
+			CodePoint:  fmt.Sprintf("%U", v.rune),
+			Count:      v.count,
+			Reason:     v.explanation.Reason.String(),
+			Suggestion: v.explanation.Alternative,
+			Examples:   []jsonLocation{},
+		}
+		for _, e := range v.examples {
+			r.Examples = append(r.Examples, jsonLocation{Path: e.path, Line: e.line, Column: e.column, Offset: e.offset})
+		}
+		ret.OutOfSet = append(ret.OutOfSet, r)
+	}
+	return writeJSON(w, ret)
+}
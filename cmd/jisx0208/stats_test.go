@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       []byte
+		e       *textEncoding
+		d       *jisx0208.Discriminator
+		want    map[category]int
+		wantErr bool
+	}{
+		{
+			name: "categories",
+			b:    []byte("ab 髙橋さん①\n亞ｱ─\xff"),
+			e:    encodingUTF8,
+			d:    jisx0208.NewDiscriminator(),
+			want: map[category]int{categoryASCII: 4, categoryKana: 2, categorySymbol: 1, categoryLevel1: 1, categoryLevel2: 1, categoryOutOfSet: 3, categoryInvalid: 1},
+		},
+		{
+			name: "allowed and disallowed",
+			b:    []byte("髙あ"),
+			e:    encodingUTF8,
+			d:    jisx0208.NewDiscriminator(jisx0208.Allow('髙'), jisx0208.Disallow('あ')),
+			want: map[category]int{categoryAllowed: 1, categoryKana: 1, categoryDisallowed: 1},
+		},
+		{
+			name: "level 1",
+			b:    []byte("亜弌"),
+			e:    encodingUTF8,
+			d:    jisx0208.NewDiscriminator(jisx0208.DisallowTable(jisx0208.Level2RangeTable)),
+			want: map[category]int{categoryLevel1: 1, categoryLevel2: 1, categoryDisallowed: 1},
+		},
+		{
+			name: "Shift_JIS",
+			b:    []byte("\x8d\x82\x8b\xb4\xfb\xfc"),
+			e:    encodingShiftJIS,
+			d:    jisx0208.NewDiscriminator(),
+			want: map[category]int{categoryLevel1: 2, categoryOutOfSet: 1},
+		},
+		{
+			name:    "not UTF-8",
+			b:       []byte("\x8d\x82\x8b\xb4\x82\xb3\x82\xf1\x82\xc5\x82\xb7"),
+			e:       encodingUTF8,
+			d:       jisx0208.NewDiscriminator(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, v, err := count("a.txt", tt.b, tt.e, tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("count() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var want [numCategories]int
+			for k, n := range tt.want {
+				want[k] = n
+			}
+			if got != want {
+				t.Errorf("count() = %v, want %v", got, want)
+			}
+			if n := want[categoryDisallowed] + want[categoryOutOfSet] + want[categoryInvalid]; len(v) != n {
+				t.Errorf("count() violations = %v, want %d", v, n)
+			}
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	d := jisx0208.NewDiscriminator()
+	s := stats{examples: 2}
+	for _, f := range []struct {
+		path string
+		text string
+	}{
+		{path: "a.txt", text: "髙橋\n①髙"},
+		{path: "b.txt", text: "髙①ｱ"},
+	} {
+		counts, v, err := count(f.path, []byte(f.text), encodingUTF8, d)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		s.add(counts, v)
+	}
+	t.Run("text", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := s.writeText(&b, 2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, want := range []string{
+			"ASCII          1      12.5%",
+			"level 1        1      12.5%",
+			"out-of-set     6      75.0%",
+			"total          8      100.0%",
+			"disallowed     0      0.0%",
+			"U+9AD9      髙     3      IBM extended character  高           a.txt:1:1, a.txt:2:2",
+			"U+2460      ①     2      NEC special character               a.txt:2:1, b.txt:1:2",
+		} {
+			if !strings.Contains(b.String(), want) {
+				t.Errorf("got\n%s\nwant to contain %q", b.String(), want)
+			}
+		}
+		if strings.Contains(b.String(), "U+FF71") {
+			t.Errorf("got\n%s\nwant the top 2", b.String())
+		}
+	})
+	t.Run("json", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := s.writeJSON(&b, -1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var got jsonStats
+		if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Files != 2 || got.Characters != 8 || got.Categories["out_of_set"] != 6 || got.Categories["level1"] != 1 {
+			t.Errorf("got %+v", got)
+		}
+		if len(got.OutOfSet) != 3 || got.OutOfSet[0].CodePoint != "U+9AD9" || got.OutOfSet[0].Count != 3 || len(got.OutOfSet[0].Examples) != 2 || got.OutOfSet[2].Rune != "ｱ" {
+			t.Errorf("got %+v", got.OutOfSet)
+		}
+	})
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// runTable prints the code chart of JIS X 0208 row by row, in the layout of the reference table
+// in testdata/jisx0208.html: each row is six lines of sixteen cells. The chart is of the -edition,
+// in which the code points added in a later edition are left out as unassigned, and the kanji that
+// JIS C 6226-1983 moved are at their code points of the edition. The characters added or moved
+// up to the edition since JIS C 6226-1978, and the glyphs changed up to the edition, are marked.
+//
+//	jisx0208 table [-row N] [-level 1|2] [-edition 1983] [-format text|html|csv]
+func runTable(args []string) error {
+	fs := flag.NewFlagSet("table", flag.ContinueOnError)
+	row := fs.Int("row", 0, "print only the row `n` from 1 to 94")
+	level := fs.Int("level", 0, "print only the rows of the kanji of JIS level `n` (1 or 2)")
+	edition := fs.Int("edition", int(jisx0208.Edition1990), "print the chart of the `edition`: 1978, 1983 or 1990")
+	format := fs.String("format", "text", "output `format`: text, html or csv")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jisx0208 table [-row n] [-level 1|2] [-edition 1978|1983|1990] [-format text|html|csv]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	rows, err := chartRows(*row, *level)
+	if err != nil {
+		return err
+	}
+	e := jisx0208.Edition(*edition)
+	switch e {
+	case jisx0208.Edition1978, jisx0208.Edition1983, jisx0208.Edition1990:
+	default:
+		return fmt.Errorf("invalid edition %d, want 1978, 1983 or 1990", *edition)
+	}
+	var chart [][]chartLine
+	for _, ku := range rows {
+		chart = append(chart, chartRow(ku, e))
+	}
+	switch *format {
+	case "text":
+		return writeChartText(os.Stdout, chart)
+	case "html":
+		return writeChartHTML(os.Stdout, chart, e)
+	case "csv":
+		return writeChartCSV(os.Stdout, chart)
+	}
+	return fmt.Errorf("unknown format %q, want text, html or csv", *format)
+}
+
+// chartRows returns the rows to print: the row if it is given, the rows of the kanji of the level
+// if it is given, or else the rows that have characters, 1 to 8 and 16 to 84.
+func chartRows(row, level int) ([]int, error) {
+	var from, to int
+	switch level {
+	case 0:
+		from, to = 1, 84
+	case 1:
+		from, to = 16, 47
+	case 2:
+		from, to = 48, 84
+	default:
+		return nil, fmt.Errorf("invalid level %d, want 1 or 2", level)
+	}
+	if row != 0 {
+		if row < 1 || row > 94 {
+			return nil, fmt.Errorf("invalid row %d, want 1 to 94", row)
+		}
+		if row < from || row > to {
+			return nil, fmt.Errorf("row %d is not of level %d", row, level)
+		}
+		return []int{row}, nil
+	}
+	var ret []int
+	for ku := from; ku <= to; ku++ {
+		if ku <= 8 || ku >= 16 {
+			ret = append(ret, ku)
+		}
+	}
+	return ret, nil
+}
+
+// chartLine is a line of sixteen cells of a row, from the cell ten, which is a multiple of 16.
+// The cells out of 1 to 94 and the unassigned cells are zero.
+type chartLine struct {
+	ku, ten int
+	cells   [16]chartCell
+}
+
+// code returns the code point of the first cell of the line, which is not valid for the cell 0.
+func (l chartLine) code() jisx0208.Kuten {
+	return jisx0208.Kuten{Ku: l.ku, Ten: l.ten}
+}
+
+// chartCell is a character in the chart with the kind of its mark.
+type chartCell struct {
+	rune rune
+	kind cellKind
+}
+
+// cellKind is the kind of change of a character since JIS C 6226-1978.
+type cellKind int
+
+const (
+	cellUnchanged cellKind = iota
+	cellAssigned1983
+	cellAssigned1990
+	cellGlyph1983
+	cellGlyph1990
+	numCellKinds
+)
+
+// cellKinds is the marks of the text chart, and the classes and the titles of the HTML chart,
+// of the kinds of change. The classes of the reference table do not tell glyph changes from
+// additions and moves.
+var cellKinds = [numCellKinds]struct {
+	mark, class, title, legend string
+}{
+	cellUnchanged:    {class: "cha"},
+	cellAssigned1983: {mark: "*", class: "cha_jis83", title: "JIS83追加・移動", legend: "added or moved in JIS C 6226-1983"},
+	cellAssigned1990: {mark: "+", class: "cha_jis90", title: "JIS90追加", legend: "added in JIS X 0208-1990"},
+	cellGlyph1983:    {mark: "~", class: "glyph_jis83", title: "JIS83字形変更", legend: "glyph changed in JIS C 6226-1983"},
+	cellGlyph1990:    {mark: "^", class: "glyph_jis90", title: "JIS90字形変更", legend: "glyph changed in JIS X 0208-1990"},
+}
+
+func (c chartCell) mark() string {
+	return cellKinds[c.kind].mark
+}
+
+func (c chartCell) class() string {
+	return cellKinds[c.kind].class
+}
+
+// chartRow returns the six lines of the row in the edition. The code points that a later edition
+// added are unassigned, and the kanji that a later edition moved are at their code points of the edition.
+func chartRow(ku int, e jisx0208.Edition) []chartLine {
+	ret := make([]chartLine, 6)
+	for i := range ret {
+		ret[i].ku, ret[i].ten = ku, i*16
+		for j := range ret[i].cells {
+			r, ok := jisx0208.Kuten{Ku: ku, Ten: i*16 + j}.Rune()
+			if !ok {
+				continue
+			}
+			if v, ok := moved1983[r]; ok && e < jisx0208.Edition1983 {
+				r = v
+			}
+			a, _ := jisx0208.EditionOf(r)
+			if a > e {
+				if addedRow(ku) {
+					continue
+				}
+				a = jisx0208.Edition1978 // at its code point of JIS C 6226-1978
+			}
+			c := chartCell{rune: r}
+			g, ok := jisx0208.GlyphChangeOf(r)
+			switch {
+			case a == jisx0208.Edition1983:
+				c.kind = cellAssigned1983
+			case a == jisx0208.Edition1990:
+				c.kind = cellAssigned1990
+			case ok && g == jisx0208.Edition1983 && g <= e:
+				c.kind = cellGlyph1983
+			case ok && g == jisx0208.Edition1990 && g <= e:
+				c.kind = cellGlyph1990
+			}
+			ret[i].cells[j] = c
+		}
+	}
+	return ret
+}
+
+// moved1983 maps the kanji that JIS C 6226-1983 moved to the kanji at their code points in
+// JIS C 6226-1978: the 22 pairs that were swapped, and the simplified forms 尭槙遥瑶 that took
+// the code points of 堯槇遙瑤, which were moved to 84-1 to 84-4.
+var moved1983 = func() map[rune]rune {
+	ret := map[rune]rune{'尭': '堯', '槙': '槇', '遥': '遙', '瑶': '瑤'}
+	pairs := []rune("鯵鰺鴬鶯蛎蠣撹攪竃竈潅灌諌諫頚頸砿礦蕊蘂靭靱賎賤壷壺砺礪梼檮涛濤迩邇蝿蠅桧檜侭儘薮藪篭籠")
+	for i := 0; i < len(pairs); i += 2 {
+		ret[pairs[i]], ret[pairs[i+1]] = pairs[i+1], pairs[i]
+	}
+	return ret
+}()
+
+// addedRow reports whether the characters of the row that the later editions assigned were added
+// to unassigned code points: the non-kanji rows and row 84. Elsewhere, they were moved.
+func addedRow(ku int) bool {
+	return ku <= 8 || ku == 84
+}
+
+// writeChartText writes the chart with a cell of two columns and a mark, as Width counts,
+// "--" for an unassigned cell, and the legend of the marks.
+func writeChartText(w io.Writer, chart [][]chartLine) error {
+	bw := &errWriter{w: w}
+	for i, row := range chart {
+		if i > 0 {
+			bw.printf("\n")
+		}
+		bw.printf("ku ten JIS  SJIS EUC ")
+		for j := 0; j < 16; j++ {
+			bw.printf(" +%X", j)
+			if j < 15 {
+				bw.printf(" ")
+			}
+		}
+		bw.printf("\n")
+		for _, l := range row {
+			bw.printf("%s\n", l.text())
+		}
+	}
+	bw.printf("\n-- unassigned")
+	for _, k := range chartKinds(chart) {
+		bw.printf(", %s %s", cellKinds[k].mark, cellKinds[k].legend)
+	}
+	bw.printf("\n")
+	return bw.err
+}
+
+// chartKinds returns the kinds of change of the characters in the chart, other than unchanged.
+func chartKinds(chart [][]chartLine) []cellKind {
+	var found [numCellKinds]bool
+	for _, row := range chart {
+		for _, l := range row {
+			for _, c := range l.cells {
+				if c.rune != 0 {
+					found[c.kind] = true
+				}
+			}
+		}
+	}
+	var ret []cellKind
+	for k := cellUnchanged + 1; k < numCellKinds; k++ {
+		if found[k] {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// text returns the line of the text chart, with the row only in the first line.
+func (l chartLine) text() string {
+	var b strings.Builder
+	ku := ""
+	if l.ten == 0 {
+		ku = strconv.Itoa(l.ku)
+	}
+	k := l.code()
+	fmt.Fprintf(&b, "%2s %3d %04X %04X %04X", ku, l.ten, k.JIS(), k.ShiftJIS(), k.EUCJP())
+	for _, c := range l.cells {
+		if c.rune == 0 {
+			b.WriteString(" -- ")
+			continue
+		}
+		fmt.Fprintf(&b, " %c%-1s", c.rune, c.mark())
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+// writeChartHTML writes the chart as an HTML document with a table for each row and the classes
+// of the cells of the reference table: cha for the characters, cha_jis83 and cha_jis90 for the
+// characters added or moved, and blnk for the unassigned cells, and in addition glyph_jis83 and
+// glyph_jis90 for the glyph changes.
+func writeChartHTML(w io.Writer, chart [][]chartLine, e jisx0208.Edition) error {
+	bw := &errWriter{w: w}
+	bw.printf(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>JIS X 0208 (%s)</title>
+<style>
+table.basic { border-collapse: collapse; margin-bottom: 1em; }
+table.basic th, table.basic td { border: 1px solid #999; padding: 2px 4px; text-align: center; }
+td.blnk { background: #ddd; }
+td.cha_jis83 { background: #cfc; }
+td.cha_jis90 { background: #fcc; }
+td.glyph_jis83 { background: #eff; }
+td.glyph_jis90 { background: #fef; }
+</style>
+</head>
+<body>
+<h1>JIS X 0208 (%s)</h1>
+`, e, e)
+	for _, row := range chart {
+		bw.printf("<table class=\"basic\" summary=\"JIS X 0208 %d区\">\n  <tr>\n    <th>区</th>\n    <th>点</th>\n    <th>JIS</th>\n    <th>SJIS</th>\n    <th>EUC</th>\n", row[0].ku)
+		for j := 0; j < 16; j++ {
+			bw.printf("    <th>+%X</th>\n", j)
+		}
+		bw.printf("  </tr>\n")
+		for _, l := range row {
+			bw.printf("  <tr>\n")
+			if l.ten == 0 {
+				bw.printf("    <th class=\"v\" rowspan=\"%d\">%d</th>\n", len(row), l.ku)
+			}
+			k := l.code()
+			bw.printf("    <th class=\"v\">%d</th>\n    <th class=\"v\">%04X</th>\n    <th class=\"v\">%04X</th>\n    <th class=\"v\">%04X</th>\n", l.ten, k.JIS(), k.ShiftJIS(), k.EUCJP())
+			for _, c := range l.cells {
+				switch {
+				case c.rune == 0:
+					bw.printf("    <td class=\"blnk\"></td>\n")
+				case c.kind == cellUnchanged:
+					bw.printf("    <td class=\"cha\">%s</td>\n", html.EscapeString(string(c.rune)))
+				default:
+					bw.printf("    <td class=\"%s\" title=\"%s\">%s</td>\n", c.class(), cellKinds[c.kind].title, html.EscapeString(string(c.rune)))
+				}
+			}
+			bw.printf("  </tr>\n")
+		}
+		bw.printf("</table>\n")
+	}
+	bw.printf("<ul>\n")
+	for _, k := range chartKinds(chart) {
+		bw.printf("  <li><span class=\"%s\">%s</span> %s</li>\n", cellKinds[k].class, cellKinds[k].mark, cellKinds[k].legend)
+	}
+	bw.printf("</ul>\n</body>\n</html>\n")
+	return bw.err
+}
+
+// writeChartCSV writes the chart with a record for each line, the cells of which are the characters
+// with the marks of the text chart, or empty for the unassigned cells.
+func writeChartCSV(w io.Writer, chart [][]chartLine) error {
+	cw := csv.NewWriter(w)
+	header := []string{"ku", "ten", "JIS", "SJIS", "EUC"}
+	for j := 0; j < 16; j++ {
+		header = append(header, fmt.Sprintf("+%X", j))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range chart {
+		for _, l := range row {
+			k := l.code()
+			record := []string{strconv.Itoa(l.ku), strconv.Itoa(l.ten), fmt.Sprintf("%04X", k.JIS()), fmt.Sprintf("%04X", k.ShiftJIS()), fmt.Sprintf("%04X", k.EUCJP())}
+			for _, c := range l.cells {
+				if c.rune == 0 {
+					record = append(record, "")
+					continue
+				}
+				record = append(record, string(c.rune)+c.mark())
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// errWriter writes formatted text until the first error, which it keeps.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *errWriter) printf(format string, a ...any) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, a...)
+	}
+}
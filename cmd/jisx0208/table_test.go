@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"html"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestChart_Reference(t *testing.T) {
+	b, err := os.ReadFile("../../testdata/jisx0208.html")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := regexp.MustCompile(`<td class="(\w+)"[^>]*>([^<]*)</td>`).FindAllStringSubmatch(string(b), -1)
+	rows, err := chartRows(0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []chartCell
+	for _, ku := range rows {
+		for _, l := range chartRow(ku, jisx0208.Edition1990) {
+			got = append(got, l.cells[:]...)
+		}
+	}
+	// the reference table leaves out the empty lines of the last row
+	if len(got) < len(want) {
+		t.Fatalf("got %d cells, want %d or more", len(got), len(want))
+	}
+	// the classes of the reference table mark both the glyph changes and the additions and moves
+	classes := map[string][]string{
+		"blnk":        {"blnk"},
+		"cha":         {"cha"},
+		"cha_jis83":   {"cha_jis83", "glyph_jis83"},
+		"cha_jis90":   {"cha_jis90", "glyph_jis90"},
+		"cha_jis8390": {"cha_jis83", "glyph_jis90"},
+	}
+	for i, c := range got {
+		class := "blnk"
+		if c.rune != 0 {
+			class = c.class()
+		}
+		w := []string{"blnk"}
+		if i < len(want) && want[i][2] != "" { // the cell 23-95 of the reference table is an empty character
+			w = classes[want[i][1]]
+			if string(c.rune) != html.UnescapeString(want[i][2]) {
+				t.Errorf("cell %d: %q, want %q", i, c.rune, want[i][2])
+			}
+		}
+		if class != w[0] && (len(w) == 1 || class != w[1]) {
+			t.Errorf("cell %d: class %s %q, want %v", i, class, c.rune, w)
+		}
+	}
+}
+
+func TestChartRow(t *testing.T) {
+	tests := []struct {
+		name    string
+		kuten   jisx0208.Kuten
+		edition jisx0208.Edition
+		want    chartCell
+	}{
+		{name: "unchanged", kuten: jisx0208.Kuten{Ku: 16, Ten: 1}, edition: jisx0208.Edition1978, want: chartCell{rune: '亜'}},
+		{name: "glyph changed in 1990", kuten: jisx0208.Kuten{Ku: 16, Ten: 46}, edition: jisx0208.Edition1990, want: chartCell{rune: '偉', kind: cellGlyph1990}},
+		{name: "glyph changed after 1983", kuten: jisx0208.Kuten{Ku: 16, Ten: 46}, edition: jisx0208.Edition1983, want: chartCell{rune: '偉'}},
+		{name: "glyph changed in 1983", kuten: jisx0208.Kuten{Ku: 16, Ten: 2}, edition: jisx0208.Edition1983, want: chartCell{rune: '唖', kind: cellGlyph1983}},
+		{name: "glyph changed after 1978", kuten: jisx0208.Kuten{Ku: 16, Ten: 2}, edition: jisx0208.Edition1978, want: chartCell{rune: '唖'}},
+		{name: "moved in 1983", kuten: jisx0208.Kuten{Ku: 16, Ten: 19}, edition: jisx0208.Edition1983, want: chartCell{rune: '鯵', kind: cellAssigned1983}},
+		{name: "swapped before 1983", kuten: jisx0208.Kuten{Ku: 16, Ten: 19}, edition: jisx0208.Edition1978, want: chartCell{rune: '鰺'}},
+		{name: "swapped pair before 1983", kuten: jisx0208.Kuten{Ku: 82, Ten: 45}, edition: jisx0208.Edition1978, want: chartCell{rune: '鯵'}},
+		{name: "simplified in 1983", kuten: jisx0208.Kuten{Ku: 22, Ten: 38}, edition: jisx0208.Edition1983, want: chartCell{rune: '尭', kind: cellAssigned1983}},
+		{name: "simplified after 1978", kuten: jisx0208.Kuten{Ku: 22, Ten: 38}, edition: jisx0208.Edition1978, want: chartCell{rune: '堯'}},
+		{name: "moved to row 84 after 1978", kuten: jisx0208.Kuten{Ku: 84, Ten: 1}, edition: jisx0208.Edition1978},
+		{name: "added in 1983", kuten: jisx0208.Kuten{Ku: 2, Ten: 26}, edition: jisx0208.Edition1983, want: chartCell{rune: '∈', kind: cellAssigned1983}},
+		{name: "added after 1978", kuten: jisx0208.Kuten{Ku: 2, Ten: 26}, edition: jisx0208.Edition1978},
+		{name: "added in 1990", kuten: jisx0208.Kuten{Ku: 84, Ten: 5}, edition: jisx0208.Edition1990, want: chartCell{rune: '凜', kind: cellAssigned1990}},
+		{name: "added after 1983", kuten: jisx0208.Kuten{Ku: 84, Ten: 5}, edition: jisx0208.Edition1983},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chartRow(tt.kuten.Ku, tt.edition)[tt.kuten.Ten/16].cells[tt.kuten.Ten%16]
+			if got != tt.want {
+				t.Errorf("chartRow(%d, %d) at %v = %+v, want %+v", tt.kuten.Ku, tt.edition, tt.kuten, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChartRows(t *testing.T) {
+	tests := []struct {
+		name      string
+		row       int
+		level     int
+		wantFirst int
+		wantLast  int
+		wantLen   int
+		wantErr   bool
+	}{
+		{name: "all", wantFirst: 1, wantLast: 84, wantLen: 77},
+		{name: "level 1", level: 1, wantFirst: 16, wantLast: 47, wantLen: 32},
+		{name: "level 2", level: 2, wantFirst: 48, wantLast: 84, wantLen: 37},
+		{name: "row", row: 9, wantFirst: 9, wantLast: 9, wantLen: 1},
+		{name: "row of level", row: 20, level: 1, wantFirst: 20, wantLast: 20, wantLen: 1},
+		{name: "row not of level", row: 3, level: 1, wantErr: true},
+		{name: "invalid row", row: 95, wantErr: true},
+		{name: "invalid level", level: 3, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := chartRows(tt.row, tt.level)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("chartRows(%d, %d) error = %v, wantErr %v", tt.row, tt.level, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != tt.wantLen || got[0] != tt.wantFirst || got[len(got)-1] != tt.wantLast {
+				t.Errorf("chartRows(%d, %d) = %v, want %d rows from %d to %d", tt.row, tt.level, got, tt.wantLen, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestWriteChart(t *testing.T) {
+	chart := [][]chartLine{chartRow(16, jisx0208.Edition1983), chartRow(84, jisx0208.Edition1983)}
+	tests := []struct {
+		name  string
+		write func(b *bytes.Buffer) error
+		want  []string
+	}{
+		{
+			name:  "text",
+			write: func(b *bytes.Buffer) error { return writeChartText(b, chart) },
+			want: []string{
+				"ku ten JIS  SJIS EUC  +0  +1  +2  +3  +4  +5  +6  +7  +8  +9  +A  +B  +C  +D  +E  +F",
+				"16   0 3020 889E B0A0 --  亜  唖~ 娃  阿  哀  愛  挨  姶  逢~ 葵  茜  穐  悪  握  渥",
+				"    16 3030 88AE B0B0 旭  葦  芦~ 鯵* 梓  圧  斡  扱  宛  姐  虻  飴~ 絢  綾  鮎  或",
+				"84   0 7420 EA9E F4A0 --  堯* 槇* 遙* 瑤* --  --  --  --  --  --  --  --  --  --  --",
+				"-- unassigned, * added or moved in JIS C 6226-1983, ~ glyph changed in JIS C 6226-1983\n",
+			},
+		},
+		{
+			name:  "html",
+			write: func(b *bytes.Buffer) error { return writeChartHTML(b, chart, jisx0208.Edition1983) },
+			want: []string{
+				`<table class="basic" summary="JIS X 0208 84区">`,
+				"    <th class=\"v\" rowspan=\"6\">84</th>\n    <th class=\"v\">0</th>\n    <th class=\"v\">7420</th>\n    <th class=\"v\">EA9E</th>\n    <th class=\"v\">F4A0</th>\n    <td class=\"blnk\"></td>\n    <td class=\"cha_jis83\" title=\"JIS83追加・移動\">堯</td>",
+				"    <td class=\"cha_jis83\" title=\"JIS83追加・移動\">瑤</td>\n    <td class=\"blnk\"></td>\n",
+				"    <td class=\"cha\">亜</td>\n    <td class=\"glyph_jis83\" title=\"JIS83字形変更\">唖</td>\n",
+				"  </tr>\n</table>\n<ul>\n",
+				"  <li><span class=\"glyph_jis83\">~</span> glyph changed in JIS C 6226-1983</li>\n</ul>\n",
+			},
+		},
+		{
+			name:  "csv",
+			write: func(b *bytes.Buffer) error { return writeChartCSV(b, chart) },
+			want: []string{
+				"ku,ten,JIS,SJIS,EUC,+0,+1,+2,+3,+4,+5,+6,+7,+8,+9,+A,+B,+C,+D,+E,+F\n",
+				"84,0,7420,EA9E,F4A0,,堯*,槇*,遙*,瑤*,,,,,,,,,,,\n",
+				"84,80,7470,EAEE,F4F0,,,,,,,,,,,,,,,,\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := tt.write(&b); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(b.String(), want) {
+					t.Errorf("got\n%s\nwant to contain\n%s", b.String(), want)
+				}
+			}
+		})
+	}
+}
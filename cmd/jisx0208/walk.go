@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// input is a file to process.
+type input struct {
+	name string
+	// walked reports whether the file is found in a directory, in which case binary files are skipped.
+	walked bool
+}
+
+// filter is the glob patterns of the files to process in directories. A pattern without a slash
+// matches the base name, and one with a slash matches the path relative to the directory argument,
+// where ** matches any number of directories.
+type filter struct {
+	include stringList
+	exclude stringList
+}
+
+// inputFlags is the flags of the files to process, shared by the subcommands.
+type inputFlags struct {
+	filter  filter
+	workers int
+}
+
+// register defines the flags of the inputs in the flag set.
+func (f *inputFlags) register(fs *flag.FlagSet) {
+	fs.Var(&f.filter.include, "include", "process only the files matching the `glob` in directories (repeatable)")
+	fs.Var(&f.filter.exclude, "exclude", "skip the files and directories matching the `glob` (repeatable)")
+	fs.IntVar(&f.workers, "workers", runtime.NumCPU(), "number of files processed in parallel")
+}
+
+// collectInputs returns the files of the arguments: glob patterns are expanded, and directories
+// are walked recursively in lexical order, skipping .git, the files ignored by .gitignore and
+// the files filtered out. The standard input is returned if no arguments are given.
+func collectInputs(args []string, f filter) ([]input, error) {
+	names, err := expandArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	var ret []input
+	for _, name := range names {
+		if name == stdin {
+			ret = append(ret, input{name: name})
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			ret = append(ret, input{name: name})
+			continue
+		}
+		v, err := walkDir(name, f)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v...)
+	}
+	return ret, nil
+}
+
+func walkDir(root string, f filter) ([]input, error) {
+	var ret []input
+	ignore, err := loadParentIgnores(root)
+	if err != nil {
+		return nil, err
+	}
+	err = filepath.WalkDir(root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return ignore.load(name)
+		}
+		switch {
+		case d.IsDir() && d.Name() == ".git",
+			ignore.match(name, d.IsDir()),
+			matchAny(f.exclude, rel):
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		case d.IsDir():
+			return ignore.load(name)
+		case !d.Type().IsRegular():
+			return nil
+		case len(f.include) > 0 && !matchAny(f.include, rel):
+			return nil
+		}
+		ret = append(ret, input{name: name, walked: true})
+		return nil
+	})
+	return ret, err
+}
+
+// loadParentIgnores returns the rules of the .gitignore files in the parent directories of dir
+// up to the root of the git repository, if dir is in a git repository.
+func loadParentIgnores(dir string) (gitignore, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(abs, ".git")); err == nil {
+		return nil, nil // dir is the root of the repository
+	}
+	var parents []string
+	for d := filepath.Dir(abs); ; d = filepath.Dir(d) {
+		parents = append(parents, d)
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			var ret gitignore
+			for i := len(parents) - 1; i >= 0; i-- {
+				if err := ret.load(parents[i]); err != nil {
+					return nil, err
+				}
+			}
+			return ret, nil
+		}
+		if d == filepath.Dir(d) {
+			break
+		}
+	}
+	return nil, nil
+}
+
+// matchAny reports whether any of the patterns matches the slash-separated relative path.
+func matchAny(patterns []string, rel string) bool {
+	for _, p := range patterns {
+		if !strings.Contains(strings.TrimSuffix(p, "/"), "/") {
+			if ok, _ := path.Match(p, path.Base(rel)); ok {
+				return true
+			}
+			continue
+		}
+		if matchPath(strings.TrimPrefix(p, "/"), rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath reports whether the slash-separated pattern matches the path, where ** matches
+// any number of path elements.
+func matchPath(pattern, name string) bool {
+	return matchElems(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchElems(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElems(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// gitignore is the rules of the .gitignore files found in a walk.
+type gitignore []ignoreRule
+
+type ignoreRule struct {
+	// base is the absolute slash-separated directory of the .gitignore file.
+	base    string
+	pattern string
+	negate  bool
+	dirOnly bool
+}
+
+// load reads the rules of the .gitignore file in the directory, if any.
+func (g *gitignore) load(dir string) error {
+	base, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	fp, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+	s := bufio.NewScanner(fp)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r := ignoreRule{base: strings.TrimSuffix(filepath.ToSlash(base), "/")}
+		if r.negate = strings.HasPrefix(line, "!"); r.negate {
+			line = line[1:]
+		}
+		line = strings.TrimPrefix(line, `\`)
+		if r.dirOnly = strings.HasSuffix(line, "/"); r.dirOnly {
+			line = strings.TrimSuffix(line, "/")
+		}
+		r.pattern = line
+		*g = append(*g, r)
+	}
+	return s.Err()
+}
+
+// match reports whether the file is ignored. As in git, the last matching rule wins,
+// and deeper .gitignore files are loaded later.
+func (g gitignore) match(name string, isDir bool) bool {
+	if len(g) == 0 {
+		return false
+	}
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return false
+	}
+	abs = filepath.ToSlash(abs)
+	var ret bool
+	for _, r := range g {
+		if !strings.HasPrefix(abs, r.base+"/") {
+			continue
+		}
+		rel := abs[len(r.base)+1:]
+		if r.dirOnly && !isDir {
+			continue
+		}
+		if matchAny([]string{r.pattern}, rel) {
+			ret = !r.negate
+		}
+	}
+	return ret
+}
+
+// isBinary reports whether b looks like a binary file: it has a NUL byte in the first 8000 bytes, as git does.
+func isBinary(b []byte) bool {
+	if len(b) > 8000 {
+		b = b[:8000]
+	}
+	return bytes.IndexByte(b, 0) >= 0
+}
+
+// parallel calls f with the indexes from 0 to n-1 in the workers, and calls g with the results
+// in the order of the indexes, until g returns false.
+func parallel[T any](n, workers int, f func(i int) T, g func(i int, v T) bool) {
+	if workers < 1 {
+		workers = 1
+	}
+	var (
+		results = make([]chan T, n)
+		jobs    = make(chan int)
+		done    = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	for i := range results {
+		results[i] = make(chan T, 1)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] <- f(i)
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := 0; i < n; i++ {
+			select {
+			case jobs <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	for i := 0; i < n; i++ {
+		if !g(i, <-results[i]) {
+			break
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+// errorf formats the error of the file.
+func errorf(name string, err error) error {
+	return fmt.Errorf("%s: %w", displayName(name), err)
+}
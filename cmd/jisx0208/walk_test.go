@@ -0,0 +1,134 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCollectInputs(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".gitignore":           "vendor/\n*.log\n!keep.log\n/root.txt\n",
+		".git/config":          "",
+		"a/x.txt":              "",
+		"a/b/y.md":             "",
+		"a/skip.log":           "",
+		"a/keep.log":           "",
+		"a/root.txt":           "", // not ignored, /root.txt is anchored
+		"a/.gitignore":         "y.md\n",
+		"root.txt":             "",
+		"vendor/z.txt":         "",
+		"docs/generated/g.txt": "",
+	}
+	for name, s := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := os.WriteFile(name, []byte(s), 0o644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	tests := []struct {
+		name   string
+		args   []string
+		filter filter
+		want   []string
+	}{
+		{
+			name: "all",
+			args: []string{dir},
+			want: []string{".gitignore", "a/.gitignore", "a/keep.log", "a/root.txt", "a/x.txt", "docs/generated/g.txt"},
+		},
+		{
+			name:   "include",
+			args:   []string{dir},
+			filter: filter{include: stringList{"*.txt"}},
+			want:   []string{"a/root.txt", "a/x.txt", "docs/generated/g.txt"},
+		},
+		{
+			name:   "exclude",
+			args:   []string{dir},
+			filter: filter{include: stringList{"*.txt"}, exclude: stringList{"docs/**/*.txt", "root.txt"}},
+			want:   []string{"a/x.txt"},
+		},
+		{
+			name: "parent .gitignore",
+			args: []string{filepath.Join(dir, "a")},
+			want: []string{"a/.gitignore", "a/keep.log", "a/root.txt", "a/x.txt"},
+		},
+		{
+			name: "ignored file given explicitly",
+			args: []string{filepath.Join(dir, "a", "skip.log")},
+			want: []string{"a/skip.log"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, err := collectInputs(tt.args, tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, v := range inputs {
+				rel, err := filepath.Rel(dir, v.name)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, filepath.ToSlash(rel))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("collectInputs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{pattern: "a/*.txt", name: "a/x.txt", want: true},
+		{pattern: "a/*.txt", name: "a/b/x.txt", want: false},
+		{pattern: "a/**/*.txt", name: "a/x.txt", want: true},
+		{pattern: "a/**/*.txt", name: "a/b/c/x.txt", want: true},
+		{pattern: "**/x.txt", name: "x.txt", want: true},
+		{pattern: "a/**", name: "a/b/c", want: true},
+		{pattern: "a/**", name: "b/c", want: false},
+	}
+	for _, tt := range tests {
+		if got := matchPath(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("matchPath(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsBinary(t *testing.T) {
+	if isBinary([]byte("テキスト\n")) {
+		t.Errorf("isBinary(text) = true")
+	}
+	if !isBinary([]byte("PNG\x00\x01")) {
+		t.Errorf("isBinary(binary) = false")
+	}
+}
+
+func TestParallel(t *testing.T) {
+	var got []int
+	parallel(100, 8, func(i int) int {
+		return i * i
+	}, func(i, v int) bool {
+		got = append(got, v)
+		return i < 49
+	})
+	if len(got) != 50 {
+		t.Fatalf("got %d results, want 50", len(got))
+	}
+	for i, v := range got {
+		if v != i*i {
+			t.Errorf("result %d = %d, want %d", i, v, i*i)
+		}
+	}
+}
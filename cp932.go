@@ -0,0 +1,90 @@
+package jisx0208
+
+import (
+	"sort"
+	"sync"
+)
+
+type cp932Char struct {
+	r    rune
+	sjis uint16
+}
+
+// cp932 returns the Shift_JIS code of the rune r if r is a CP932 vendor extended character.
+func cp932(r rune) (uint16, bool) {
+	i := sort.Search(len(cp932Table), func(i int) bool {
+		return cp932Table[i].r >= r
+	})
+	if i < len(cp932Table) && cp932Table[i].r == r {
+		return cp932Table[i].sjis, true
+	}
+	return 0, false
+}
+
+// cp932Rune returns the CP932 vendor extended character of the Shift_JIS code c.
+// NEC-selected IBM extended characters are resolved to the same characters as IBM extended ones.
+func cp932Rune(c uint16) (rune, bool) {
+	if v, ok := necSelectedToIBM(c); ok {
+		c = v
+	}
+	cp932IndexOnce.Do(func() {
+		cp932Index = make([]cp932Char, len(cp932Table))
+		copy(cp932Index, cp932Table)
+		sort.Slice(cp932Index, func(i, j int) bool {
+			return cp932Index[i].sjis < cp932Index[j].sjis
+		})
+	})
+	i := sort.Search(len(cp932Index), func(i int) bool {
+		return cp932Index[i].sjis >= c
+	})
+	if i < len(cp932Index) && cp932Index[i].sjis == c {
+		return cp932Index[i].r, true
+	}
+	return 0, false
+}
+
+// necSelectedToIBM returns the IBM extended character code of the NEC-selected IBM extended
+// character code c in 0xED40-0xEEFC. Both are in the same order except for the symbols at the end.
+func necSelectedToIBM(c uint16) (uint16, bool) {
+	i, ok := sjisIndex(c, 0xED)
+	switch {
+	case !ok:
+		return 0, false
+	case i < 360: // kanji from 0xFA5C
+		i += 28
+	case i >= 362 && i < 372: // small roman numerals from 0xFA40
+		i -= 362
+	case i >= 372 && i < 376: // ￢￤＇＂ from 0xFA54
+		i -= 352
+	default:
+		return 0, false
+	}
+	return sjisCode(i, 0xFA), true
+}
+
+// sjisIndex returns the index of the double-byte code c counted from the lead byte lead.
+func sjisIndex(c uint16, lead byte) (int, bool) {
+	hi, lo := byte(c>>8), byte(c)
+	if hi < lead || lo < 0x40 || lo == 0x7F || lo > 0xFC {
+		return 0, false
+	}
+	i := int(lo) - 0x40
+	if lo > 0x7F {
+		i--
+	}
+	return int(hi-lead)*188 + i, true
+}
+
+// sjisCode returns the double-byte code of the index counted from the lead byte lead.
+func sjisCode(i int, lead byte) uint16 {
+	lo := i%188 + 0x40
+	if lo >= 0x7F {
+		lo++
+	}
+	return uint16(int(lead)+i/188)<<8 | uint16(lo)
+}
+
+var (
+	cp932IndexOnce sync.Once
+	cp932Index     []cp932Char // sorted by Shift_JIS code
+)
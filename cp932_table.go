@@ -0,0 +1,454 @@
+// Code generated by tool/makecp932; DO NOT EDIT.
+
+package jisx0208
+
+// cp932Table is the CP932 vendor extended characters that are not in JIS X 0208, sorted by rune.
+var cp932Table = []cp932Char{
+	{r: 0x2116, sjis: 0x8782}, // №
+	{r: 0x2121, sjis: 0x8784}, // ℡
+	{r: 0x2160, sjis: 0x8754}, // Ⅰ
+	{r: 0x2161, sjis: 0x8755}, // Ⅱ
+	{r: 0x2162, sjis: 0x8756}, // Ⅲ
+	{r: 0x2163, sjis: 0x8757}, // Ⅳ
+	{r: 0x2164, sjis: 0x8758}, // Ⅴ
+	{r: 0x2165, sjis: 0x8759}, // Ⅵ
+	{r: 0x2166, sjis: 0x875A}, // Ⅶ
+	{r: 0x2167, sjis: 0x875B}, // Ⅷ
+	{r: 0x2168, sjis: 0x875C}, // Ⅸ
+	{r: 0x2169, sjis: 0x875D}, // Ⅹ
+	{r: 0x2170, sjis: 0xFA40}, // ⅰ
+	{r: 0x2171, sjis: 0xFA41}, // ⅱ
+	{r: 0x2172, sjis: 0xFA42}, // ⅲ
+	{r: 0x2173, sjis: 0xFA43}, // ⅳ
+	{r: 0x2174, sjis: 0xFA44}, // ⅴ
+	{r: 0x2175, sjis: 0xFA45}, // ⅵ
+	{r: 0x2176, sjis: 0xFA46}, // ⅶ
+	{r: 0x2177, sjis: 0xFA47}, // ⅷ
+	{r: 0x2178, sjis: 0xFA48}, // ⅸ
+	{r: 0x2179, sjis: 0xFA49}, // ⅹ
+	{r: 0x2211, sjis: 0x8794}, // ∑
+	{r: 0x221F, sjis: 0x8798}, // ∟
+	{r: 0x222E, sjis: 0x8793}, // ∮
+	{r: 0x22BF, sjis: 0x8799}, // ⊿
+	{r: 0x2460, sjis: 0x8740}, // ①
+	{r: 0x2461, sjis: 0x8741}, // ②
+	{r: 0x2462, sjis: 0x8742}, // ③
+	{r: 0x2463, sjis: 0x8743}, // ④
+	{r: 0x2464, sjis: 0x8744}, // ⑤
+	{r: 0x2465, sjis: 0x8745}, // ⑥
+	{r: 0x2466, sjis: 0x8746}, // ⑦
+	{r: 0x2467, sjis: 0x8747}, // ⑧
+	{r: 0x2468, sjis: 0x8748}, // ⑨
+	{r: 0x2469, sjis: 0x8749}, // ⑩
+	{r: 0x246A, sjis: 0x874A}, // ⑪
+	{r: 0x246B, sjis: 0x874B}, // ⑫
+	{r: 0x246C, sjis: 0x874C}, // ⑬
+	{r: 0x246D, sjis: 0x874D}, // ⑭
+	{r: 0x246E, sjis: 0x874E}, // ⑮
+	{r: 0x246F, sjis: 0x874F}, // ⑯
+	{r: 0x2470, sjis: 0x8750}, // ⑰
+	{r: 0x2471, sjis: 0x8751}, // ⑱
+	{r: 0x2472, sjis: 0x8752}, // ⑲
+	{r: 0x2473, sjis: 0x8753}, // ⑳
+	{r: 0x301D, sjis: 0x8780}, // 〝
+	{r: 0x301F, sjis: 0x8781}, // 〟
+	{r: 0x3231, sjis: 0x878A}, // ㈱
+	{r: 0x3232, sjis: 0x878B}, // ㈲
+	{r: 0x3239, sjis: 0x878C}, // ㈹
+	{r: 0x32A4, sjis: 0x8785}, // ㊤
+	{r: 0x32A5, sjis: 0x8786}, // ㊥
+	{r: 0x32A6, sjis: 0x8787}, // ㊦
+	{r: 0x32A7, sjis: 0x8788}, // ㊧
+	{r: 0x32A8, sjis: 0x8789}, // ㊨
+	{r: 0x3303, sjis: 0x8765}, // ㌃
+	{r: 0x330D, sjis: 0x8769}, // ㌍
+	{r: 0x3314, sjis: 0x8760}, // ㌔
+	{r: 0x3318, sjis: 0x8763}, // ㌘
+	{r: 0x3322, sjis: 0x8761}, // ㌢
+	{r: 0x3323, sjis: 0x876B}, // ㌣
+	{r: 0x3326, sjis: 0x876A}, // ㌦
+	{r: 0x3327, sjis: 0x8764}, // ㌧
+	{r: 0x332B, sjis: 0x876C}, // ㌫
+	{r: 0x3336, sjis: 0x8766}, // ㌶
+	{r: 0x333B, sjis: 0x876E}, // ㌻
+	{r: 0x3349, sjis: 0x875F}, // ㍉
+	{r: 0x334A, sjis: 0x876D}, // ㍊
+	{r: 0x334D, sjis: 0x8762}, // ㍍
+	{r: 0x3351, sjis: 0x8767}, // ㍑
+	{r: 0x3357, sjis: 0x8768}, // ㍗
+	{r: 0x337B, sjis: 0x877E}, // ㍻
+	{r: 0x337C, sjis: 0x878F}, // ㍼
+	{r: 0x337D, sjis: 0x878E}, // ㍽
+	{r: 0x337E, sjis: 0x878D}, // ㍾
+	{r: 0x338E, sjis: 0x8772}, // ㎎
+	{r: 0x338F, sjis: 0x8773}, // ㎏
+	{r: 0x339C, sjis: 0x876F}, // ㎜
+	{r: 0x339D, sjis: 0x8770}, // ㎝
+	{r: 0x339E, sjis: 0x8771}, // ㎞
+	{r: 0x33A1, sjis: 0x8775}, // ㎡
+	{r: 0x33C4, sjis: 0x8774}, // ㏄
+	{r: 0x33CD, sjis: 0x8783}, // ㏍
+	{r: 0x4E28, sjis: 0xFA68}, // 丨
+	{r: 0x4EE1, sjis: 0xFA69}, // 仡
+	{r: 0x4EFC, sjis: 0xFA6A}, // 仼
+	{r: 0x4F00, sjis: 0xFA6B}, // 伀
+	{r: 0x4F03, sjis: 0xFA6C}, // 伃
+	{r: 0x4F39, sjis: 0xFA6D}, // 伹
+	{r: 0x4F56, sjis: 0xFA6E}, // 佖
+	{r: 0x4F8A, sjis: 0xFA70}, // 侊
+	{r: 0x4F92, sjis: 0xFA6F}, // 侒
+	{r: 0x4F94, sjis: 0xFA72}, // 侔
+	{r: 0x4F9A, sjis: 0xFA71}, // 侚
+	{r: 0x4FC9, sjis: 0xFA61}, // 俉
+	{r: 0x4FCD, sjis: 0xFA73}, // 俍
+	{r: 0x4FFF, sjis: 0xFA76}, // 俿
+	{r: 0x501E, sjis: 0xFA77}, // 倞
+	{r: 0x5022, sjis: 0xFA75}, // 倢
+	{r: 0x5040, sjis: 0xFA74}, // 偀
+	{r: 0x5042, sjis: 0xFA7A}, // 偂
+	{r: 0x5046, sjis: 0xFA78}, // 偆
+	{r: 0x5070, sjis: 0xFA79}, // 偰
+	{r: 0x5094, sjis: 0xFA7B}, // 傔
+	{r: 0x50D8, sjis: 0xFA7D}, // 僘
+	{r: 0x50F4, sjis: 0xFA7C}, // 僴
+	{r: 0x514A, sjis: 0xFA7E}, // 兊
+	{r: 0x5164, sjis: 0xFA80}, // 兤
+	{r: 0x519D, sjis: 0xFA81}, // 冝
+	{r: 0x51BE, sjis: 0xFA82}, // 冾
+	{r: 0x51EC, sjis: 0xFA83}, // 凬
+	{r: 0x5215, sjis: 0xFA84}, // 刕
+	{r: 0x529C, sjis: 0xFA85}, // 劜
+	{r: 0x52A6, sjis: 0xFA86}, // 劦
+	{r: 0x52AF, sjis: 0xFB77}, // 劯
+	{r: 0x52C0, sjis: 0xFA87}, // 勀
+	{r: 0x52DB, sjis: 0xFA88}, // 勛
+	{r: 0x5300, sjis: 0xFA89}, // 匀
+	{r: 0x5307, sjis: 0xFA8A}, // 匇
+	{r: 0x5324, sjis: 0xFA8B}, // 匤
+	{r: 0x5372, sjis: 0xFA8C}, // 卲
+	{r: 0x5393, sjis: 0xFA8D}, // 厓
+	{r: 0x53B2, sjis: 0xFA8E}, // 厲
+	{r: 0x53DD, sjis: 0xFA8F}, // 叝
+	{r: 0x548A, sjis: 0xFA92}, // 咊
+	{r: 0x549C, sjis: 0xFA91}, // 咜
+	{r: 0x54A9, sjis: 0xFA93}, // 咩
+	{r: 0x54FF, sjis: 0xFA94}, // 哿
+	{r: 0x5586, sjis: 0xFA95}, // 喆
+	{r: 0x5759, sjis: 0xFA96}, // 坙
+	{r: 0x5765, sjis: 0xFA97}, // 坥
+	{r: 0x57AC, sjis: 0xFA98}, // 垬
+	{r: 0x57C7, sjis: 0xFA9A}, // 埇
+	{r: 0x57C8, sjis: 0xFA99}, // 埈
+	{r: 0x589E, sjis: 0xFA9D}, // 增
+	{r: 0x58B2, sjis: 0xFA9E}, // 墲
+	{r: 0x590B, sjis: 0xFA9F}, // 夋
+	{r: 0x5953, sjis: 0xFAA0}, // 奓
+	{r: 0x595B, sjis: 0xFAA1}, // 奛
+	{r: 0x595D, sjis: 0xFAA2}, // 奝
+	{r: 0x5963, sjis: 0xFAA3}, // 奣
+	{r: 0x59A4, sjis: 0xFAA4}, // 妤
+	{r: 0x59BA, sjis: 0xFAA5}, // 妺
+	{r: 0x5B56, sjis: 0xFAA6}, // 孖
+	{r: 0x5BC0, sjis: 0xFAA7}, // 寀
+	{r: 0x5BD8, sjis: 0xFAA9}, // 寘
+	{r: 0x5BEC, sjis: 0xFAAA}, // 寬
+	{r: 0x5C1E, sjis: 0xFAAB}, // 尞
+	{r: 0x5CA6, sjis: 0xFAAC}, // 岦
+	{r: 0x5CBA, sjis: 0xFAAD}, // 岺
+	{r: 0x5CF5, sjis: 0xFAAE}, // 峵
+	{r: 0x5D27, sjis: 0xFAAF}, // 崧
+	{r: 0x5D42, sjis: 0xFAB2}, // 嵂
+	{r: 0x5D53, sjis: 0xFAB0}, // 嵓
+	{r: 0x5D6D, sjis: 0xFAB3}, // 嵭
+	{r: 0x5DB8, sjis: 0xFAB4}, // 嶸
+	{r: 0x5DB9, sjis: 0xFAB5}, // 嶹
+	{r: 0x5DD0, sjis: 0xFAB6}, // 巐
+	{r: 0x5F21, sjis: 0xFAB7}, // 弡
+	{r: 0x5F34, sjis: 0xFAB8}, // 弴
+	{r: 0x5F45, sjis: 0xFA67}, // 彅
+	{r: 0x5F67, sjis: 0xFAB9}, // 彧
+	{r: 0x5FB7, sjis: 0xFABA}, // 德
+	{r: 0x5FDE, sjis: 0xFABB}, // 忞
+	{r: 0x605D, sjis: 0xFABC}, // 恝
+	{r: 0x6085, sjis: 0xFABD}, // 悅
+	{r: 0x608A, sjis: 0xFABE}, // 悊
+	{r: 0x60D5, sjis: 0xFAC0}, // 惕
+	{r: 0x60DE, sjis: 0xFABF}, // 惞
+	{r: 0x60F2, sjis: 0xFAC2}, // 惲
+	{r: 0x6111, sjis: 0xFAC3}, // 愑
+	{r: 0x6120, sjis: 0xFAC1}, // 愠
+	{r: 0x6130, sjis: 0xFAC5}, // 愰
+	{r: 0x6137, sjis: 0xFAC4}, // 愷
+	{r: 0x6198, sjis: 0xFAC6}, // 憘
+	{r: 0x6213, sjis: 0xFAC7}, // 戓
+	{r: 0x62A6, sjis: 0xFAC8}, // 抦
+	{r: 0x63F5, sjis: 0xFAC9}, // 揵
+	{r: 0x6460, sjis: 0xFACA}, // 摠
+	{r: 0x649D, sjis: 0xFACB}, // 撝
+	{r: 0x64CE, sjis: 0xFACC}, // 擎
+	{r: 0x654E, sjis: 0xFACD}, // 敎
+	{r: 0x6600, sjis: 0xFACE}, // 昀
+	{r: 0x6609, sjis: 0xFAD1}, // 昉
+	{r: 0x6615, sjis: 0xFACF}, // 昕
+	{r: 0x661E, sjis: 0xFAD3}, // 昞
+	{r: 0x6624, sjis: 0xFAD4}, // 昤
+	{r: 0x662E, sjis: 0xFAD2}, // 昮
+	{r: 0x6631, sjis: 0xFA63}, // 昱
+	{r: 0x663B, sjis: 0xFAD0}, // 昻
+	{r: 0x6657, sjis: 0xFAD6}, // 晗
+	{r: 0x6659, sjis: 0xFAD7}, // 晙
+	{r: 0x6665, sjis: 0xFAD5}, // 晥
+	{r: 0x6673, sjis: 0xFAD9}, // 晳
+	{r: 0x6699, sjis: 0xFADA}, // 暙
+	{r: 0x66A0, sjis: 0xFADB}, // 暠
+	{r: 0x66B2, sjis: 0xFADC}, // 暲
+	{r: 0x66BF, sjis: 0xFADD}, // 暿
+	{r: 0x66FA, sjis: 0xFADE}, // 曺
+	{r: 0x66FB, sjis: 0xFA66}, // 曻
+	{r: 0x670E, sjis: 0xFADF}, // 朎
+	{r: 0x6766, sjis: 0xFAE1}, // 杦
+	{r: 0x67BB, sjis: 0xFAE2}, // 枻
+	{r: 0x67C0, sjis: 0xFAE4}, // 柀
+	{r: 0x6801, sjis: 0xFAE5}, // 栁
+	{r: 0x6844, sjis: 0xFAE6}, // 桄
+	{r: 0x6852, sjis: 0xFAE3}, // 桒
+	{r: 0x68C8, sjis: 0xFA64}, // 棈
+	{r: 0x68CF, sjis: 0xFAE7}, // 棏
+	{r: 0x6968, sjis: 0xFAE9}, // 楨
+	{r: 0x6998, sjis: 0xFAEB}, // 榘
+	{r: 0x69E2, sjis: 0xFAEC}, // 槢
+	{r: 0x6A30, sjis: 0xFAED}, // 樰
+	{r: 0x6A46, sjis: 0xFAEF}, // 橆
+	{r: 0x6A6B, sjis: 0xFAEE}, // 橫
+	{r: 0x6A73, sjis: 0xFAF0}, // 橳
+	{r: 0x6A7E, sjis: 0xFAF1}, // 橾
+	{r: 0x6AE2, sjis: 0xFAF2}, // 櫢
+	{r: 0x6AE4, sjis: 0xFAF3}, // 櫤
+	{r: 0x6BD6, sjis: 0xFAF4}, // 毖
+	{r: 0x6C3F, sjis: 0xFAF5}, // 氿
+	{r: 0x6C5C, sjis: 0xFAF6}, // 汜
+	{r: 0x6C6F, sjis: 0xFAF8}, // 汯
+	{r: 0x6C86, sjis: 0xFAF7}, // 沆
+	{r: 0x6CDA, sjis: 0xFAF9}, // 泚
+	{r: 0x6D04, sjis: 0xFAFA}, // 洄
+	{r: 0x6D6F, sjis: 0xFAFC}, // 浯
+	{r: 0x6D87, sjis: 0xFAFB}, // 涇
+	{r: 0x6D96, sjis: 0xFB40}, // 涖
+	{r: 0x6DAC, sjis: 0xFB41}, // 涬
+	{r: 0x6DCF, sjis: 0xFB42}, // 淏
+	{r: 0x6DF2, sjis: 0xFB44}, // 淲
+	{r: 0x6DF8, sjis: 0xFB43}, // 淸
+	{r: 0x6DFC, sjis: 0xFB45}, // 淼
+	{r: 0x6E27, sjis: 0xFB48}, // 渧
+	{r: 0x6E39, sjis: 0xFB46}, // 渹
+	{r: 0x6E3C, sjis: 0xFB49}, // 渼
+	{r: 0x6E5C, sjis: 0xFB47}, // 湜
+	{r: 0x6EBF, sjis: 0xFB4A}, // 溿
+	{r: 0x6F88, sjis: 0xFB4B}, // 澈
+	{r: 0x6FB5, sjis: 0xFB4C}, // 澵
+	{r: 0x6FF5, sjis: 0xFB4D}, // 濵
+	{r: 0x7005, sjis: 0xFB4E}, // 瀅
+	{r: 0x7007, sjis: 0xFB4F}, // 瀇
+	{r: 0x7028, sjis: 0xFB50}, // 瀨
+	{r: 0x7085, sjis: 0xFB51}, // 炅
+	{r: 0x70AB, sjis: 0xFB52}, // 炫
+	{r: 0x70BB, sjis: 0xFA62}, // 炻
+	{r: 0x7104, sjis: 0xFB54}, // 焄
+	{r: 0x710F, sjis: 0xFB53}, // 焏
+	{r: 0x7146, sjis: 0xFB56}, // 煆
+	{r: 0x7147, sjis: 0xFB57}, // 煇
+	{r: 0x715C, sjis: 0xFB55}, // 煜
+	{r: 0x71C1, sjis: 0xFB59}, // 燁
+	{r: 0x71FE, sjis: 0xFB5A}, // 燾
+	{r: 0x72B1, sjis: 0xFB5B}, // 犱
+	{r: 0x72BE, sjis: 0xFB5C}, // 犾
+	{r: 0x7324, sjis: 0xFB5D}, // 猤
+	{r: 0x7377, sjis: 0xFB5F}, // 獷
+	{r: 0x73BD, sjis: 0xFB60}, // 玽
+	{r: 0x73C9, sjis: 0xFB61}, // 珉
+	{r: 0x73D2, sjis: 0xFB64}, // 珒
+	{r: 0x73D6, sjis: 0xFB62}, // 珖
+	{r: 0x73E3, sjis: 0xFB63}, // 珣
+	{r: 0x73F5, sjis: 0xFB66}, // 珵
+	{r: 0x7407, sjis: 0xFB65}, // 琇
+	{r: 0x7426, sjis: 0xFB67}, // 琦
+	{r: 0x7429, sjis: 0xFB69}, // 琩
+	{r: 0x742A, sjis: 0xFB68}, // 琪
+	{r: 0x742E, sjis: 0xFB6A}, // 琮
+	{r: 0x7462, sjis: 0xFB6B}, // 瑢
+	{r: 0x7489, sjis: 0xFB6C}, // 璉
+	{r: 0x749F, sjis: 0xFB6D}, // 璟
+	{r: 0x7501, sjis: 0xFB6E}, // 甁
+	{r: 0x752F, sjis: 0xFAA8}, // 甯
+	{r: 0x756F, sjis: 0xFB6F}, // 畯
+	{r: 0x7682, sjis: 0xFB70}, // 皂
+	{r: 0x769B, sjis: 0xFB73}, // 皛
+	{r: 0x769C, sjis: 0xFB71}, // 皜
+	{r: 0x769E, sjis: 0xFB72}, // 皞
+	{r: 0x76A6, sjis: 0xFB74}, // 皦
+	{r: 0x7746, sjis: 0xFB76}, // 睆
+	{r: 0x7821, sjis: 0xFB78}, // 砡
+	{r: 0x784E, sjis: 0xFB79}, // 硎
+	{r: 0x7864, sjis: 0xFB7A}, // 硤
+	{r: 0x787A, sjis: 0xFB7B}, // 硺
+	{r: 0x7930, sjis: 0xFB7C}, // 礰
+	{r: 0x7994, sjis: 0xFB81}, // 禔
+	{r: 0x799B, sjis: 0xFB83}, // 禛
+	{r: 0x7AD1, sjis: 0xFB84}, // 竑
+	{r: 0x7AE7, sjis: 0xFB85}, // 竧
+	{r: 0x7AEB, sjis: 0xFB87}, // 竫
+	{r: 0x7B9E, sjis: 0xFB88}, // 箞
+	{r: 0x7D48, sjis: 0xFB8A}, // 絈
+	{r: 0x7D5C, sjis: 0xFB8B}, // 絜
+	{r: 0x7DA0, sjis: 0xFB8D}, // 綠
+	{r: 0x7DB7, sjis: 0xFB8C}, // 綷
+	{r: 0x7DD6, sjis: 0xFB8E}, // 緖
+	{r: 0x7E52, sjis: 0xFB8F}, // 繒
+	{r: 0x7E8A, sjis: 0xFA5C}, // 纊
+	{r: 0x7F47, sjis: 0xFB90}, // 罇
+	{r: 0x7FA1, sjis: 0xFB91}, // 羡
+	{r: 0x8301, sjis: 0xFB93}, // 茁
+	{r: 0x8362, sjis: 0xFB94}, // 荢
+	{r: 0x837F, sjis: 0xFB95}, // 荿
+	{r: 0x83C7, sjis: 0xFB96}, // 菇
+	{r: 0x83F6, sjis: 0xFB97}, // 菶
+	{r: 0x8448, sjis: 0xFB98}, // 葈
+	{r: 0x84B4, sjis: 0xFB99}, // 蒴
+	{r: 0x84DC, sjis: 0xFA60}, // 蓜
+	{r: 0x8553, sjis: 0xFB9A}, // 蕓
+	{r: 0x8559, sjis: 0xFB9B}, // 蕙
+	{r: 0x856B, sjis: 0xFB9C}, // 蕫
+	{r: 0x85B0, sjis: 0xFB9E}, // 薰
+	{r: 0x8807, sjis: 0xFBA1}, // 蠇
+	{r: 0x88F5, sjis: 0xFBA2}, // 裵
+	{r: 0x891C, sjis: 0xFA5D}, // 褜
+	{r: 0x8A12, sjis: 0xFBA3}, // 訒
+	{r: 0x8A37, sjis: 0xFBA4}, // 訷
+	{r: 0x8A79, sjis: 0xFBA5}, // 詹
+	{r: 0x8AA7, sjis: 0xFBA6}, // 誧
+	{r: 0x8ABE, sjis: 0xFBA7}, // 誾
+	{r: 0x8ADF, sjis: 0xFBA8}, // 諟
+	{r: 0x8AF6, sjis: 0xFBAA}, // 諶
+	{r: 0x8B53, sjis: 0xFBAB}, // 譓
+	{r: 0x8B7F, sjis: 0xFBAC}, // 譿
+	{r: 0x8CF0, sjis: 0xFBAD}, // 賰
+	{r: 0x8CF4, sjis: 0xFBAE}, // 賴
+	{r: 0x8D12, sjis: 0xFBAF}, // 贒
+	{r: 0x8D76, sjis: 0xFBB0}, // 赶
+	{r: 0x8ECF, sjis: 0xFBB2}, // 軏
+	{r: 0x9067, sjis: 0xFBB5}, // 遧
+	{r: 0x90DE, sjis: 0xFBB6}, // 郞
+	{r: 0x9115, sjis: 0xFBB8}, // 鄕
+	{r: 0x9127, sjis: 0xFBB9}, // 鄧
+	{r: 0x91D7, sjis: 0xFBBB}, // 釗
+	{r: 0x91DA, sjis: 0xFBBA}, // 釚
+	{r: 0x91DE, sjis: 0xFBBC}, // 釞
+	{r: 0x91E4, sjis: 0xFBBF}, // 釤
+	{r: 0x91E5, sjis: 0xFBC0}, // 釥
+	{r: 0x91ED, sjis: 0xFBBD}, // 釭
+	{r: 0x91EE, sjis: 0xFBBE}, // 釮
+	{r: 0x9206, sjis: 0xFBC1}, // 鈆
+	{r: 0x920A, sjis: 0xFBC3}, // 鈊
+	{r: 0x9210, sjis: 0xFBC2}, // 鈐
+	{r: 0x9239, sjis: 0xFBCA}, // 鈹
+	{r: 0x923A, sjis: 0xFBC4}, // 鈺
+	{r: 0x923C, sjis: 0xFBC6}, // 鈼
+	{r: 0x9240, sjis: 0xFBC5}, // 鉀
+	{r: 0x924E, sjis: 0xFBC7}, // 鉎
+	{r: 0x9251, sjis: 0xFBC9}, // 鉑
+	{r: 0x9259, sjis: 0xFBC8}, // 鉙
+	{r: 0x9267, sjis: 0xFBCB}, // 鉧
+	{r: 0x9277, sjis: 0xFBCD}, // 鉷
+	{r: 0x9278, sjis: 0xFBCE}, // 鉸
+	{r: 0x9288, sjis: 0xFA5F}, // 銈
+	{r: 0x92A7, sjis: 0xFBCC}, // 銧
+	{r: 0x92D0, sjis: 0xFBD2}, // 鋐
+	{r: 0x92D3, sjis: 0xFBD6}, // 鋓
+	{r: 0x92D5, sjis: 0xFBD4}, // 鋕
+	{r: 0x92D7, sjis: 0xFBD0}, // 鋗
+	{r: 0x92D9, sjis: 0xFBD1}, // 鋙
+	{r: 0x92E0, sjis: 0xFBD5}, // 鋠
+	{r: 0x92E7, sjis: 0xFBCF}, // 鋧
+	{r: 0x92F9, sjis: 0xFA65}, // 鋹
+	{r: 0x92FB, sjis: 0xFBD9}, // 鋻
+	{r: 0x92FF, sjis: 0xFBDC}, // 鋿
+	{r: 0x9302, sjis: 0xFBDE}, // 錂
+	{r: 0x931D, sjis: 0xFBDD}, // 錝
+	{r: 0x931E, sjis: 0xFBDB}, // 錞
+	{r: 0x9321, sjis: 0xFBD8}, // 錡
+	{r: 0x9325, sjis: 0xFBD7}, // 錥
+	{r: 0x9348, sjis: 0xFA5E}, // 鍈
+	{r: 0x9357, sjis: 0xFBE0}, // 鍗
+	{r: 0x9370, sjis: 0xFBDF}, // 鍰
+	{r: 0x93A4, sjis: 0xFBE1}, // 鎤
+	{r: 0x93C6, sjis: 0xFBE2}, // 鏆
+	{r: 0x93DE, sjis: 0xFBE3}, // 鏞
+	{r: 0x93F8, sjis: 0xFBE4}, // 鏸
+	{r: 0x9431, sjis: 0xFBE5}, // 鐱
+	{r: 0x9445, sjis: 0xFBE6}, // 鑅
+	{r: 0x9448, sjis: 0xFBE7}, // 鑈
+	{r: 0x9592, sjis: 0xFBE8}, // 閒
+	{r: 0x969D, sjis: 0xFBEB}, // 隝
+	{r: 0x96AF, sjis: 0xFBEC}, // 隯
+	{r: 0x9733, sjis: 0xFBED}, // 霳
+	{r: 0x973B, sjis: 0xFBEE}, // 霻
+	{r: 0x9743, sjis: 0xFBEF}, // 靃
+	{r: 0x974D, sjis: 0xFBF0}, // 靍
+	{r: 0x974F, sjis: 0xFBF1}, // 靏
+	{r: 0x9751, sjis: 0xFBF2}, // 靑
+	{r: 0x9755, sjis: 0xFBF3}, // 靕
+	{r: 0x9857, sjis: 0xFBF4}, // 顗
+	{r: 0x9865, sjis: 0xFBF5}, // 顥
+	{r: 0x9927, sjis: 0xFBF8}, // 餧
+	{r: 0x999E, sjis: 0xFBFA}, // 馞
+	{r: 0x9A4E, sjis: 0xFBFB}, // 驎
+	{r: 0x9AD9, sjis: 0xFBFC}, // 髙
+	{r: 0x9ADC, sjis: 0xFC40}, // 髜
+	{r: 0x9B72, sjis: 0xFC42}, // 魲
+	{r: 0x9B75, sjis: 0xFC41}, // 魵
+	{r: 0x9B8F, sjis: 0xFC43}, // 鮏
+	{r: 0x9BB1, sjis: 0xFC44}, // 鮱
+	{r: 0x9BBB, sjis: 0xFC45}, // 鮻
+	{r: 0x9C00, sjis: 0xFC46}, // 鰀
+	{r: 0x9D6B, sjis: 0xFC48}, // 鵫
+	{r: 0x9D70, sjis: 0xFC47}, // 鵰
+	{r: 0x9E19, sjis: 0xFC4A}, // 鸙
+	{r: 0x9ED1, sjis: 0xFC4B}, // 黑
+	{r: 0xF929, sjis: 0xFAE0}, // 朗
+	{r: 0xF9DC, sjis: 0xFBE9}, // 隆
+	{r: 0xFA0E, sjis: 0xFA90}, // 﨎
+	{r: 0xFA0F, sjis: 0xFA9B}, // 﨏
+	{r: 0xFA10, sjis: 0xFA9C}, // 塚
+	{r: 0xFA11, sjis: 0xFAB1}, // 﨑
+	{r: 0xFA12, sjis: 0xFAD8}, // 晴
+	{r: 0xFA13, sjis: 0xFAE8}, // 﨓
+	{r: 0xFA14, sjis: 0xFAEA}, // 﨔
+	{r: 0xFA15, sjis: 0xFB58}, // 凞
+	{r: 0xFA16, sjis: 0xFB5E}, // 猪
+	{r: 0xFA17, sjis: 0xFB75}, // 益
+	{r: 0xFA18, sjis: 0xFB7D}, // 礼
+	{r: 0xFA19, sjis: 0xFB7E}, // 神
+	{r: 0xFA1A, sjis: 0xFB80}, // 祥
+	{r: 0xFA1B, sjis: 0xFB82}, // 福
+	{r: 0xFA1C, sjis: 0xFB86}, // 靖
+	{r: 0xFA1D, sjis: 0xFB89}, // 精
+	{r: 0xFA1E, sjis: 0xFB92}, // 羽
+	{r: 0xFA1F, sjis: 0xFB9D}, // 﨟
+	{r: 0xFA20, sjis: 0xFB9F}, // 蘒
+	{r: 0xFA21, sjis: 0xFBA0}, // 﨡
+	{r: 0xFA22, sjis: 0xFBA9}, // 諸
+	{r: 0xFA23, sjis: 0xFBB1}, // 﨣
+	{r: 0xFA24, sjis: 0xFBB3}, // 﨤
+	{r: 0xFA25, sjis: 0xFBB4}, // 逸
+	{r: 0xFA26, sjis: 0xFBB7}, // 都
+	{r: 0xFA27, sjis: 0xFBD3}, // 﨧
+	{r: 0xFA28, sjis: 0xFBDA}, // 﨨
+	{r: 0xFA29, sjis: 0xFBEA}, // 﨩
+	{r: 0xFA2A, sjis: 0xFBF6}, // 飯
+	{r: 0xFA2B, sjis: 0xFBF7}, // 飼
+	{r: 0xFA2C, sjis: 0xFBF9}, // 館
+	{r: 0xFA2D, sjis: 0xFC49}, // 鶴
+	{r: 0xFF02, sjis: 0xFA57}, // ＂
+	{r: 0xFF07, sjis: 0xFA56}, // ＇
+	{r: 0xFFE4, sjis: 0xFA55}, // ￤
+}
@@ -0,0 +1,115 @@
+package jisx0208
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// HasTrailingBackslash reports whether the second byte of the rune r in Shift_JIS is 0x5C (backslash),
+// e.g. ソ (0x835C), 表 (0x955C), 能 (0x945C) and 十 (0x8F5C).
+func HasTrailingBackslash(r rune) bool {
+	b, ok := trailByte(r)
+	return ok && b == '\\'
+}
+
+// HasTrailingVerticalBar reports whether the second byte of the rune r in Shift_JIS is 0x7C (vertical bar),
+// e.g. ポ (0x837C), 竹 (0x927C) and － (0x817C).
+func HasTrailingVerticalBar(r rune) bool {
+	b, ok := trailByte(r)
+	return ok && b == '|'
+}
+
+// trailByte returns the second byte of the double-byte character r in Shift_JIS.
+func trailByte(r rune) (byte, bool) {
+	if r < utf8.RuneSelf {
+		return 0, false
+	}
+	k, ok := KutenOf(r)
+	if !ok {
+		return 0, false
+	}
+	return byte(k.ShiftJIS()), true
+}
+
+// Target is a syntax in which a legacy system parses Shift_JIS text byte by byte.
+type Target int
+
+const (
+	// TargetSQL is a string literal of SQL with backslash escapes, e.g. of MySQL.
+	TargetSQL Target = iota + 1
+	// TargetCSV is a field of CSV, which may be delimited by 0x7C or escaped by 0x5C.
+	TargetCSV
+	// TargetC is a string literal of C.
+	TargetC
+)
+
+// String returns the name of the target.
+func (t Target) String() string {
+	switch t {
+	case TargetSQL:
+		return "SQL"
+	case TargetCSV:
+		return "CSV"
+	case TargetC:
+		return "C"
+	}
+	return "unknown"
+}
+
+// breaks reports whether the rune r breaks the target.
+func (t Target) breaks(r rune) bool {
+	b, ok := trailByte(r)
+	return ok && (b == '\\' || t == TargetCSV && b == '|')
+}
+
+// EscapeDameMoji returns a copy of s that a byte-wise parser of the target reads back as s in Shift_JIS.
+// For SQL and C, a backslash is added after each character with a trailing backslash, so that
+// the parser reads an escaped backslash; s should be escaped for the target beforehand.
+// For CSV, the field s is enclosed in double quotes, with the double quotes in it doubled,
+// if it contains a character with a trailing backslash or vertical bar.
+func EscapeDameMoji(s string, t Target) string {
+	if CheckDameMoji(s, t) == nil {
+		return s
+	}
+	if t == TargetCSV {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteRune(r)
+		if t.breaks(r) {
+			b.WriteByte('\\')
+		}
+	}
+	return b.String()
+}
+
+// CheckDameMoji returns a *DameMojiError for the first character of s whose trailing byte in Shift_JIS
+// breaks the target: a backslash for all the targets, and a vertical bar for CSV.
+func CheckDameMoji(s string, t Target) error {
+	for i, r := range s {
+		if t.breaks(r) {
+			b, _ := trailByte(r)
+			return &DameMojiError{Target: t, Offset: i, Rune: r, Byte: b}
+		}
+	}
+	return nil
+}
+
+// DameMojiError is the error of a character whose trailing byte in Shift_JIS breaks the target.
+type DameMojiError struct {
+	// Target is the target syntax.
+	Target Target
+	// Offset is the byte offset of the character in the input.
+	Offset int
+	// Rune is the character.
+	Rune rune
+	// Byte is the trailing byte of the character, 0x5C or 0x7C.
+	Byte byte
+}
+
+// Error returns the error message.
+func (e *DameMojiError) Error() string {
+	return fmt.Sprintf("jisx0208: %U %s has trailing byte 0x%X in Shift_JIS, which breaks %s, at offset %d", e.Rune, quoteRune(e.Rune), e.Byte, e.Target, e.Offset)
+}
@@ -0,0 +1,83 @@
+package jisx0208
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+)
+
+func TestDameMojiRangeTable(t *testing.T) {
+	var n int
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		want := HasTrailingBackslash(r) || HasTrailingVerticalBar(r)
+		if got := unicode.Is(DameMojiRangeTable, r); got != want {
+			t.Errorf("%U %q: got %v, want %v", r, r, got, want)
+		}
+		if want {
+			n++
+		}
+	}
+	if n != 73 {
+		t.Errorf("got %d characters, want 73", n)
+	}
+}
+
+func TestHasTrailingBackslash(t *testing.T) {
+	for _, r := range "ソ表能十ー―" {
+		if r == 'ー' {
+			if HasTrailingBackslash(r) {
+				t.Errorf("HasTrailingBackslash(%q) = true", r)
+			}
+			continue
+		}
+		if !HasTrailingBackslash(r) {
+			t.Errorf("HasTrailingBackslash(%q) = false", r)
+		}
+	}
+	for _, r := range `\|Aあ髙` {
+		if HasTrailingBackslash(r) || HasTrailingVerticalBar(r) {
+			t.Errorf("%q has a trailing backslash or vertical bar", r)
+		}
+	}
+	if !HasTrailingVerticalBar('ポ') {
+		t.Errorf("HasTrailingVerticalBar('ポ') = false")
+	}
+}
+
+func TestEscapeDameMoji(t *testing.T) {
+	tests := []struct {
+		s      string
+		target Target
+		want   string
+	}{
+		{s: "ソフト", target: TargetSQL, want: `ソ\フト`},
+		{s: "表示能力", target: TargetC, want: `表\示能\力`},
+		{s: "ポスト", target: TargetSQL, want: "ポスト"},
+		{s: "ポスト", target: TargetCSV, want: `"ポスト"`},
+		{s: `表"1"`, target: TargetCSV, want: `"表""1"""`},
+		{s: "漢字", target: TargetCSV, want: "漢字"},
+	}
+	for _, tt := range tests {
+		if got := EscapeDameMoji(tt.s, tt.target); got != tt.want {
+			t.Errorf("EscapeDameMoji(%q, %v) = %q, want %q", tt.s, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestCheckDameMoji(t *testing.T) {
+	if err := CheckDameMoji("ポスト", TargetC); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err := CheckDameMoji("あポ", TargetCSV)
+	var got *DameMojiError
+	if !errors.As(err, &got) {
+		t.Fatalf("want DameMojiError, got %v", err)
+	}
+	want := DameMojiError{Target: TargetCSV, Offset: 3, Rune: 'ポ', Byte: 0x7C}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+	if got, want := err.Error(), "jisx0208: U+30DD 'ポ' has trailing byte 0x7C in Shift_JIS, which breaks CSV, at offset 3"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,242 @@
+package jisx0208
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+// Guess is a guess of the encoding of a byte sequence.
+type Guess struct {
+	// Encoding is the name of the encoding: "UTF-8", "Shift_JIS", "EUC-JP" or "ISO-2022-JP".
+	Encoding string
+	// Confidence is the likelihood of the encoding relative to the other candidates, in [0, 1].
+	Confidence float64
+	// Invalid is the number of byte sequences that cannot be decoded in the encoding.
+	Invalid int
+	// OutOfSet is the number of decoded characters that are neither ASCII nor in JIS X 0208,
+	// e.g. vendor extensions and halfwidth katakana.
+	OutOfSet int
+}
+
+// String returns the encoding and the confidence of the guess, e.g. "Shift_JIS (0.98)".
+func (g Guess) String() string {
+	return fmt.Sprintf("%s (%.2f)", g.Encoding, g.Confidence)
+}
+
+// Detect guesses the encoding of b among UTF-8, Shift_JIS, EUC-JP and ISO-2022-JP, and returns
+// the guesses in descending order of confidence. Each encoding is scored by the validity of
+// the byte sequences and by how likely the decoded characters are in Japanese text: kana and
+// JIS level 1 kanji score higher than level 2 kanji, vendor extensions and halfwidth katakana.
+// For ASCII, all the encodings are equally likely. Since an incomplete sequence at the end of b
+// is ignored, b may be a prefix of a stream.
+func Detect(b []byte) []Guess {
+	var d Detector
+	d.Write(b)
+	return d.Guesses()
+}
+
+// Detector guesses the encoding of the bytes written to it in the same way as Detect.
+// It keeps only the scores of the encodings, so that a stream of any length can be written.
+// The zero value is ready to use.
+type Detector struct {
+	candidates [numCandidates]candidate
+}
+
+type candidateKind int
+
+const (
+	candidateUTF8 candidateKind = iota
+	candidateShiftJIS
+	candidateEUCJP
+	candidateISO2022JP
+	numCandidates
+)
+
+var candidateNames = [numCandidates]string{"UTF-8", "Shift_JIS", "EUC-JP", "ISO-2022-JP"}
+
+type candidate struct {
+	score    float64
+	invalid  int
+	outOfSet int
+	pending  []byte         // incomplete sequence at the end of the last write
+	state    iso2022jpState // for ISO-2022-JP
+}
+
+// Log-probabilities of the classes of characters in Japanese text, roughly estimated.
+// A character scores its log-probability over that of random bytes of the same length.
+const (
+	logProbByte      = -8 * math.Ln2
+	logProbCommon    = -3.0 // symbols, alphanumerics and kana in rows 1 to 5
+	logProbLevel1    = -5.0 // JIS level 1 kanji
+	logProbRare      = -9.0 // JIS level 2 kanji, Greek, Cyrillic and box drawings
+	logProbOther     = -9.0 // characters out of JIS X 0208 in UTF-8
+	logProbVendor    = -10.0
+	logProbHalfwidth = -8.0
+	logProbInvalid   = -15.0
+)
+
+// Write implements io.Writer. It never fails.
+func (d *Detector) Write(p []byte) (int, error) {
+	for i := range d.candidates {
+		c := &d.candidates[i]
+		b := p
+		if len(c.pending) > 0 {
+			b = append(c.pending, p...)
+		}
+		n := c.feed(candidateKind(i), b)
+		c.pending = append([]byte(nil), b[n:]...)
+	}
+	return len(p), nil
+}
+
+// Guesses returns the guesses for the bytes written so far, in descending order of confidence.
+// An incomplete sequence at the end is ignored.
+func (d *Detector) Guesses() []Guess {
+	max := math.Inf(-1)
+	for _, c := range d.candidates {
+		max = math.Max(max, c.score)
+	}
+	var sum float64
+	for _, c := range d.candidates {
+		sum += math.Exp(c.score - max)
+	}
+	ret := make([]Guess, 0, numCandidates)
+	for i, c := range d.candidates {
+		ret = append(ret, Guess{
+			Encoding:   candidateNames[i],
+			Confidence: math.Exp(c.score-max) / sum,
+			Invalid:    c.invalid,
+			OutOfSet:   c.outOfSet,
+		})
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Confidence > ret[j].Confidence
+	})
+	return ret
+}
+
+// feed scores the characters of b and returns the number of bytes consumed.
+func (c *candidate) feed(kind candidateKind, b []byte) int {
+	switch kind {
+	case candidateUTF8:
+		return c.feedUTF8(b)
+	case candidateShiftJIS:
+		return c.feedMultiByte(schemeShiftJIS, b)
+	case candidateEUCJP:
+		return c.feedMultiByte(schemeEUCJP, b)
+	}
+	return c.feedISO2022JP(b)
+}
+
+func (c *candidate) feedUTF8(b []byte) int {
+	i := 0
+	for i < len(b) {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		if !utf8.FullRune(b[i:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(b[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			c.addInvalid(size)
+		case Is(r):
+			c.add(logProbOf(r), size)
+		default:
+			c.outOfSet++
+			c.add(logProbOther, size)
+		}
+		i += size
+	}
+	return i
+}
+
+func (c *candidate) feedMultiByte(s scheme, b []byte) int {
+	i := 0
+	for i < len(b) {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size, ok := s.decode(b[i:])
+		switch {
+		case size == 0:
+			return i
+		case ok:
+			c.add(logProbOf(r), size)
+		case r == utf8.RuneError:
+			c.addInvalid(size)
+		case r >= '｡' && r <= 'ﾟ':
+			c.outOfSet++
+			c.add(logProbHalfwidth, size)
+		default:
+			c.outOfSet++
+			c.add(logProbVendor, size)
+		}
+		i += size
+	}
+	return i
+}
+
+func (c *candidate) feedISO2022JP(b []byte) int {
+	i := 0
+	for i < len(b) {
+		ch := b[i]
+		switch {
+		case ch == esc:
+			size, state, ok := (&iso2022jpDecoder{state: c.state}).escape(b[i:])
+			if size == 0 {
+				return i
+			}
+			if !ok {
+				c.addInvalid(1)
+				i++
+				continue
+			}
+			c.state = state
+			i += size
+		case ch >= utf8.RuneSelf:
+			c.addInvalid(1)
+			i++
+		case c.state > stateASCII && ch > ' ' && ch != 0x7F:
+			if i+1 >= len(b) {
+				return i
+			}
+			k, _ := KutenFromJIS(uint16(ch)<<8 | uint16(b[i+1]))
+			if r, ok := k.Rune(); ok {
+				c.add(logProbOf(r), 2)
+			} else {
+				c.addInvalid(2)
+			}
+			i += 2
+		default:
+			i++
+		}
+	}
+	return i
+}
+
+func (c *candidate) add(logProb float64, size int) {
+	c.score += logProb - float64(size)*logProbByte
+}
+
+func (c *candidate) addInvalid(size int) {
+	c.invalid++
+	c.add(logProbInvalid, size)
+}
+
+// logProbOf returns the log-probability of the class of the rune r in JIS X 0208.
+func logProbOf(r rune) float64 {
+	k, ok := KutenOf(r)
+	switch {
+	case !ok, k.Ku <= 5:
+		return logProbCommon
+	case k.Ku >= 16 && k.Ku <= 47:
+		return logProbLevel1
+	}
+	return logProbRare
+}
@@ -0,0 +1,89 @@
+package jisx0208
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestDetect(t *testing.T) {
+	samples := []string{
+		"こんにちは",
+		"吾輩は猫である。名前はまだ無い。",
+		"人魚は、南の方の海にばかり棲んでいるのではありません。北の海にも棲んでいたのであります。",
+		"The JIS X 0208 character set: 亜唖娃阿哀愛挨姶逢葵茜穐悪握渥",
+	}
+	encodings := []*Encoding{ShiftJIS, EUCJP, ISO2022JP}
+	for _, s := range samples {
+		t.Run(s, func(t *testing.T) {
+			got := Detect([]byte(s))
+			if got[0].Encoding != "UTF-8" || got[0].Confidence < 0.9 {
+				t.Errorf("Detect(UTF-8) = %v", got)
+			}
+			for _, e := range encodings {
+				b, err := e.NewEncoder().Bytes([]byte(s))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got := Detect(b)
+				if got[0].Encoding != e.String() || got[0].Confidence < 0.9 {
+					t.Errorf("Detect(%s) = %v", e, got)
+				}
+				if got[0].Invalid != 0 || got[0].OutOfSet != 0 {
+					t.Errorf("Detect(%s) = %+v", e, got[0])
+				}
+			}
+		})
+	}
+}
+
+func TestDetect_ASCII(t *testing.T) {
+	got := Detect([]byte("Hello, world\n"))
+	want := []string{"UTF-8", "Shift_JIS", "EUC-JP", "ISO-2022-JP"}
+	for i, v := range got {
+		if v.Encoding != want[i] || v.Confidence != 0.25 {
+			t.Errorf("Detect()[%d] = %v, want %s (0.25)", i, v, want[i])
+		}
+	}
+}
+
+func TestDetect_VendorExtension(t *testing.T) {
+	b, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte("①髙島屋ｶﾀｶﾅのお店です"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := Detect(b)
+	if got[0].Encoding != "Shift_JIS" {
+		t.Fatalf("Detect() = %v", got)
+	}
+	if got[0].OutOfSet != 6 || got[0].Invalid != 0 {
+		t.Errorf("Detect()[0] = %#v, want 6 characters out of set", got[0])
+	}
+}
+
+func TestDetector(t *testing.T) {
+	s := "吾輩は猫である。名前はまだ無い。"
+	for _, e := range []*Encoding{ShiftJIS, EUCJP, ISO2022JP} {
+		t.Run(e.String(), func(t *testing.T) {
+			b, err := e.NewEncoder().Bytes([]byte(s))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := Detect(b)
+			var d Detector
+			for i := range b {
+				d.Write(b[i : i+1])
+			}
+			got := d.Guesses()
+			for i := range want {
+				if got[i].Encoding != want[i].Encoding || got[i].Invalid != want[i].Invalid {
+					t.Errorf("Guesses()[%d] = %+v, want %+v", i, got[i], want[i])
+				}
+			}
+			// a prefix cut in the middle of a character
+			if got := Detect(b[:len(b)-3]); got[0].Encoding != e.String() || got[0].Invalid != 0 {
+				t.Errorf("Detect(prefix) = %+v", got)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package jisx0208
 import (
 	"strings"
 	"unicode"
-	"unicode/utf8"
 )
 
 // Is returns true if the rune r is in JIS X 0208.
@@ -44,10 +43,27 @@ func Disallow(r ...rune) Option {
 	}
 }
 
+// AllowTable is a discriminator option to set allow characters by a range table.
+func AllowTable(t *unicode.RangeTable) Option {
+	return func(d *Discriminator) {
+		d.allowTables = append(d.allowTables, t)
+	}
+}
+
+// DisallowTable is a discriminator option to set disallow characters by a range table,
+// e.g. Level2RangeTable to restrict the characters to JIS level 1.
+func DisallowTable(t *unicode.RangeTable) Option {
+	return func(d *Discriminator) {
+		d.disallowTables = append(d.disallowTables, t)
+	}
+}
+
 // Discriminator determines if a character is in JISX0208 or allowed/disallowed character.
 type Discriminator struct {
-	allow    []rune
-	disallow []rune
+	allow          []rune
+	disallow       []rune
+	allowTables    []*unicode.RangeTable
+	disallowTables []*unicode.RangeTable
 }
 
 // NewDiscriminator returns a character discriminator.
@@ -67,11 +83,17 @@ func (d *Discriminator) Is(r rune) bool {
 			return true
 		}
 	}
+	if len(d.allowTables) > 0 && unicode.In(r, d.allowTables...) {
+		return true
+	}
 	for _, v := range d.disallow {
 		if v == r {
 			return false
 		}
 	}
+	if len(d.disallowTables) > 0 && unicode.In(r, d.disallowTables...) {
+		return false
+	}
 	return Is(r)
 }
 
@@ -81,58 +103,25 @@ func (d *Discriminator) ToValid(s, replacement string) string {
 	return toValid(s, replacement, d.Is)
 }
 
+// toValid replaces the parts of s that scanInvalid finds, so that ToValid, ToValidWithReport,
+// Offsets and Segments agree on what is invalid.
 func toValid(s, replacement string, is func(rune) bool) string {
-	var b strings.Builder
-
-	for i, c := range s {
-		if c != utf8.RuneError && is(c) {
-			continue
-		}
-		if !is(c) {
-			b.Grow(len(s) + len(replacement))
-			b.WriteString(s[:i])
-			s = s[i:]
-			break
-		}
-		_, wid := utf8.DecodeRuneInString(s[i:])
-		if wid == 1 {
+	var (
+		b    strings.Builder
+		last int
+	)
+	scanInvalid(s, is, func(start, end int, _ rune) {
+		if b.Cap() == 0 {
 			b.Grow(len(s) + len(replacement))
-			b.WriteString(s[:i])
-			s = s[i:]
-			break
 		}
-	}
-
+		b.WriteString(s[last:start])
+		b.WriteString(replacement)
+		last = end
+	})
 	// Fast path for unchanged input
-	if b.Cap() == 0 { // didn't call b.Grow above
+	if b.Cap() == 0 {
 		return s
 	}
-
-	invalid := false // previous byte was from an invalid UTF-8 sequence
-	for i := 0; i < len(s); {
-		c := s[i]
-		if c < utf8.RuneSelf {
-			i++
-			invalid = false
-			b.WriteByte(c)
-			continue
-		}
-		r, wid := utf8.DecodeRuneInString(s[i:])
-		if wid == 1 {
-			i++
-			if !invalid {
-				invalid = true
-				b.WriteString(replacement)
-			}
-			continue
-		}
-		invalid = false
-		if is(r) {
-			b.WriteString(s[i : i+wid])
-		} else {
-			b.WriteString(replacement)
-		}
-		i += wid
-	}
+	b.WriteString(s[last:])
 	return b.String()
 }
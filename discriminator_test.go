@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"unicode"
 )
 
 func TestIs(t *testing.T) {
@@ -392,6 +393,26 @@ func TestDiscriminator_Is(t *testing.T) {
 	})
 }
 
+func TestDiscriminator_Is_Table(t *testing.T) {
+	d := NewDiscriminator(Allow('鵝'), DisallowTable(Level2RangeTable), AllowTable(unicode.Katakana))
+	tests := []struct {
+		rune rune
+		want bool
+	}{
+		{rune: '亜', want: true},  // level 1
+		{rune: '弌', want: false}, // level 2
+		{rune: '鵝', want: true},  // level 2, but allowed
+		{rune: 'ｱ', want: true},  // halfwidth katakana
+		{rune: 'あ', want: true},
+		{rune: '髙', want: false},
+	}
+	for _, v := range tests {
+		if got := d.Is(v.rune); got != v.want {
+			t.Errorf("d.Is(%c) = %v, want %v", v.rune, got, v.want)
+		}
+	}
+}
+
 func TestDiscriminator_ToValid(t *testing.T) {
 	t.Run("unspecified", func(t *testing.T) {
 		d := NewDiscriminator()
@@ -0,0 +1,48 @@
+package jisx0208
+
+// Edition is an edition of JIS X 0208.
+type Edition int
+
+const (
+	// Edition1978 is JIS C 6226-1978.
+	Edition1978 Edition = 1978
+	// Edition1983 is JIS C 6226-1983, renamed JIS X 0208-1983 in 1987.
+	Edition1983 Edition = 1983
+	// Edition1990 is JIS X 0208-1990.
+	Edition1990 Edition = 1990
+)
+
+// String returns the name of the edition.
+func (e Edition) String() string {
+	switch e {
+	case Edition1978:
+		return "JIS C 6226-1978"
+	case Edition1983:
+		return "JIS C 6226-1983"
+	case Edition1990:
+		return "JIS X 0208-1990"
+	}
+	return "unknown edition"
+}
+
+// EditionOf returns the edition in which the JIS X 0208 double-byte character r was assigned
+// to its code point: Edition1983 for the symbols, box drawings and kanji that JIS C 6226-1983
+// added or moved, Edition1990 for 凜 and 熙, and Edition1978 for the others. The glyph changes
+// do not count, since the code point means the same character; see GlyphChangeOf.
+func EditionOf(r rune) (Edition, bool) {
+	if _, ok := KutenOf(r); !ok {
+		return 0, false
+	}
+	if e, ok := editionTable[r]; ok {
+		return e, true
+	}
+	return Edition1978, true
+}
+
+// GlyphChangeOf returns the latest edition in which the example glyph of the JIS X 0208
+// double-byte character r was changed, e.g. Edition1990 for 偉. It reports false if the glyph
+// has not been changed since JIS C 6226-1978.
+func GlyphChangeOf(r rune) (Edition, bool) {
+	e, ok := glyphChangeTable[r]
+	return e, ok
+}
@@ -0,0 +1,310 @@
+package jisx0208
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// Encoding is a character encoding limited to JIS X 0208 and ASCII, which implements encoding.Encoding.
+// Unlike the encodings of golang.org/x/text/encoding/japanese, it accepts neither vendor extensions
+// nor halfwidth katakana. As in ToValid, ASCII characters, including control characters, are always valid.
+type Encoding struct {
+	name        string
+	scheme      scheme
+	is          func(rune) bool
+	replacement string
+	replace     bool
+}
+
+var (
+	// ShiftJIS is the Shift_JIS encoding of JIS X 0208.
+	ShiftJIS = &Encoding{name: "Shift_JIS", scheme: schemeShiftJIS, is: Is}
+	// EUCJP is the EUC-JP encoding of JIS X 0208.
+	EUCJP = &Encoding{name: "EUC-JP", scheme: schemeEUCJP, is: Is}
+	// ISO2022JP is the ISO-2022-JP encoding of JIS X 0208. Its encoder designates each run of
+	// JIS X 0208 characters with the escape sequence of the edition in which the characters were
+	// assigned, as EditionOf returns: ESC $ @ for most characters, ESC $ B only for those added or
+	// moved in 1983, and ESC & @ ESC $ B only for 凜 and 熙. It returns to ASCII at the end of each line.
+	ISO2022JP = &Encoding{name: "ISO-2022-JP", scheme: schemeISO2022JP, is: Is}
+)
+
+// WithReplacement returns a copy of the encoding whose encoder writes the replacement string,
+// which may be empty, instead of each rune that is invalid under the discriminator d or cannot be encoded.
+// A nil discriminator means the JIS X 0208 character set. The replacement must be encodable.
+func (e *Encoding) WithReplacement(d *Discriminator, replacement string) *Encoding {
+	ret := *e
+	ret.is = Is
+	if d != nil {
+		ret.is = d.Is
+	}
+	ret.replacement = replacement
+	ret.replace = true
+	return &ret
+}
+
+// String returns the name of the encoding.
+func (e *Encoding) String() string {
+	return e.name
+}
+
+// NewDecoder returns a decoder that converts the encoding to UTF-8.
+// The decoder fails with a *DecodeError on byte sequences out of JIS X 0208.
+func (e *Encoding) NewDecoder() *encoding.Decoder {
+	if e.scheme == schemeISO2022JP {
+		return &encoding.Decoder{Transformer: &iso2022jpDecoder{encoding: e}}
+	}
+	return &encoding.Decoder{Transformer: &decoder{encoding: e}}
+}
+
+// NewEncoder returns an encoder that converts UTF-8 to the encoding.
+// The encoder fails with an *EncodeError on runes out of JIS X 0208, unless a replacement is given.
+func (e *Encoding) NewEncoder() *encoding.Encoder {
+	if e.scheme == schemeISO2022JP {
+		return &encoding.Encoder{Transformer: &iso2022jpEncoder{encoding: e}}
+	}
+	return &encoding.Encoder{Transformer: &encoder{encoding: e}}
+}
+
+// EncodeError is the error of the encoder, which reports the rune that cannot be encoded.
+type EncodeError struct {
+	// Encoding is the name of the encoding.
+	Encoding string
+	// Offset is the byte offset of the rune in the input.
+	Offset int
+	// Rune is the rune that cannot be encoded; utf8.RuneError for invalid UTF-8 bytes.
+	Rune rune
+}
+
+// Error returns the error message.
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("jisx0208: cannot encode %U %s in %s at offset %d", e.Rune, quoteRune(e.Rune), e.Encoding, e.Offset)
+}
+
+// DecodeError is the error of the decoder, which reports the byte sequence that cannot be decoded.
+type DecodeError struct {
+	// Encoding is the name of the encoding.
+	Encoding string
+	// Offset is the byte offset of the sequence in the input.
+	Offset int
+	// Bytes is the byte sequence that cannot be decoded.
+	Bytes []byte
+	// Rune is the character of the sequence in a vendor extension or in JIS X 0201, if known,
+	// otherwise utf8.RuneError.
+	Rune rune
+}
+
+// Error returns the error message.
+func (e *DecodeError) Error() string {
+	if e.Rune != utf8.RuneError {
+		return fmt.Sprintf("jisx0208: cannot decode % X (%U %s) in %s at offset %d", e.Bytes, e.Rune, quoteRune(e.Rune), e.Encoding, e.Offset)
+	}
+	return fmt.Sprintf("jisx0208: cannot decode % X in %s at offset %d", e.Bytes, e.Encoding, e.Offset)
+}
+
+type scheme int
+
+const (
+	schemeShiftJIS scheme = iota + 1
+	schemeEUCJP
+	schemeISO2022JP
+)
+
+// appendRune appends the code of the rune r to b. It reports false if r is not in JIS X 0208.
+func (s scheme) appendRune(b []byte, r rune) ([]byte, bool) {
+	if r < utf8.RuneSelf {
+		return append(b, byte(r)), true
+	}
+	k, ok := KutenOf(r)
+	if !ok {
+		return b, false
+	}
+	var c uint16
+	switch s {
+	case schemeShiftJIS:
+		c = k.ShiftJIS()
+	case schemeEUCJP:
+		c = k.EUCJP()
+	}
+	return append(b, byte(c>>8), byte(c)), true
+}
+
+// kuten returns the code point of the double-byte code c.
+func (s scheme) kuten(c uint16) (Kuten, bool) {
+	switch s {
+	case schemeShiftJIS:
+		return KutenFromShiftJIS(c)
+	case schemeEUCJP:
+		return KutenFromEUCJP(c)
+	}
+	return Kuten{}, false
+}
+
+type encoder struct {
+	encoding *Encoding
+	offset   int
+	invalid  bool // previous byte was from an invalid UTF-8 sequence
+	buf      []byte
+}
+
+// Reset implements transform.Transformer.
+func (t *encoder) Reset() {
+	t.offset = 0
+	t.invalid = false
+}
+
+// Transform implements transform.Transformer.
+func (t *encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	defer func() {
+		t.offset += nSrc
+	}()
+	e := t.encoding
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+		invalid := r == utf8.RuneError && size == 1
+		b, ok := t.buf[:0], false
+		if r < utf8.RuneSelf || e.is(r) {
+			b, ok = e.scheme.appendRune(b, r)
+		}
+		if !ok && e.replace {
+			b, ok = e.appendReplacement(b)
+			if invalid && t.invalid {
+				b = b[:0] // a run of invalid bytes is replaced once
+			}
+		}
+		if !ok {
+			return nDst, nSrc, &EncodeError{Encoding: e.name, Offset: t.offset + nSrc, Rune: r}
+		}
+		if len(b) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], b)
+		nSrc += size
+		t.buf = b
+		t.invalid = invalid
+	}
+	return nDst, nSrc, nil
+}
+
+func (e *Encoding) appendReplacement(b []byte) ([]byte, bool) {
+	for _, r := range e.replacement {
+		var ok bool
+		if b, ok = e.scheme.appendRune(b, r); !ok {
+			return b, false
+		}
+	}
+	return b, true
+}
+
+type decoder struct {
+	encoding *Encoding
+	offset   int
+}
+
+// Reset implements transform.Transformer.
+func (t *decoder) Reset() {
+	t.offset = 0
+}
+
+// Transform implements transform.Transformer.
+func (t *decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	defer func() {
+		t.offset += nSrc
+	}()
+	e := t.encoding
+	for nSrc < len(src) {
+		if c := src[nSrc]; c < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+		r, size, ok := e.scheme.decode(src[nSrc:])
+		if size == 0 {
+			if !atEOF {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.RuneError, len(src)-nSrc
+		}
+		if !ok {
+			return nDst, nSrc, &DecodeError{
+				Encoding: e.name,
+				Offset:   t.offset + nSrc,
+				Bytes:    append([]byte(nil), src[nSrc:nSrc+size]...),
+				Rune:     r,
+			}
+		}
+		if utf8.RuneLen(r) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// decode decodes the multi-byte sequence at the head of p. It returns size 0 if p is too short.
+// For a sequence out of JIS X 0208, it returns false with the character in a vendor extension
+// or in JIS X 0201, if known, otherwise utf8.RuneError.
+func (s scheme) decode(p []byte) (r rune, size int, ok bool) {
+	lead := p[0]
+	switch {
+	case s == schemeShiftJIS && lead >= 0xA1 && lead <= 0xDF: // JIS X 0201 katakana
+		return rune(lead) - 0xA1 + '｡', 1, false
+	case s == schemeShiftJIS && (lead >= 0x81 && lead <= 0x9F || lead >= 0xE0 && lead <= 0xFC):
+	case s == schemeEUCJP && lead == 0x8E: // SS2, JIS X 0201 katakana
+		if len(p) < 2 {
+			return 0, 0, false
+		}
+		if p[1] >= 0xA1 && p[1] <= 0xDF {
+			return rune(p[1]) - 0xA1 + '｡', 2, false
+		}
+		return utf8.RuneError, 1, false
+	case s == schemeEUCJP && lead == 0x8F: // SS3, JIS X 0212
+		if len(p) < 3 {
+			return 0, 0, false
+		}
+		return utf8.RuneError, 3, false
+	case s == schemeEUCJP && lead >= 0xA1 && lead <= 0xFE:
+	default:
+		return utf8.RuneError, 1, false
+	}
+	if len(p) < 2 {
+		return 0, 0, false
+	}
+	if p[1] < 0x40 {
+		return utf8.RuneError, 1, false
+	}
+	c := uint16(lead)<<8 | uint16(p[1])
+	k, valid := s.kuten(c)
+	if r, assigned := k.Rune(); valid && assigned {
+		return r, 2, true
+	}
+	return s.vendorRune(c), 2, false
+}
+
+// vendorRune returns the CP932 vendor extended character of the double-byte code c, if any.
+func (s scheme) vendorRune(c uint16) rune {
+	if s == schemeEUCJP {
+		k, ok := KutenFromEUCJP(c)
+		if !ok || k.Ku != 13 { // only NEC special characters are in EUC-JP
+			return utf8.RuneError
+		}
+		c = k.ShiftJIS()
+	}
+	if r, ok := cp932Rune(c); ok {
+		return r
+	}
+	return utf8.RuneError
+}
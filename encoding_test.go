@@ -0,0 +1,249 @@
+package jisx0208
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestEncoding_CompatibleWithXText(t *testing.T) {
+	var b strings.Builder
+	for _, row := range kutenTable {
+		for _, r := range row {
+			if r != 0 {
+				b.WriteRune(r)
+			}
+		}
+	}
+	for r := rune(0); r < 0x80; r++ {
+		b.WriteRune(r)
+	}
+	s := b.String()
+	tests := []struct {
+		encoding *Encoding
+		xtext    encoding.Encoding
+	}{
+		{encoding: ShiftJIS, xtext: japanese.ShiftJIS},
+		{encoding: EUCJP, xtext: japanese.EUCJP},
+	}
+	for _, tt := range tests {
+		t.Run(tt.encoding.String(), func(t *testing.T) {
+			got, err := tt.encoding.NewEncoder().String(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want, err := tt.xtext.NewEncoder().String(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != want {
+				t.Errorf("encoded string differs from golang.org/x/text")
+			}
+			dec, err := tt.encoding.NewDecoder().String(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dec != s {
+				t.Errorf("decoded string differs from the original")
+			}
+		})
+	}
+}
+
+func TestEncoding_EncodeError(t *testing.T) {
+	tests := []struct {
+		encoding *Encoding
+		s        string
+		want     EncodeError
+	}{
+		{encoding: ShiftJIS, s: "高島屋と髙島屋", want: EncodeError{Encoding: "Shift_JIS", Offset: 12, Rune: '髙'}},
+		{encoding: EUCJP, s: "abcｱ", want: EncodeError{Encoding: "EUC-JP", Offset: 3, Rune: 'ｱ'}},
+		{encoding: ShiftJIS, s: "ab\xFF", want: EncodeError{Encoding: "Shift_JIS", Offset: 2, Rune: '�'}},
+	}
+	for _, tt := range tests {
+		_, err := tt.encoding.NewEncoder().String(tt.s)
+		var got *EncodeError
+		if !errors.As(err, &got) {
+			t.Fatalf("want EncodeError, got %v", err)
+		}
+		if *got != tt.want {
+			t.Errorf("got %+v, want %+v", *got, tt.want)
+		}
+	}
+	err := &EncodeError{Encoding: "Shift_JIS", Offset: 12, Rune: '髙'}
+	if got, want := err.Error(), "jisx0208: cannot encode U+9AD9 '髙' in Shift_JIS at offset 12"; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestEncoding_EncodeErrorOffsetAcrossChunks(t *testing.T) {
+	s := strings.Repeat("あ", 1000) + "髙"
+	w := transform.NewWriter(io.Discard, ShiftJIS.NewEncoder())
+	var err error
+	for i := 0; i < len(s) && err == nil; i += 7 {
+		end := i + 7
+		if end > len(s) {
+			end = len(s)
+		}
+		_, err = w.Write([]byte(s[i:end]))
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	var got *EncodeError
+	if !errors.As(err, &got) {
+		t.Fatalf("want EncodeError, got %v", err)
+	}
+	if want := 3000; got.Offset != want {
+		t.Errorf("offset = %d, want %d", got.Offset, want)
+	}
+}
+
+func TestEncoding_WithReplacement(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		s        string
+		want     string
+	}{
+		{
+			name:     "default",
+			encoding: ShiftJIS.WithReplacement(nil, "〓"),
+			s:        "髙島屋\xFF\xFE!",
+			want:     "〓島屋〓!",
+		},
+		{
+			name:     "discriminator",
+			encoding: EUCJP.WithReplacement(NewDiscriminator(Disallow('島'), Allow('髙')), "?"),
+			s:        "髙島屋",
+			want:     "??屋",
+		},
+		{
+			name:     "empty",
+			encoding: ShiftJIS.WithReplacement(nil, ""),
+			s:        "髙島屋",
+			want:     "島屋",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := tt.encoding.NewEncoder().String(tt.s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := tt.encoding.NewDecoder().String(enc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+	_, err := ShiftJIS.WithReplacement(nil, "�").NewEncoder().String("髙")
+	var got *EncodeError
+	if !errors.As(err, &got) || got.Rune != '髙' {
+		t.Errorf("want EncodeError of 髙 for an unencodable replacement, got %v", err)
+	}
+}
+
+func TestEncoding_DecodeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		b        []byte
+		want     DecodeError
+	}{
+		{
+			name:     "IBM extension",
+			encoding: ShiftJIS,
+			b:        []byte{0x8D, 0x82, 0xFA, 0x40},
+			want:     DecodeError{Encoding: "Shift_JIS", Offset: 2, Bytes: []byte{0xFA, 0x40}, Rune: 'ⅰ'},
+		},
+		{
+			name:     "NEC special",
+			encoding: ShiftJIS,
+			b:        []byte{'a', 0x87, 0x40},
+			want:     DecodeError{Encoding: "Shift_JIS", Offset: 1, Bytes: []byte{0x87, 0x40}, Rune: '①'},
+		},
+		{
+			name:     "NEC selected IBM extension",
+			encoding: ShiftJIS,
+			b:        []byte{0xED, 0x40},
+			want:     DecodeError{Encoding: "Shift_JIS", Offset: 0, Bytes: []byte{0xED, 0x40}, Rune: '纊'},
+		},
+		{
+			name:     "halfwidth katakana",
+			encoding: ShiftJIS,
+			b:        []byte{0xB1},
+			want:     DecodeError{Encoding: "Shift_JIS", Offset: 0, Bytes: []byte{0xB1}, Rune: 'ｱ'},
+		},
+		{
+			name:     "truncated",
+			encoding: ShiftJIS,
+			b:        []byte{'a', 0x8D},
+			want:     DecodeError{Encoding: "Shift_JIS", Offset: 1, Bytes: []byte{0x8D}, Rune: '�'},
+		},
+		{
+			name:     "EUC-JP NEC special",
+			encoding: EUCJP,
+			b:        []byte{0xAD, 0xA1},
+			want:     DecodeError{Encoding: "EUC-JP", Offset: 0, Bytes: []byte{0xAD, 0xA1}, Rune: '①'},
+		},
+		{
+			name:     "EUC-JP JIS X 0212",
+			encoding: EUCJP,
+			b:        []byte{0xA4, 0xA2, 0x8F, 0xB0, 0xA1},
+			want:     DecodeError{Encoding: "EUC-JP", Offset: 2, Bytes: []byte{0x8F, 0xB0, 0xA1}, Rune: '�'},
+		},
+		{
+			name:     "EUC-JP halfwidth katakana",
+			encoding: EUCJP,
+			b:        []byte{0x8E, 0xB1},
+			want:     DecodeError{Encoding: "EUC-JP", Offset: 0, Bytes: []byte{0x8E, 0xB1}, Rune: 'ｱ'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.encoding.NewDecoder().Bytes(tt.b)
+			var got *DecodeError
+			if !errors.As(err, &got) {
+				t.Fatalf("want DecodeError, got %v", err)
+			}
+			if got.Offset != tt.want.Offset || got.Rune != tt.want.Rune || got.Encoding != tt.want.Encoding || !bytes.Equal(got.Bytes, tt.want.Bytes) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+	err := &DecodeError{Encoding: "Shift_JIS", Offset: 2, Bytes: []byte{0xFA, 0x40}, Rune: 'ⅰ'}
+	if got, want := err.Error(), "jisx0208: cannot decode FA 40 (U+2170 'ⅰ') in Shift_JIS at offset 2"; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestDecode_NECSelectedIBMExtension(t *testing.T) {
+	for c := uint16(0xED40); c <= 0xEEFC; c++ {
+		want, err := japanese.ShiftJIS.NewDecoder().Bytes([]byte{byte(c >> 8), byte(c)})
+		if err != nil || bytes.ContainsRune(want, utf8.RuneError) {
+			continue
+		}
+		if r, _ := utf8.DecodeRune(want); Is(r) { // duplicate of a JIS X 0208 character
+			continue
+		}
+		_, err = ShiftJIS.NewDecoder().Bytes([]byte{byte(c >> 8), byte(c)})
+		var got *DecodeError
+		if !errors.As(err, &got) {
+			t.Fatalf("0x%X: want DecodeError, got %v", c, err)
+		}
+		if string(got.Rune) != string(want) {
+			t.Errorf("0x%X: got %q, want %q", c, got.Rune, want)
+		}
+	}
+}
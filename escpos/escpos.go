@@ -0,0 +1,208 @@
+// Package escpos encodes text into the byte sequences of ESC/POS printers, which print kanji
+// of JIS X 0208 in JIS or Shift_JIS in kanji character mode.
+package escpos
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// Commands of ESC/POS for kanji.
+var (
+	// KanjiModeOn is FS &, which selects kanji character mode.
+	KanjiModeOn = []byte{0x1C, '&'}
+	// KanjiModeOff is FS ., which cancels kanji character mode.
+	KanjiModeOff = []byte{0x1C, '.'}
+)
+
+// Code is a kanji code system of ESC/POS, selected by FS C n.
+type Code byte
+
+const (
+	// JIS is the JIS code system, in which kanji are two bytes of 0x21-0x7E.
+	JIS Code = 0
+	// ShiftJIS is the Shift_JIS code system.
+	ShiftJIS Code = 1
+)
+
+// String returns the name of the code system.
+func (c Code) String() string {
+	switch c {
+	case JIS:
+		return "JIS"
+	case ShiftJIS:
+		return "Shift_JIS"
+	}
+	return "unknown"
+}
+
+// Font is a standard font of ESC/POS printers, selected by ESC M n.
+type Font int
+
+const (
+	// FontA is the font of 12x24 dots for ANK characters and 24x24 dots for kanji.
+	FontA Font = iota
+	// FontB is the font of 9x17 dots for ANK characters and 16x16 dots for kanji.
+	FontB
+)
+
+// dots returns the widths of an ANK character and of a kanji in dots.
+func (f Font) dots() (ank, kanji int) {
+	if f == FontB {
+		return 9, 16
+	}
+	return 12, 24
+}
+
+// Option represents an option for the encoder.
+type Option func(e *Encoder)
+
+// Discriminator is an encoder option to set the discriminator of valid characters.
+// Characters that are valid under the discriminator but not in JIS X 0208 are replaced too,
+// since printers cannot print them.
+func Discriminator(d *jisx0208.Discriminator) Option {
+	return func(e *Encoder) {
+		e.discriminator = d
+	}
+}
+
+// Replacement is an encoder option to set the replacement string, which may be empty,
+// of invalid characters. The default is jisx0208.AozoraGeta.
+func Replacement(s string) Option {
+	return func(e *Encoder) {
+		e.replacement = s
+	}
+}
+
+// CodeSystem is an encoder option to set the kanji code system. The default is ShiftJIS.
+func CodeSystem(c Code) Option {
+	return func(e *Encoder) {
+		e.code = c
+	}
+}
+
+// Encoder encodes text into ESC/POS.
+type Encoder struct {
+	discriminator *jisx0208.Discriminator
+	replacement   string
+	code          Code
+}
+
+// NewEncoder returns an encoder of ESC/POS.
+func NewEncoder(options ...Option) *Encoder {
+	ret := Encoder{replacement: jisx0208.AozoraGeta, code: ShiftJIS}
+	for _, option := range options {
+		option(&ret)
+	}
+	return &ret
+}
+
+// Encode returns s encoded into ESC/POS in Shift_JIS. See Encoder.Encode for details.
+func Encode(s string) ([]byte, error) {
+	return NewEncoder().Encode(s)
+}
+
+// Width returns the printed width of s in dots in the font. See Encoder.Width for details.
+func Width(s string, f Font) int {
+	return NewEncoder().Width(s, f)
+}
+
+// SelectCode returns FS C n, which selects the kanji code system of the encoder on the printer.
+func (e *Encoder) SelectCode() []byte {
+	return []byte{0x1C, 'C', byte(e.code)}
+}
+
+// Encode returns s encoded into ESC/POS: ASCII characters, including control characters,
+// are written as is, and each run of JIS X 0208 characters is written in the kanji code system
+// between FS & and FS ., so that the printer is out of kanji character mode at the end.
+// Invalid characters are replaced with the replacement, which fails with an error
+// if it has characters that cannot be printed.
+func (e *Encoder) Encode(s string) ([]byte, error) {
+	var (
+		ret   = make([]byte, 0, len(s))
+		kanji bool
+	)
+	err := e.walk(s, func(r rune, k jisx0208.Kuten, ok bool) {
+		if ok != kanji {
+			if kanji = ok; kanji {
+				ret = append(ret, KanjiModeOn...)
+			} else {
+				ret = append(ret, KanjiModeOff...)
+			}
+		}
+		switch {
+		case !ok:
+			ret = append(ret, byte(r))
+		case e.code == JIS:
+			c := k.JIS()
+			ret = append(ret, byte(c>>8), byte(c))
+		default:
+			c := k.ShiftJIS()
+			ret = append(ret, byte(c>>8), byte(c))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if kanji {
+		ret = append(ret, KanjiModeOff...)
+	}
+	return ret, nil
+}
+
+// Width returns the printed width of s in dots in the font, with the default character spacing
+// and magnification. For text of several lines, it returns the width of the widest line.
+// Control characters are zero dots wide, and invalid characters are counted as the replacement.
+func (e *Encoder) Width(s string, f Font) int {
+	ank, kanji := f.dots()
+	var ret, w int
+	_ = e.walk(s, func(r rune, _ jisx0208.Kuten, ok bool) {
+		switch {
+		case ok:
+			w += kanji
+		case r == '\n':
+			w = 0
+		case r >= ' ' && r != 0x7F:
+			w += ank
+		}
+		if w > ret {
+			ret = w
+		}
+	})
+	return ret
+}
+
+// walk calls f with each character of s after the replacement, with its kuten if it is a kanji.
+func (e *Encoder) walk(s string, f func(r rune, k jisx0208.Kuten, ok bool)) error {
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			f(r, jisx0208.Kuten{}, false)
+			continue
+		}
+		if k, ok := jisx0208.KutenOf(r); ok && e.is(r) {
+			f(r, k, true)
+			continue
+		}
+		for _, v := range e.replacement {
+			if v < utf8.RuneSelf {
+				f(v, jisx0208.Kuten{}, false)
+				continue
+			}
+			k, ok := jisx0208.KutenOf(v)
+			if !ok {
+				return fmt.Errorf("escpos: cannot print %U %q in the replacement", v, v)
+			}
+			f(v, k, true)
+		}
+	}
+	return nil
+}
+
+func (e *Encoder) is(r rune) bool {
+	if e.discriminator != nil {
+		return e.discriminator.Is(r)
+	}
+	return jisx0208.Is(r)
+}
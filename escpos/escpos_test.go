@@ -0,0 +1,135 @@
+package escpos
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestEncoder_Encode_Fixture(t *testing.T) {
+	src, err := os.ReadFile("testdata/receipt.txt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		code Code
+		want string
+	}{
+		{code: ShiftJIS, want: "testdata/receipt.sjis.bin"},
+		{code: JIS, want: "testdata/receipt.jis.bin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			want, err := os.ReadFile(tt.want)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := NewEncoder(CodeSystem(tt.code)).Encode(string(src))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Encode() = % X, want % X", got, want)
+			}
+		})
+	}
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		s       string
+		want    []byte
+	}{
+		{
+			name: "ascii",
+			s:    "abc\n",
+			want: []byte("abc\n"),
+		},
+		{
+			name: "kanji",
+			s:    "漢字",
+			want: []byte("\x1c&\x8a\xbf\x8e\x9a\x1c."),
+		},
+		{
+			name:    "jis",
+			options: []Option{CodeSystem(JIS)},
+			s:       "a漢字b",
+			want:    []byte("a\x1c&\x34\x41\x3b\x7a\x1c.b"),
+		},
+		{
+			name:    "replacement",
+			options: []Option{Replacement("?")},
+			s:       "髙橋",
+			want:    []byte("?\x1c&\x8b\xb4\x1c."),
+		},
+		{
+			name:    "empty replacement",
+			options: []Option{Replacement("")},
+			s:       "①a",
+			want:    []byte("a"),
+		},
+		{
+			name:    "disallowed",
+			options: []Option{Discriminator(jisx0208.NewDiscriminator(jisx0208.Disallow('橋'))), Replacement("_")},
+			s:       "橋",
+			want:    []byte("_"),
+		},
+		{
+			name:    "allowed but not printable",
+			options: []Option{Discriminator(jisx0208.NewDiscriminator(jisx0208.Allow('髙'))), Replacement("_")},
+			s:       "髙",
+			want:    []byte("_"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEncoder(tt.options...).Encode(tt.s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Encode(%q) = % X, want % X", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncoder_Encode_Error(t *testing.T) {
+	if _, err := NewEncoder(Replacement("①")).Encode("髙"); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestEncoder_SelectCode(t *testing.T) {
+	if got, want := NewEncoder().SelectCode(), []byte{0x1C, 'C', 1}; !bytes.Equal(got, want) {
+		t.Errorf("SelectCode() = % X, want % X", got, want)
+	}
+	if got, want := NewEncoder(CodeSystem(JIS)).SelectCode(), []byte{0x1C, 'C', 0}; !bytes.Equal(got, want) {
+		t.Errorf("SelectCode() = % X, want % X", got, want)
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		font Font
+		want int
+	}{
+		{s: "", font: FontA, want: 0},
+		{s: "abc", font: FontA, want: 36},
+		{s: "abc", font: FontB, want: 27},
+		{s: "合計 ￥800", font: FontA, want: 3*24 + 4*12},
+		{s: "合計 ￥800", font: FontB, want: 3*16 + 4*9},
+		{s: "a\n漢字\r\n", font: FontA, want: 48},
+		{s: "髙", font: FontA, want: 24}, // replaced with 〓
+	}
+	for _, tt := range tests {
+		if got := Width(tt.s, tt.font); got != tt.want {
+			t.Errorf("Width(%q, %v) = %d, want %d", tt.s, tt.font, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package jisx0208
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Reason is the reason why a character is valid or not.
+type Reason int
+
+const (
+	// ReasonJISX0208 means the character is in JIS X 0208.
+	ReasonJISX0208 Reason = iota
+	// ReasonAllowed means the character is allowed by the discriminator.
+	ReasonAllowed
+	// ReasonDisallowed means the character is in JIS X 0208, but disallowed by the discriminator.
+	ReasonDisallowed
+	// ReasonJISX0213 means the character is in JIS X 0213 level 3 or 4, or a JIS X 0213 non-kanji.
+	ReasonJISX0213
+	// ReasonNECSpecial means the character is a NEC special character (13区) of CP932.
+	ReasonNECSpecial
+	// ReasonIBMExtension means the character is an IBM extended character of CP932.
+	ReasonIBMExtension
+	// ReasonCompatibilityIdeograph means the character is a CJK compatibility ideograph.
+	ReasonCompatibilityIdeograph
+	// ReasonHalfwidth means the character is a halfwidth form.
+	ReasonHalfwidth
+	// ReasonVariationSelector means the character is a variation selector.
+	ReasonVariationSelector
+	// ReasonControl means the character is a control or format character.
+	ReasonControl
+	// ReasonEmoji means the character is an emoji.
+	ReasonEmoji
+	// ReasonPrivateUse means the character is in a private use area.
+	ReasonPrivateUse
+	// ReasonUnassigned means the code point is not assigned to a character.
+	ReasonUnassigned
+	// ReasonInvalid means the rune is not a valid Unicode code point.
+	ReasonInvalid
+	// ReasonOther means the character is not in JIS X 0208 for none of the above reasons.
+	ReasonOther
+)
+
+// String returns the description of the reason.
+func (r Reason) String() string {
+	switch r {
+	case ReasonJISX0208:
+		return "in JIS X 0208"
+	case ReasonAllowed:
+		return "allowed"
+	case ReasonDisallowed:
+		return "disallowed"
+	case ReasonJISX0213:
+		return "JIS X 0213"
+	case ReasonNECSpecial:
+		return "NEC special character"
+	case ReasonIBMExtension:
+		return "IBM extended character"
+	case ReasonCompatibilityIdeograph:
+		return "CJK compatibility ideograph"
+	case ReasonHalfwidth:
+		return "halfwidth form"
+	case ReasonVariationSelector:
+		return "variation selector"
+	case ReasonControl:
+		return "control or format character"
+	case ReasonEmoji:
+		return "emoji"
+	case ReasonPrivateUse:
+		return "private use character"
+	case ReasonUnassigned:
+		return "unassigned code point"
+	case ReasonInvalid:
+		return "invalid code point"
+	}
+	return "not in JIS X 0208"
+}
+
+// Explanation is the structured reason why a character is valid or not.
+type Explanation struct {
+	Rune   rune
+	Reason Reason
+	// JISX0213 is the JIS X 0213 code point of the character, if any.
+	JISX0213 MenKuTen
+	// SJIS is the Shift_JIS code of a CP932 vendor extended character, if any.
+	SJIS uint16
+	// Canonical is the canonical equivalent of a CJK compatibility ideograph, if any.
+	Canonical rune
+	// Alternative is a JIS X 0208 string that can be used instead of the character, if known.
+	Alternative string
+}
+
+// Valid reports whether the character is valid.
+func (e Explanation) Valid() bool {
+	return e.Reason == ReasonJISX0208 || e.Reason == ReasonAllowed
+}
+
+// String returns the explanation in the form:
+//
+//	U+9AD9 '髙': IBM extended character (Shift_JIS 0xFBFC), alternative '高'
+func (e Explanation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%U %s: %v", e.Rune, quoteRune(e.Rune), e.Reason)
+	switch {
+	case e.JISX0213 != MenKuTen{} && e.JISX0213.Level() != 0:
+		fmt.Fprintf(&b, " level %d (%v)", e.JISX0213.Level(), e.JISX0213)
+	case e.JISX0213 != MenKuTen{}:
+		fmt.Fprintf(&b, " (%v)", e.JISX0213)
+	}
+	if e.SJIS != 0 {
+		fmt.Fprintf(&b, " (Shift_JIS 0x%04X)", e.SJIS)
+	}
+	if e.Canonical != 0 {
+		fmt.Fprintf(&b, " of %U %s", e.Canonical, quoteRune(e.Canonical))
+	}
+	if e.Alternative != "" {
+		fmt.Fprintf(&b, ", alternative '%s'", e.Alternative)
+	}
+	return b.String()
+}
+
+func quoteRune(r rune) string {
+	if unicode.IsGraphic(r) && !unicode.Is(unicode.M, r) {
+		return "'" + string(r) + "'"
+	}
+	return fmt.Sprintf("%+q", r)
+}
+
+// Explain returns the reason why the rune r is in JIS X 0208 or not.
+func Explain(r rune) Explanation {
+	return explain(r)
+}
+
+// Explain returns the reason why the rune r is valid or not under the discriminator.
+func (d *Discriminator) Explain(r rune) Explanation {
+	e := explain(r)
+	switch {
+	case d.Is(r) && !e.Valid():
+		e.Reason = ReasonAllowed
+	case !d.Is(r) && e.Valid():
+		e.Reason = ReasonDisallowed
+	}
+	return e
+}
+
+func explain(r rune) Explanation {
+	ret := Explanation{Rune: r}
+	if !utf8.ValidRune(r) {
+		ret.Reason = ReasonInvalid
+		return ret
+	}
+	if Is(r) {
+		ret.Reason = ReasonJISX0208
+		return ret
+	}
+	ret.JISX0213, _ = JISX0213(r)
+	ret.SJIS, _ = cp932(r)
+	if unicode.Is(compatibilityIdeographs, r) {
+		if d := []rune(norm.NFD.String(string(r))); len(d) == 1 && d[0] != r {
+			ret.Canonical = d[0]
+		}
+	}
+	ret.Alternative = alternative(r)
+	switch {
+	case unicode.Is(unicode.Variation_Selector, r):
+		ret.Reason = ReasonVariationSelector
+	case unicode.In(r, unicode.Cc, unicode.Cf):
+		ret.Reason = ReasonControl
+	case ret.SJIS != 0 && ret.SJIS < 0x8800:
+		ret.Reason = ReasonNECSpecial
+	case ret.SJIS != 0:
+		ret.Reason = ReasonIBMExtension
+	case ret.JISX0213 != MenKuTen{}:
+		ret.Reason = ReasonJISX0213
+	case unicode.Is(compatibilityIdeographs, r):
+		ret.Reason = ReasonCompatibilityIdeograph
+	case width.LookupRune(r).Kind() == width.EastAsianHalfwidth:
+		ret.Reason = ReasonHalfwidth
+	case unicode.Is(emoji, r):
+		ret.Reason = ReasonEmoji
+	case unicode.Is(unicode.Co, r):
+		ret.Reason = ReasonPrivateUse
+	case !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs):
+		ret.Reason = ReasonUnassigned
+	default:
+		ret.Reason = ReasonOther
+	}
+	return ret
+}
+
+// alternative returns a JIS X 0208 string that can be used instead of the rune r, if known.
+// Compatibility decompositions, e.g. "1" for ① or "kg" for ㎏, are not alternatives, since
+// they lose the meaning of the character; only canonical and width foldings are.
+func alternative(r rune) string {
+	if v, ok := alternatives[r]; ok {
+		return string(v)
+	}
+	for _, f := range []func(string) string{norm.NFD.String, width.Widen.String} {
+		s := f(string(r))
+		if s != string(r) && isAll(s) {
+			return s
+		}
+	}
+	return ""
+}
+
+func isAll(s string) bool {
+	for _, r := range s {
+		if !Is(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compatibilityIdeographs is the CJK compatibility ideographs blocks.
+var compatibilityIdeographs = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x2F800, Hi: 0x2FA1F, Stride: 1},
+	},
+}
+
+// emoji is the blocks mainly consisting of emoji.
+var emoji = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1FAFF, Stride: 1},
+	},
+}
+
+// alternatives is the JIS X 0208 characters that can be used instead of well-known characters outside the set:
+// the characters mapped differently between JIS X 0208 and Unicode, and variant forms of kanji (異体字).
+var alternatives = map[rune]rune{
+	'¢': '￠',
+	'£': '￡',
+	'¥': '￥',
+	'¬': '￢',
+	'—': '―',
+	'‖': '∥',
+	'‾': '￣',
+	'−': '－',
+	'〜': '～',
+	'吴': '呉',
+	'塡': '填',
+	'增': '増',
+	'姸': '妍',
+	'屛': '屏',
+	'幷': '并',
+	'德': '徳',
+	'昻': '昂',
+	'曻': '昇',
+	'栁': '柳',
+	'桒': '桑',
+	'槪': '概',
+	'樱': '桜',
+	'橫': '横',
+	'淸': '清',
+	'濵': '濱',
+	'瀨': '瀬',
+	'瘦': '痩',
+	'緖': '緒',
+	'繫': '繋',
+	'羡': '羨',
+	'蔣': '蒋',
+	'薰': '薫',
+	'裵': '裴',
+	'賴': '頼',
+	'郞': '郎',
+	'靑': '青',
+	'頰': '頬',
+	'髙': '高',
+	'鷗': '鴎',
+	'黑': '黒',
+	'噓': '嘘',
+	'剝': '剥',
+	'卽': '即',
+	'﨑': '崎',
+	'𠮟': '叱',
+	'𠮷': '吉',
+}
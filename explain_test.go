@@ -0,0 +1,153 @@
+package jisx0208
+
+import (
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name string
+		rune rune
+		want Explanation
+	}{
+		{
+			name: "JIS X 0208",
+			rune: '高',
+			want: Explanation{Rune: '高', Reason: ReasonJISX0208},
+		},
+		{
+			name: "IBM extension",
+			rune: '髙',
+			want: Explanation{Rune: '髙', Reason: ReasonIBMExtension, SJIS: 0xFBFC, Alternative: "高"},
+		},
+		{
+			name: "NEC special",
+			rune: '①',
+			want: Explanation{Rune: '①', Reason: ReasonNECSpecial, JISX0213: MenKuTen{Men: 1, Ku: 13, Ten: 1}, SJIS: 0x8740},
+		},
+		{
+			name: "JIS X 0213 level 3",
+			rune: '𠮟',
+			want: Explanation{Rune: '𠮟', Reason: ReasonJISX0213, JISX0213: MenKuTen{Men: 1, Ku: 47, Ten: 52}, Alternative: "叱"},
+		},
+		{
+			name: "JIS X 0213 mapped differently",
+			rune: '〜',
+			want: Explanation{Rune: '〜', Reason: ReasonJISX0213, JISX0213: MenKuTen{Men: 1, Ku: 1, Ten: 33}, Alternative: "～"},
+		},
+		{
+			name: "compatibility ideograph",
+			rune: '\uF900',
+			want: Explanation{Rune: '\uF900', Reason: ReasonCompatibilityIdeograph, Canonical: '豈', Alternative: "豈"},
+		},
+		{
+			name: "halfwidth",
+			rune: 'ｱ',
+			want: Explanation{Rune: 'ｱ', Reason: ReasonHalfwidth, JISX0213: MenKuTen{}, Alternative: "ア"},
+		},
+		{
+			name: "variation selector",
+			rune: '\U000E0100',
+			want: Explanation{Rune: '\U000E0100', Reason: ReasonVariationSelector},
+		},
+		{
+			name: "control",
+			rune: '\u200d',
+			want: Explanation{Rune: '\u200d', Reason: ReasonControl},
+		},
+		{
+			name: "emoji",
+			rune: '🙅',
+			want: Explanation{Rune: '🙅', Reason: ReasonEmoji},
+		},
+		{
+			name: "private use",
+			rune: '',
+			want: Explanation{Rune: '', Reason: ReasonPrivateUse},
+		},
+		{
+			name: "unassigned",
+			rune: '\U0003FFFD',
+			want: Explanation{Rune: '\U0003FFFD', Reason: ReasonUnassigned},
+		},
+		{
+			name: "invalid",
+			rune: 0xD800,
+			want: Explanation{Rune: 0xD800, Reason: ReasonInvalid},
+		},
+		{
+			name: "other",
+			rune: '说',
+			want: Explanation{Rune: '说', Reason: ReasonOther},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Explain(tt.rune); got != tt.want {
+				t.Errorf("Explain() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscriminator_Explain(t *testing.T) {
+	d := NewDiscriminator(Allow('髙'), Disallow('魚'))
+	if got := d.Explain('髙'); got.Reason != ReasonAllowed || !got.Valid() {
+		t.Errorf("Explain(髙) = %+v, want allowed", got)
+	}
+	if got := d.Explain('魚'); got.Reason != ReasonDisallowed || got.Valid() {
+		t.Errorf("Explain(魚) = %+v, want disallowed", got)
+	}
+	if got := d.Explain('﨑'); got.Reason != ReasonIBMExtension {
+		t.Errorf("Explain(﨑) = %+v, want IBM extension", got)
+	}
+}
+
+func TestExplanation_String(t *testing.T) {
+	tests := []struct {
+		rune rune
+		want string
+	}{
+		{rune: '髙', want: "U+9AD9 '髙': IBM extended character (Shift_JIS 0xFBFC), alternative '高'"},
+		{rune: '𠮟', want: "U+20B9F '𠮟': JIS X 0213 level 3 (1-47-52), alternative '叱'"},
+		{rune: '〻', want: "U+303B '〻': JIS X 0213 (1-2-22)"},
+		{rune: '\uF900', want: "U+F900 '\uF900': CJK compatibility ideograph of U+8C48 '豈', alternative '豈'"},
+		{rune: '\u200d', want: `U+200D '\u200d': control or format character`},
+	}
+	for _, v := range tests {
+		if got := Explain(v.rune).String(); got != v.want {
+			t.Errorf("Explain(%U).String() = %v, want %v", v.rune, got, v.want)
+		}
+	}
+}
+
+func TestAlternatives(t *testing.T) {
+	for k, v := range alternatives {
+		if Is(k) {
+			t.Errorf("%c is in JIS X 0208", k)
+		}
+		if !Is(v) {
+			t.Errorf("alternative of %c, %c is not in JIS X 0208", k, v)
+		}
+	}
+}
+
+func TestAlternative(t *testing.T) {
+	tests := []struct {
+		rune rune
+		want string
+	}{
+		{rune: '髙', want: "高"},
+		{rune: '\uF900', want: "豈"},
+		{rune: 'ｱ', want: "ア"},
+		// compatibility decompositions lose the meaning of the characters
+		{rune: '①', want: ""},
+		{rune: '㎏', want: ""},
+		{rune: 'Ⅸ', want: ""},
+	}
+	for _, tt := range tests {
+		if got := alternative(tt.rune); got != tt.want {
+			t.Errorf("alternative(%q) = %q, want %q", tt.rune, got, tt.want)
+		}
+	}
+}
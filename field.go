@@ -0,0 +1,184 @@
+package jisx0208
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// Len returns the length of s encoded in the encoding, including the escape sequences of ISO-2022-JP.
+// It fails with an *EncodeError on runes out of JIS X 0208, unless the encoding has a replacement.
+func (e *Encoding) Len(s string) (int, error) {
+	s, err := e.sanitize(s)
+	if err != nil {
+		return 0, err
+	}
+	var ret int
+	e.walk(s, func(_, n int) bool {
+		ret = n
+		return true
+	})
+	return ret, nil
+}
+
+// Truncate returns the longest prefix of s whose encoding fits in n bytes, without splitting a character.
+// For ISO-2022-JP, the escape sequences are counted, including the one that returns to ASCII at the end.
+// Invalid runes are replaced if the encoding has a replacement, so the result is always encodable;
+// otherwise it fails with an *EncodeError.
+func (e *Encoding) Truncate(s string, n int) (string, error) {
+	s, err := e.sanitize(s)
+	if err != nil {
+		return "", err
+	}
+	end := 0
+	e.walk(s, func(i, m int) bool {
+		if m > n {
+			return false
+		}
+		end = i
+		return true
+	})
+	return s[:end], nil
+}
+
+// Pad returns s truncated to n bytes and padded with the rune pad, e.g. ' ' or '　' (U+3000),
+// so that its encoding is exactly n bytes. If pad does not fill the rest exactly, ASCII spaces fill it.
+func (e *Encoding) Pad(s string, n int, pad rune) (string, error) {
+	s, err := e.Truncate(s, n)
+	if err != nil {
+		return "", err
+	}
+	if err := e.checkPad(pad, len(s)); err != nil {
+		return "", err
+	}
+	m, _ := e.Len(s)
+	for m < n {
+		v := s + string(pad)
+		if l, _ := e.Len(v); l <= n {
+			s, m = v, l
+			continue
+		}
+		s, m = s+" ", m+1 // an ASCII space is one byte in any state
+	}
+	return s, nil
+}
+
+// TruncateWidth returns the longest prefix of s whose display width fits in n columns, as Width counts.
+// Invalid runes are replaced if the encoding has a replacement, so the result is always encodable;
+// otherwise it fails with an *EncodeError.
+func (e *Encoding) TruncateWidth(s string, n int) (string, error) {
+	s, err := e.sanitize(s)
+	if err != nil {
+		return "", err
+	}
+	w := 0
+	for i, r := range s {
+		if w += runeWidth(r); w > n {
+			return s[:i], nil
+		}
+	}
+	return s, nil
+}
+
+// PadWidth returns s truncated to n columns and padded with the rune pad, e.g. ' ' or '　' (U+3000),
+// so that its display width is exactly n columns. If pad does not fill the rest exactly, ASCII spaces fill it.
+func (e *Encoding) PadWidth(s string, n int, pad rune) (string, error) {
+	s, err := e.TruncateWidth(s, n)
+	if err != nil {
+		return "", err
+	}
+	if err := e.checkPad(pad, len(s)); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString(s)
+	w, pw := Width(s), runeWidth(pad)
+	for ; pw > 0 && w+pw <= n; w += pw {
+		b.WriteRune(pad)
+	}
+	for ; w < n; w++ {
+		b.WriteByte(' ')
+	}
+	return b.String(), nil
+}
+
+// Width returns the East Asian display width of s in columns. Characters in JIS X 0208 other than
+// ASCII, and wide, fullwidth and ambiguous characters are two columns wide, as in legacy fixed-width
+// fields; control characters and nonspacing marks are zero columns wide; the others are one column wide.
+func Width(s string) int {
+	var ret int
+	for _, r := range s {
+		ret += runeWidth(r)
+	}
+	return ret
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case r < ' ' || r == 0x7F:
+		return 0
+	case r < utf8.RuneSelf:
+		return 1
+	case Is(r):
+		return 2
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Mn, unicode.Me):
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth, width.EastAsianAmbiguous:
+		return 2
+	}
+	return 1
+}
+
+// sanitize returns s with invalid runes replaced if the encoding has a replacement,
+// otherwise it fails with an *EncodeError on the first invalid rune.
+func (e *Encoding) sanitize(s string) (string, error) {
+	b, err := e.NewEncoder().String(s)
+	if err != nil {
+		return "", err
+	}
+	if !e.replace {
+		return s, nil
+	}
+	return e.NewDecoder().String(b)
+}
+
+// checkPad returns an *EncodeError if the rune pad is not valid in the encoding.
+func (e *Encoding) checkPad(pad rune, offset int) error {
+	if pad < utf8.RuneSelf {
+		return nil
+	}
+	if _, ok := KutenOf(pad); ok && e.is(pad) {
+		return nil
+	}
+	return &EncodeError{Encoding: e.name, Offset: offset, Rune: pad}
+}
+
+// walk calls f with the byte offset of s after each rune and the length of the prefix encoded,
+// including the escape sequence that returns to ASCII at the end, until f returns false.
+// The string s must be valid in the encoding.
+func (e *Encoding) walk(s string, f func(i, n int) bool) {
+	var (
+		b     []byte
+		state iso2022jpState
+		n     int
+	)
+	for i, r := range s {
+		if e.scheme == schemeISO2022JP {
+			b, state, _ = appendISO2022JP(b[:0], r, state)
+		} else {
+			b, _ = e.scheme.appendRune(b[:0], r)
+		}
+		n += len(b)
+		m := n
+		if state != stateASCII {
+			m += len(escASCII)
+		}
+		if !f(i+utf8.RuneLen(r), m) {
+			return
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package jisx0208
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEncoding_Len(t *testing.T) {
+	inputs := []string{
+		"",
+		"abc",
+		"こんにちは",
+		"ABCあいうDEF",
+		"あ\nい",
+		"凜と熙", // JIS X 0208-1990
+		"亜a唖b",
+	}
+	for _, e := range []*Encoding{ShiftJIS, EUCJP, ISO2022JP} {
+		for _, s := range inputs {
+			want, err := e.NewEncoder().String(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := e.Len(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != len(want) {
+				t.Errorf("%s.Len(%q) = %d, want %d", e, s, got, len(want))
+			}
+		}
+	}
+}
+
+func TestEncoding_Truncate(t *testing.T) {
+	tests := []struct {
+		encoding *Encoding
+		s        string
+		n        int
+		want     string
+	}{
+		{encoding: ShiftJIS, s: "ABCあいう", n: 6, want: "ABCあ"},
+		{encoding: ShiftJIS, s: "ABCあいう", n: 7, want: "ABCあい"},
+		{encoding: EUCJP, s: "あいう", n: 5, want: "あい"},
+		{encoding: ISO2022JP, s: "ABCあいう", n: 10, want: "ABC"},
+		{encoding: ISO2022JP, s: "ABCあいう", n: 11, want: "ABCあ"},
+		{encoding: ISO2022JP, s: "あいう", n: 100, want: "あいう"},
+		{encoding: ShiftJIS.WithReplacement(nil, "〓"), s: "髙島屋", n: 4, want: "〓島"},
+		{encoding: ShiftJIS.WithReplacement(NewDiscriminator(Disallow('島')), "?"), s: "高島屋", n: 4, want: "高?"},
+	}
+	for _, tt := range tests {
+		got, err := tt.encoding.Truncate(tt.s, tt.n)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%s.Truncate(%q, %d) = %q, want %q", tt.encoding, tt.s, tt.n, got, tt.want)
+		}
+		if n, _ := tt.encoding.Len(got); n > tt.n {
+			t.Errorf("%s.Truncate(%q, %d) is %d bytes", tt.encoding, tt.s, tt.n, n)
+		}
+	}
+}
+
+func TestEncoding_Truncate_Error(t *testing.T) {
+	_, err := ShiftJIS.Truncate("高島屋髙", 4)
+	var got *EncodeError
+	if !errors.As(err, &got) {
+		t.Fatalf("want EncodeError, got %v", err)
+	}
+	if got.Offset != 9 || got.Rune != '髙' {
+		t.Errorf("got %+v", *got)
+	}
+}
+
+func TestEncoding_Pad(t *testing.T) {
+	tests := []struct {
+		encoding *Encoding
+		s        string
+		n        int
+		pad      rune
+		want     string
+	}{
+		{encoding: ShiftJIS, s: "ABC", n: 6, pad: ' ', want: "ABC   "},
+		{encoding: ShiftJIS, s: "あい", n: 8, pad: '　', want: "あい　　"},
+		{encoding: ShiftJIS, s: "あい", n: 9, pad: '　', want: "あい　　 "},
+		{encoding: ShiftJIS, s: "あいう", n: 5, pad: '　', want: "あい "},
+		{encoding: EUCJP, s: "A", n: 4, pad: '　', want: "A　 "},
+		{encoding: ISO2022JP, s: "A", n: 11, pad: '　', want: "A　　"},
+		{encoding: ISO2022JP, s: "A", n: 12, pad: '　', want: "A　　 "},
+		{encoding: ISO2022JP, s: "A", n: 10, pad: '　', want: "A　 "},
+		{encoding: ISO2022JP, s: "あ", n: 10, pad: ' ', want: "あ  "},
+	}
+	for _, tt := range tests {
+		got, err := tt.encoding.Pad(tt.s, tt.n, tt.pad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%s.Pad(%q, %d, %q) = %q, want %q", tt.encoding, tt.s, tt.n, tt.pad, got, tt.want)
+		}
+		b, err := tt.encoding.NewEncoder().String(got)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(b) != tt.n {
+			t.Errorf("%s.Pad(%q, %d, %q) is %d bytes", tt.encoding, tt.s, tt.n, tt.pad, len(b))
+		}
+	}
+	if _, err := ShiftJIS.Pad("A", 4, '髙'); err == nil {
+		t.Errorf("want error for invalid pad")
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{s: "abc", want: 3},
+		{s: "あいう", want: 6},
+		{s: "ｱｲｳ", want: 3},
+		{s: "αβ", want: 4},
+		{s: "a\tb", want: 2},
+		{s: "髙", want: 2},
+		{s: "é", want: 2}, // ambiguous
+		{s: "가", want: 2},
+	}
+	for _, tt := range tests {
+		if got := Width(tt.s); got != tt.want {
+			t.Errorf("Width(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestEncoding_PadWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		pad  rune
+		want string
+	}{
+		{s: "あいう", n: 10, pad: '　', want: "あいう　　"},
+		{s: "あいう", n: 5, pad: '　', want: "あい "},
+		{s: "Aあ", n: 6, pad: '　', want: "Aあ　 "},
+		{s: "髙島屋", n: 8, pad: ' ', want: "〓島屋  "},
+	}
+	e := ShiftJIS.WithReplacement(nil, "〓")
+	for _, tt := range tests {
+		got, err := e.PadWidth(tt.s, tt.n, tt.pad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("PadWidth(%q, %d, %q) = %q, want %q", tt.s, tt.n, tt.pad, got, tt.want)
+		}
+		if w := Width(got); w != tt.n {
+			t.Errorf("PadWidth(%q, %d, %q) is %d columns", tt.s, tt.n, tt.pad, w)
+		}
+	}
+	if got, _ := e.TruncateWidth("あいう", 3); got != "あ" {
+		t.Errorf("TruncateWidth() = %q, want %q", got, "あ")
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/ikawaha/jisx0208
 
 go 1.19
+
+require golang.org/x/text v0.14.0
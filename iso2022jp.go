@@ -0,0 +1,223 @@
+package jisx0208
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+const esc = 0x1B
+
+// Escape sequences of ISO-2022-JP.
+var (
+	escASCII     = []byte{esc, '(', 'B'}
+	escJISRoman  = []byte{esc, '(', 'J'}
+	escJIS1978   = []byte{esc, '$', '@'}
+	escJIS1983   = []byte{esc, '$', 'B'}
+	escJIS1990   = []byte{esc, '&', '@', esc, '$', 'B'}
+	escSequences = [][]byte{escASCII, escJISRoman, escJIS1978, escJIS1983, escJIS1990}
+)
+
+// iso2022jpState is the character set designated to G0: 0 for ASCII, or an edition of JIS X 0208.
+type iso2022jpState = Edition
+
+const (
+	stateASCII    iso2022jpState = 0
+	stateJISRoman iso2022jpState = -1
+)
+
+func escapeSequence(e Edition) []byte {
+	switch e {
+	case Edition1978:
+		return escJIS1978
+	case Edition1983:
+		return escJIS1983
+	case Edition1990:
+		return escJIS1990
+	}
+	return escASCII
+}
+
+// appendISO2022JP appends the code of the rune r to b, preceded by an escape sequence if the rune
+// requires another character set than the current state. It reports false if r is not in JIS X 0208.
+func appendISO2022JP(b []byte, r rune, state iso2022jpState) ([]byte, iso2022jpState, bool) {
+	if r < utf8.RuneSelf {
+		if state != stateASCII {
+			b, state = append(b, escASCII...), stateASCII
+		}
+		return append(b, byte(r)), state, true
+	}
+	k, ok := KutenOf(r)
+	if !ok {
+		return b, state, false
+	}
+	if e, _ := EditionOf(r); state < e {
+		b, state = append(b, escapeSequence(e)...), e
+	}
+	c := k.JIS()
+	return append(b, byte(c>>8), byte(c)), state, true
+}
+
+type iso2022jpEncoder struct {
+	encoding *Encoding
+	offset   int
+	state    iso2022jpState
+	invalid  bool // previous byte was from an invalid UTF-8 sequence
+	buf      []byte
+}
+
+// Reset implements transform.Transformer.
+func (t *iso2022jpEncoder) Reset() {
+	t.offset = 0
+	t.state = stateASCII
+	t.invalid = false
+}
+
+// Transform implements transform.Transformer.
+func (t *iso2022jpEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	defer func() {
+		t.offset += nSrc
+	}()
+	e := t.encoding
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+		invalid := r == utf8.RuneError && size == 1
+		b, state, ok := t.buf[:0], t.state, false
+		if r < utf8.RuneSelf || e.is(r) {
+			b, state, ok = appendISO2022JP(b, r, state)
+		}
+		if !ok && e.replace {
+			b, state, ok = t.buf[:0], t.state, true
+			if !invalid || !t.invalid { // a run of invalid bytes is replaced once
+				for _, v := range e.replacement {
+					if b, state, ok = appendISO2022JP(b, v, state); !ok {
+						break
+					}
+				}
+			}
+		}
+		if !ok {
+			return nDst, nSrc, &EncodeError{Encoding: e.name, Offset: t.offset + nSrc, Rune: r}
+		}
+		if len(b) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], b)
+		nSrc += size
+		t.buf, t.state, t.invalid = b, state, invalid
+	}
+	if atEOF && t.state != stateASCII {
+		if len(escASCII) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], escASCII)
+		t.state = stateASCII
+	}
+	return nDst, nSrc, nil
+}
+
+type iso2022jpDecoder struct {
+	encoding *Encoding
+	offset   int
+	state    iso2022jpState
+}
+
+// Reset implements transform.Transformer.
+func (t *iso2022jpDecoder) Reset() {
+	t.offset = 0
+	t.state = stateASCII
+}
+
+// Transform implements transform.Transformer.
+// JIS C 6226-1978 is decoded in the same way as the later editions.
+func (t *iso2022jpDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	defer func() {
+		t.offset += nSrc
+	}()
+	for nSrc < len(src) {
+		c := src[nSrc]
+		if c == esc {
+			size, state, ok := t.escape(src[nSrc:])
+			if size == 0 && !atEOF {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			if !ok {
+				return nDst, nSrc, t.error(src[nSrc:nSrc+1], nSrc)
+			}
+			nSrc += size
+			t.state = state
+			continue
+		}
+		r, size := rune(c), 1
+		switch {
+		case c >= utf8.RuneSelf:
+			return nDst, nSrc, t.error(src[nSrc:nSrc+1], nSrc)
+		case c <= ' ' || c == 0x7F: // control characters and space in any state
+		case t.state == stateJISRoman && c == '\\':
+			r = '¥'
+		case t.state == stateJISRoman && c == '~':
+			r = '‾'
+		case t.state > stateASCII:
+			if nSrc+1 >= len(src) {
+				if !atEOF {
+					return nDst, nSrc, transform.ErrShortSrc
+				}
+				return nDst, nSrc, t.error(src[nSrc:], nSrc)
+			}
+			k, ok := KutenFromJIS(uint16(c)<<8 | uint16(src[nSrc+1]))
+			if r, ok = k.Rune(); !ok {
+				return nDst, nSrc, t.error(src[nSrc:nSrc+2], nSrc)
+			}
+			size = 2
+		}
+		if utf8.RuneLen(r) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// escape returns the size of the escape sequence at the head of p and the state it designates.
+// It returns size 0 if p is a prefix of an escape sequence, and false if p is not an escape sequence
+// of ISO-2022-JP limited to JIS X 0208.
+func (t *iso2022jpDecoder) escape(p []byte) (int, iso2022jpState, bool) {
+	for _, v := range escSequences {
+		if len(p) < len(v) && bytes.HasPrefix(v, p) {
+			return 0, t.state, false
+		}
+		if !bytes.HasPrefix(p, v) {
+			continue
+		}
+		switch {
+		case bytes.Equal(v, escASCII):
+			return len(v), stateASCII, true
+		case bytes.Equal(v, escJISRoman):
+			return len(v), stateJISRoman, true
+		case bytes.Equal(v, escJIS1978):
+			return len(v), Edition1978, true
+		case bytes.Equal(v, escJIS1983):
+			return len(v), Edition1983, true
+		case bytes.Equal(v, escJIS1990):
+			return len(v), Edition1990, true
+		}
+	}
+	return -1, t.state, false
+}
+
+func (t *iso2022jpDecoder) error(b []byte, n int) error {
+	return &DecodeError{
+		Encoding: t.encoding.name,
+		Offset:   t.offset + n,
+		Bytes:    append([]byte(nil), b...),
+		Rune:     utf8.RuneError,
+	}
+}
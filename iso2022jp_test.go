@@ -0,0 +1,284 @@
+package jisx0208
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestEditionOf(t *testing.T) {
+	tests := []struct {
+		rune rune
+		want Edition
+		ok   bool
+	}{
+		{rune: '亜', want: Edition1978, ok: true},
+		{rune: '∈', want: Edition1983, ok: true},
+		{rune: '─', want: Edition1983, ok: true},
+		{rune: '鯵', want: Edition1983, ok: true},
+		{rune: '尭', want: Edition1983, ok: true},
+		{rune: '堯', want: Edition1983, ok: true},
+		{rune: '唖', want: Edition1978, ok: true},
+		{rune: '偉', want: Edition1978, ok: true},
+		{rune: '凜', want: Edition1990, ok: true},
+		{rune: '熙', want: Edition1990, ok: true},
+		{rune: 'a', ok: false},
+		{rune: '髙', ok: false},
+	}
+	for _, v := range tests {
+		if got, ok := EditionOf(v.rune); got != v.want || ok != v.ok {
+			t.Errorf("EditionOf(%c) = %v, %v, want %v, %v", v.rune, got, ok, v.want, v.ok)
+		}
+	}
+}
+
+func TestEditionOf_Count(t *testing.T) {
+	counts := map[Edition]int{}
+	for _, row := range kutenTable {
+		for _, r := range row {
+			if e, ok := EditionOf(r); ok {
+				counts[e]++
+			}
+		}
+	}
+	// 1983 added 39 symbols, 32 box drawings and 4 kanji, and moved 44 kanji of the swapped pairs
+	// and the 4 simplified forms; 1990 added 2 kanji.
+	if want := (map[Edition]int{Edition1978: 6879 - 123 - 2, Edition1983: 123, Edition1990: 2}); !reflect.DeepEqual(counts, want) {
+		t.Errorf("got %v, want %v", counts, want)
+	}
+}
+
+func TestGlyphChangeOf(t *testing.T) {
+	tests := []struct {
+		rune rune
+		want Edition
+		ok   bool
+	}{
+		{rune: '亜', ok: false},
+		{rune: '∈', ok: false},
+		{rune: '鯵', ok: false},
+		{rune: '唖', want: Edition1983, ok: true},
+		{rune: '偉', want: Edition1990, ok: true},
+		{rune: '靱', want: Edition1990, ok: true},
+		{rune: '凜', ok: false},
+		{rune: '髙', ok: false},
+	}
+	for _, v := range tests {
+		if got, ok := GlyphChangeOf(v.rune); got != v.want || ok != v.ok {
+			t.Errorf("GlyphChangeOf(%c) = %v, %v, want %v, %v", v.rune, got, ok, v.want, v.ok)
+		}
+	}
+}
+
+func TestISO2022JP_Encode(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "ascii",
+			s:    "abc\n",
+			want: "abc\n",
+		},
+		{
+			name: "1978",
+			s:    "亜",
+			want: "\x1b$@0!\x1b(B",
+		},
+		{
+			name: "1983",
+			s:    "亜∈亜",
+			want: "\x1b$@0!\x1b$B\":0!\x1b(B",
+		},
+		{
+			name: "1983 move",
+			s:    "鯵",
+			want: "\x1b$B03\x1b(B",
+		},
+		{
+			name: "1990",
+			s:    "凜亜",
+			want: "\x1b&@\x1b$Bt%0!\x1b(B",
+		},
+		{
+			name: "glyph changes",
+			s:    "偉い分かりました",
+			want: "\x1b$@0N$$J,$+$j$^$7$?\x1b(B",
+		},
+		{
+			name: "line end",
+			s:    "亜\r\n亜\n",
+			want: "\x1b$@0!\x1b(B\r\n\x1b$@0!\x1b(B\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ISO2022JP.NewEncoder().String(tt.s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestISO2022JP_CompatibleWithXText(t *testing.T) {
+	var b strings.Builder
+	for i, row := range kutenTable {
+		for _, r := range row {
+			// golang.org/x/text does not accept the announcer ESC & @ of JIS X 0208-1990,
+			// which only the two kanji added in 1990 require.
+			if r != 0 && r != '凜' && r != '熙' {
+				b.WriteRune(r)
+			}
+		}
+		b.WriteString("\nabc")
+		if i%2 == 0 {
+			b.WriteString("\r\n")
+		}
+	}
+	s := b.String()
+	enc, err := ISO2022JP.NewEncoder().String(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := japanese.ISO2022JP.NewDecoder().String(enc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != s {
+		t.Errorf("decoded string by golang.org/x/text differs from the original")
+	}
+	xenc, err := japanese.ISO2022JP.NewEncoder().String(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = ISO2022JP.NewDecoder().String(xenc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != s {
+		t.Errorf("decoded string differs from the original")
+	}
+}
+
+func TestISO2022JP_Streaming(t *testing.T) {
+	s := strings.Repeat("人魚は、南の方の海に∈偉\nばかり棲んでいるのではありません。\r\n", 100)
+	var enc bytes.Buffer
+	w := transform.NewWriter(&enc, ISO2022JP.NewEncoder())
+	if _, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(s))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, err := ISO2022JP.NewEncoder().String(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if enc.String() != want {
+		t.Errorf("streaming output differs from the output at once")
+	}
+	r := transform.NewReader(iotest.OneByteReader(&enc), ISO2022JP.NewDecoder())
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != s {
+		t.Errorf("decoded string differs from the original")
+	}
+}
+
+func TestISO2022JP_Decode(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		want string
+	}{
+		{name: "1978", b: "\x1b$@0!\x1b(B", want: "亜"},
+		{name: "1983", b: "\x1b$B0!\x1b(B", want: "亜"},
+		{name: "1990", b: "\x1b&@\x1b$Bt%\x1b(B", want: "凜"},
+		{name: "JIS-Roman", b: "\x1b(J\\~\x1b(B\\~", want: "¥‾\\~"},
+		{name: "no return", b: "\x1b$B0!", want: "亜"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ISO2022JP.NewDecoder().String(tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestISO2022JP_DecodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		want DecodeError
+	}{
+		{
+			name: "JIS X 0212",
+			b:    "a\x1b$(D0!",
+			want: DecodeError{Encoding: "ISO-2022-JP", Offset: 1, Bytes: []byte{esc}, Rune: '�'},
+		},
+		{
+			name: "unassigned",
+			b:    "\x1b$B-!",
+			want: DecodeError{Encoding: "ISO-2022-JP", Offset: 3, Bytes: []byte("-!"), Rune: '�'},
+		},
+		{
+			name: "8bit",
+			b:    "a\xB1",
+			want: DecodeError{Encoding: "ISO-2022-JP", Offset: 1, Bytes: []byte{0xB1}, Rune: '�'},
+		},
+		{
+			name: "truncated escape",
+			b:    "a\x1b$",
+			want: DecodeError{Encoding: "ISO-2022-JP", Offset: 1, Bytes: []byte{esc}, Rune: '�'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ISO2022JP.NewDecoder().String(tt.b)
+			var got *DecodeError
+			if !errors.As(err, &got) {
+				t.Fatalf("want DecodeError, got %v", err)
+			}
+			if got.Offset != tt.want.Offset || got.Rune != tt.want.Rune || !bytes.Equal(got.Bytes, tt.want.Bytes) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestISO2022JP_EncodeError(t *testing.T) {
+	_, err := ISO2022JP.NewEncoder().String("亜\n髙")
+	var got *EncodeError
+	if !errors.As(err, &got) {
+		t.Fatalf("want EncodeError, got %v", err)
+	}
+	if want := (EncodeError{Encoding: "ISO-2022-JP", Offset: 4, Rune: '髙'}); *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+	s, err := ISO2022JP.WithReplacement(nil, "〓").NewEncoder().String("a髙\xFF\xFE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "a\x1b$@\".\"." + "\x1b(B"; s != want {
+		t.Errorf("got %q, want %q", s, want)
+	}
+}
@@ -0,0 +1,71 @@
+package jisx0208
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// MenKuTen represents a JIS X 0213 code point by plane (面), row (区) and cell (点).
+type MenKuTen struct {
+	Men int
+	Ku  int
+	Ten int
+}
+
+// String returns the men-ku-ten notation of the code point, e.g. 1-85-22.
+func (c MenKuTen) String() string {
+	return fmt.Sprintf("%d-%d-%d", c.Men, c.Ku, c.Ten)
+}
+
+// Level returns the level (水準) of the kanji at the code point c: 3 for plane 1 and 4 for plane 2.
+// It returns 0 for the non-kanji rows, 1-1 to 1-13.
+func (c MenKuTen) Level() int {
+	switch {
+	case c.Men == 1 && c.Ku <= 13:
+		return 0
+	case c.Men == 1:
+		return 3
+	}
+	return 4
+}
+
+// Rune returns the rune at the code point c if it is a JIS X 0213 character outside JIS X 0208.
+func (c MenKuTen) Rune() (rune, bool) {
+	jisx0213IndexOnce.Do(func() {
+		jisx0213Index = make(map[MenKuTen]rune, len(jisx0213Table))
+		for _, v := range jisx0213Table {
+			jisx0213Index[v.menKuTen()] = v.r
+		}
+	})
+	r, ok := jisx0213Index[c]
+	return r, ok
+}
+
+// JISX0213 returns the JIS X 0213:2004 code point of the rune r
+// if r is not in JIS X 0208 but is in JIS X 0213.
+func JISX0213(r rune) (MenKuTen, bool) {
+	i := sort.Search(len(jisx0213Table), func(i int) bool {
+		return jisx0213Table[i].r >= r
+	})
+	if i < len(jisx0213Table) && jisx0213Table[i].r == r {
+		return jisx0213Table[i].menKuTen(), true
+	}
+	return MenKuTen{}, false
+}
+
+type jisx0213Char struct {
+	r   rune
+	men uint8
+	ku  uint8
+	ten uint8
+}
+
+func (c jisx0213Char) menKuTen() MenKuTen {
+	return MenKuTen{Men: int(c.men), Ku: int(c.ku), Ten: int(c.ten)}
+}
+
+var (
+	jisx0213IndexOnce sync.Once
+	jisx0213Index     map[MenKuTen]rune
+)
@@ -0,0 +1,4339 @@
+// Code generated by tool/makejisx0213; DO NOT EDIT.
+
+package jisx0208
+
+// jisx0213Table is the JIS X 0213:2004 characters that are not in JIS X 0208, sorted by rune.
+var jisx0213Table = []jisx0213Char{
+	{r: 0x00A0, men: 1, ku: 9, ten: 2},
+	{r: 0x00A1, men: 1, ku: 9, ten: 3},  // ¡
+	{r: 0x00A2, men: 1, ku: 1, ten: 81}, // ¢
+	{r: 0x00A3, men: 1, ku: 1, ten: 82}, // £
+	{r: 0x00A4, men: 1, ku: 9, ten: 4},  // ¤
+	{r: 0x00A6, men: 1, ku: 9, ten: 5},  // ¦
+	{r: 0x00A9, men: 1, ku: 9, ten: 6},  // ©
+	{r: 0x00AA, men: 1, ku: 9, ten: 7},  // ª
+	{r: 0x00AB, men: 1, ku: 9, ten: 8},  // «
+	{r: 0x00AC, men: 1, ku: 2, ten: 44}, // ¬
+	{r: 0x00AD, men: 1, ku: 9, ten: 9},
+	{r: 0x00AE, men: 1, ku: 9, ten: 10},  // ®
+	{r: 0x00AF, men: 1, ku: 9, ten: 11},  // ¯
+	{r: 0x00B2, men: 1, ku: 9, ten: 12},  // ²
+	{r: 0x00B3, men: 1, ku: 9, ten: 13},  // ³
+	{r: 0x00B7, men: 1, ku: 9, ten: 14},  // ·
+	{r: 0x00B8, men: 1, ku: 9, ten: 15},  // ¸
+	{r: 0x00B9, men: 1, ku: 9, ten: 16},  // ¹
+	{r: 0x00BA, men: 1, ku: 9, ten: 17},  // º
+	{r: 0x00BB, men: 1, ku: 9, ten: 18},  // »
+	{r: 0x00BC, men: 1, ku: 9, ten: 19},  // ¼
+	{r: 0x00BD, men: 1, ku: 9, ten: 20},  // ½
+	{r: 0x00BE, men: 1, ku: 9, ten: 21},  // ¾
+	{r: 0x00BF, men: 1, ku: 9, ten: 22},  // ¿
+	{r: 0x00C0, men: 1, ku: 9, ten: 23},  // À
+	{r: 0x00C1, men: 1, ku: 9, ten: 24},  // Á
+	{r: 0x00C2, men: 1, ku: 9, ten: 25},  // Â
+	{r: 0x00C3, men: 1, ku: 9, ten: 26},  // Ã
+	{r: 0x00C4, men: 1, ku: 9, ten: 27},  // Ä
+	{r: 0x00C5, men: 1, ku: 9, ten: 28},  // Å
+	{r: 0x00C6, men: 1, ku: 9, ten: 29},  // Æ
+	{r: 0x00C7, men: 1, ku: 9, ten: 30},  // Ç
+	{r: 0x00C8, men: 1, ku: 9, ten: 31},  // È
+	{r: 0x00C9, men: 1, ku: 9, ten: 32},  // É
+	{r: 0x00CA, men: 1, ku: 9, ten: 33},  // Ê
+	{r: 0x00CB, men: 1, ku: 9, ten: 34},  // Ë
+	{r: 0x00CC, men: 1, ku: 9, ten: 35},  // Ì
+	{r: 0x00CD, men: 1, ku: 9, ten: 36},  // Í
+	{r: 0x00CE, men: 1, ku: 9, ten: 37},  // Î
+	{r: 0x00CF, men: 1, ku: 9, ten: 38},  // Ï
+	{r: 0x00D0, men: 1, ku: 9, ten: 39},  // Ð
+	{r: 0x00D1, men: 1, ku: 9, ten: 40},  // Ñ
+	{r: 0x00D2, men: 1, ku: 9, ten: 41},  // Ò
+	{r: 0x00D3, men: 1, ku: 9, ten: 42},  // Ó
+	{r: 0x00D4, men: 1, ku: 9, ten: 43},  // Ô
+	{r: 0x00D5, men: 1, ku: 9, ten: 44},  // Õ
+	{r: 0x00D6, men: 1, ku: 9, ten: 45},  // Ö
+	{r: 0x00D8, men: 1, ku: 9, ten: 46},  // Ø
+	{r: 0x00D9, men: 1, ku: 9, ten: 47},  // Ù
+	{r: 0x00DA, men: 1, ku: 9, ten: 48},  // Ú
+	{r: 0x00DB, men: 1, ku: 9, ten: 49},  // Û
+	{r: 0x00DC, men: 1, ku: 9, ten: 50},  // Ü
+	{r: 0x00DD, men: 1, ku: 9, ten: 51},  // Ý
+	{r: 0x00DE, men: 1, ku: 9, ten: 52},  // Þ
+	{r: 0x00DF, men: 1, ku: 9, ten: 53},  // ß
+	{r: 0x00E0, men: 1, ku: 9, ten: 54},  // à
+	{r: 0x00E1, men: 1, ku: 9, ten: 55},  // á
+	{r: 0x00E2, men: 1, ku: 9, ten: 56},  // â
+	{r: 0x00E3, men: 1, ku: 9, ten: 57},  // ã
+	{r: 0x00E4, men: 1, ku: 9, ten: 58},  // ä
+	{r: 0x00E5, men: 1, ku: 9, ten: 59},  // å
+	{r: 0x00E6, men: 1, ku: 9, ten: 60},  // æ
+	{r: 0x00E7, men: 1, ku: 9, ten: 61},  // ç
+	{r: 0x00E8, men: 1, ku: 9, ten: 62},  // è
+	{r: 0x00E9, men: 1, ku: 9, ten: 63},  // é
+	{r: 0x00EA, men: 1, ku: 9, ten: 64},  // ê
+	{r: 0x00EB, men: 1, ku: 9, ten: 65},  // ë
+	{r: 0x00EC, men: 1, ku: 9, ten: 66},  // ì
+	{r: 0x00ED, men: 1, ku: 9, ten: 67},  // í
+	{r: 0x00EE, men: 1, ku: 9, ten: 68},  // î
+	{r: 0x00EF, men: 1, ku: 9, ten: 69},  // ï
+	{r: 0x00F0, men: 1, ku: 9, ten: 70},  // ð
+	{r: 0x00F1, men: 1, ku: 9, ten: 71},  // ñ
+	{r: 0x00F2, men: 1, ku: 9, ten: 72},  // ò
+	{r: 0x00F3, men: 1, ku: 9, ten: 73},  // ó
+	{r: 0x00F4, men: 1, ku: 9, ten: 74},  // ô
+	{r: 0x00F5, men: 1, ku: 9, ten: 75},  // õ
+	{r: 0x00F6, men: 1, ku: 9, ten: 76},  // ö
+	{r: 0x00F8, men: 1, ku: 9, ten: 77},  // ø
+	{r: 0x00F9, men: 1, ku: 9, ten: 78},  // ù
+	{r: 0x00FA, men: 1, ku: 9, ten: 79},  // ú
+	{r: 0x00FB, men: 1, ku: 9, ten: 80},  // û
+	{r: 0x00FC, men: 1, ku: 9, ten: 81},  // ü
+	{r: 0x00FD, men: 1, ku: 9, ten: 82},  // ý
+	{r: 0x00FE, men: 1, ku: 9, ten: 83},  // þ
+	{r: 0x00FF, men: 1, ku: 9, ten: 84},  // ÿ
+	{r: 0x0100, men: 1, ku: 9, ten: 85},  // Ā
+	{r: 0x0101, men: 1, ku: 9, ten: 90},  // ā
+	{r: 0x0102, men: 1, ku: 10, ten: 26}, // Ă
+	{r: 0x0103, men: 1, ku: 10, ten: 41}, // ă
+	{r: 0x0104, men: 1, ku: 10, ten: 1},  // Ą
+	{r: 0x0105, men: 1, ku: 10, ten: 12}, // ą
+	{r: 0x0106, men: 1, ku: 10, ten: 28}, // Ć
+	{r: 0x0107, men: 1, ku: 10, ten: 43}, // ć
+	{r: 0x0108, men: 1, ku: 10, ten: 57}, // Ĉ
+	{r: 0x0109, men: 1, ku: 10, ten: 63}, // ĉ
+	{r: 0x010C, men: 1, ku: 10, ten: 29}, // Č
+	{r: 0x010D, men: 1, ku: 10, ten: 44}, // č
+	{r: 0x010E, men: 1, ku: 10, ten: 32}, // Ď
+	{r: 0x010F, men: 1, ku: 10, ten: 47}, // ď
+	{r: 0x0111, men: 1, ku: 10, ten: 48}, // đ
+	{r: 0x0112, men: 1, ku: 9, ten: 88},  // Ē
+	{r: 0x0113, men: 1, ku: 9, ten: 93},  // ē
+	{r: 0x0118, men: 1, ku: 10, ten: 30}, // Ę
+	{r: 0x0119, men: 1, ku: 10, ten: 45}, // ę
+	{r: 0x011A, men: 1, ku: 10, ten: 31}, // Ě
+	{r: 0x011B, men: 1, ku: 10, ten: 46}, // ě
+	{r: 0x011C, men: 1, ku: 10, ten: 58}, // Ĝ
+	{r: 0x011D, men: 1, ku: 10, ten: 64}, // ĝ
+	{r: 0x0124, men: 1, ku: 10, ten: 59}, // Ĥ
+	{r: 0x0125, men: 1, ku: 10, ten: 65}, // ĥ
+	{r: 0x0127, men: 1, ku: 10, ten: 93}, // ħ
+	{r: 0x012A, men: 1, ku: 9, ten: 86},  // Ī
+	{r: 0x012B, men: 1, ku: 9, ten: 91},  // ī
+	{r: 0x0134, men: 1, ku: 10, ten: 60}, // Ĵ
+	{r: 0x0135, men: 1, ku: 10, ten: 66}, // ĵ
+	{r: 0x0139, men: 1, ku: 10, ten: 27}, // Ĺ
+	{r: 0x013A, men: 1, ku: 10, ten: 42}, // ĺ
+	{r: 0x013D, men: 1, ku: 10, ten: 4},  // Ľ
+	{r: 0x013E, men: 1, ku: 10, ten: 15}, // ľ
+	{r: 0x0141, men: 1, ku: 10, ten: 3},  // Ł
+	{r: 0x0142, men: 1, ku: 10, ten: 14}, // ł
+	{r: 0x0143, men: 1, ku: 10, ten: 33}, // Ń
+	{r: 0x0144, men: 1, ku: 10, ten: 49}, // ń
+	{r: 0x0147, men: 1, ku: 10, ten: 34}, // Ň
+	{r: 0x0148, men: 1, ku: 10, ten: 50}, // ň
+	{r: 0x014B, men: 1, ku: 10, ten: 90}, // ŋ
+	{r: 0x014C, men: 1, ku: 9, ten: 89},  // Ō
+	{r: 0x014D, men: 1, ku: 9, ten: 94},  // ō
+	{r: 0x0150, men: 1, ku: 10, ten: 35}, // Ő
+	{r: 0x0151, men: 1, ku: 10, ten: 51}, // ő
+	{r: 0x0152, men: 1, ku: 11, ten: 11}, // Œ
+	{r: 0x0153, men: 1, ku: 11, ten: 10}, // œ
+	{r: 0x0154, men: 1, ku: 10, ten: 25}, // Ŕ
+	{r: 0x0155, men: 1, ku: 10, ten: 40}, // ŕ
+	{r: 0x0158, men: 1, ku: 10, ten: 36}, // Ř
+	{r: 0x0159, men: 1, ku: 10, ten: 52}, // ř
+	{r: 0x015A, men: 1, ku: 10, ten: 5},  // Ś
+	{r: 0x015B, men: 1, ku: 10, ten: 16}, // ś
+	{r: 0x015C, men: 1, ku: 10, ten: 61}, // Ŝ
+	{r: 0x015D, men: 1, ku: 10, ten: 67}, // ŝ
+	{r: 0x015E, men: 1, ku: 10, ten: 7},  // Ş
+	{r: 0x015F, men: 1, ku: 10, ten: 19}, // ş
+	{r: 0x0160, men: 1, ku: 10, ten: 6},  // Š
+	{r: 0x0161, men: 1, ku: 10, ten: 18}, // š
+	{r: 0x0162, men: 1, ku: 10, ten: 39}, // Ţ
+	{r: 0x0163, men: 1, ku: 10, ten: 55}, // ţ
+	{r: 0x0164, men: 1, ku: 10, ten: 8},  // Ť
+	{r: 0x0165, men: 1, ku: 10, ten: 20}, // ť
+	{r: 0x016A, men: 1, ku: 9, ten: 87},  // Ū
+	{r: 0x016B, men: 1, ku: 9, ten: 92},  // ū
+	{r: 0x016C, men: 1, ku: 10, ten: 62}, // Ŭ
+	{r: 0x016D, men: 1, ku: 10, ten: 68}, // ŭ
+	{r: 0x016E, men: 1, ku: 10, ten: 37}, // Ů
+	{r: 0x016F, men: 1, ku: 10, ten: 53}, // ů
+	{r: 0x0170, men: 1, ku: 10, ten: 38}, // Ű
+	{r: 0x0171, men: 1, ku: 10, ten: 54}, // ű
+	{r: 0x0179, men: 1, ku: 10, ten: 9},  // Ź
+	{r: 0x017A, men: 1, ku: 10, ten: 21}, // ź
+	{r: 0x017B, men: 1, ku: 10, ten: 11}, // Ż
+	{r: 0x017C, men: 1, ku: 10, ten: 24}, // ż
+	{r: 0x017D, men: 1, ku: 10, ten: 10}, // Ž
+	{r: 0x017E, men: 1, ku: 10, ten: 23}, // ž
+	{r: 0x0193, men: 1, ku: 11, ten: 9},  // Ɠ
+	{r: 0x01C2, men: 1, ku: 11, ten: 4},  // ǂ
+	{r: 0x01CD, men: 1, ku: 8, ten: 79},  // Ǎ
+	{r: 0x01CE, men: 1, ku: 8, ten: 80},  // ǎ
+	{r: 0x01D0, men: 1, ku: 8, ten: 81},  // ǐ
+	{r: 0x01D1, men: 1, ku: 8, ten: 86},  // Ǒ
+	{r: 0x01D2, men: 1, ku: 8, ten: 87},  // ǒ
+	{r: 0x01D4, men: 1, ku: 8, ten: 88},  // ǔ
+	{r: 0x01D6, men: 1, ku: 8, ten: 89},  // ǖ
+	{r: 0x01D8, men: 1, ku: 8, ten: 90},  // ǘ
+	{r: 0x01DA, men: 1, ku: 8, ten: 91},  // ǚ
+	{r: 0x01DC, men: 1, ku: 8, ten: 92},  // ǜ
+	{r: 0x01F8, men: 1, ku: 8, ten: 84},  // Ǹ
+	{r: 0x01F9, men: 1, ku: 8, ten: 85},  // ǹ
+	{r: 0x01FD, men: 1, ku: 11, ten: 37}, // ǽ
+	{r: 0x0250, men: 1, ku: 11, ten: 19}, // ɐ
+	{r: 0x0251, men: 1, ku: 11, ten: 25}, // ɑ
+	{r: 0x0252, men: 1, ku: 11, ten: 26}, // ɒ
+	{r: 0x0253, men: 1, ku: 11, ten: 5},  // ɓ
+	{r: 0x0254, men: 1, ku: 11, ten: 24}, // ɔ
+	{r: 0x0255, men: 1, ku: 11, ten: 31}, // ɕ
+	{r: 0x0256, men: 1, ku: 10, ten: 78}, // ɖ
+	{r: 0x0257, men: 1, ku: 11, ten: 6},  // ɗ
+	{r: 0x0258, men: 1, ku: 11, ten: 14}, // ɘ
+	{r: 0x0259, men: 1, ku: 11, ten: 16}, // ə
+	{r: 0x025A, men: 1, ku: 11, ten: 35}, // ɚ
+	{r: 0x025C, men: 1, ku: 11, ten: 17}, // ɜ
+	{r: 0x025E, men: 1, ku: 11, ten: 18}, // ɞ
+	{r: 0x025F, men: 1, ku: 10, ten: 85}, // ɟ
+	{r: 0x0260, men: 1, ku: 11, ten: 8},  // ɠ
+	{r: 0x0261, men: 1, ku: 10, ten: 89}, // ɡ
+	{r: 0x0264, men: 1, ku: 11, ten: 22}, // ɤ
+	{r: 0x0265, men: 1, ku: 11, ten: 28}, // ɥ
+	{r: 0x0266, men: 1, ku: 11, ten: 2},  // ɦ
+	{r: 0x0267, men: 1, ku: 11, ten: 34}, // ɧ
+	{r: 0x0268, men: 1, ku: 11, ten: 12}, // ɨ
+	{r: 0x026C, men: 1, ku: 10, ten: 74}, // ɬ
+	{r: 0x026D, men: 1, ku: 10, ten: 84}, // ɭ
+	{r: 0x026E, men: 1, ku: 10, ten: 75}, // ɮ
+	{r: 0x026F, men: 1, ku: 11, ten: 20}, // ɯ
+	{r: 0x0270, men: 1, ku: 10, ten: 91}, // ɰ
+	{r: 0x0271, men: 1, ku: 10, ten: 69}, // ɱ
+	{r: 0x0272, men: 1, ku: 10, ten: 86}, // ɲ
+	{r: 0x0273, men: 1, ku: 10, ten: 79}, // ɳ
+	{r: 0x0275, men: 1, ku: 11, ten: 15}, // ɵ
+	{r: 0x0279, men: 1, ku: 10, ten: 76}, // ɹ
+	{r: 0x027A, men: 1, ku: 11, ten: 33}, // ɺ
+	{r: 0x027B, men: 1, ku: 10, ten: 83}, // ɻ
+	{r: 0x027D, men: 1, ku: 10, ten: 80}, // ɽ
+	{r: 0x027E, men: 1, ku: 10, ten: 71}, // ɾ
+	{r: 0x0281, men: 1, ku: 10, ten: 92}, // ʁ
+	{r: 0x0282, men: 1, ku: 10, ten: 81}, // ʂ
+	{r: 0x0283, men: 1, ku: 10, ten: 72}, // ʃ
+	{r: 0x0284, men: 1, ku: 11, ten: 7},  // ʄ
+	{r: 0x0288, men: 1, ku: 10, ten: 77}, // ʈ
+	{r: 0x0289, men: 1, ku: 11, ten: 13}, // ʉ
+	{r: 0x028A, men: 1, ku: 11, ten: 21}, // ʊ
+	{r: 0x028B, men: 1, ku: 10, ten: 70}, // ʋ
+	{r: 0x028C, men: 1, ku: 11, ten: 23}, // ʌ
+	{r: 0x028D, men: 1, ku: 11, ten: 27}, // ʍ
+	{r: 0x028E, men: 1, ku: 10, ten: 88}, // ʎ
+	{r: 0x0290, men: 1, ku: 10, ten: 82}, // ʐ
+	{r: 0x0291, men: 1, ku: 11, ten: 32}, // ʑ
+	{r: 0x0292, men: 1, ku: 10, ten: 73}, // ʒ
+	{r: 0x0294, men: 1, ku: 11, ten: 1},  // ʔ
+	{r: 0x0295, men: 1, ku: 10, ten: 94}, // ʕ
+	{r: 0x0298, men: 1, ku: 11, ten: 3},  // ʘ
+	{r: 0x029D, men: 1, ku: 10, ten: 87}, // ʝ
+	{r: 0x02A1, men: 1, ku: 11, ten: 30}, // ʡ
+	{r: 0x02A2, men: 1, ku: 11, ten: 29}, // ʢ
+	{r: 0x02C7, men: 1, ku: 10, ten: 17}, // ˇ
+	{r: 0x02C8, men: 1, ku: 11, ten: 51}, // ˈ
+	{r: 0x02CC, men: 1, ku: 11, ten: 52}, // ˌ
+	{r: 0x02D0, men: 1, ku: 11, ten: 53}, // ː
+	{r: 0x02D1, men: 1, ku: 11, ten: 54}, // ˑ
+	{r: 0x02D8, men: 1, ku: 10, ten: 2},  // ˘
+	{r: 0x02D9, men: 1, ku: 10, ten: 56}, // ˙
+	{r: 0x02DB, men: 1, ku: 10, ten: 13}, // ˛
+	{r: 0x02DD, men: 1, ku: 10, ten: 22}, // ˝
+	{r: 0x02DE, men: 1, ku: 11, ten: 81}, // ˞
+	{r: 0x02E5, men: 1, ku: 11, ten: 64}, // ˥
+	{r: 0x02E6, men: 1, ku: 11, ten: 65}, // ˦
+	{r: 0x02E7, men: 1, ku: 11, ten: 66}, // ˧
+	{r: 0x02E8, men: 1, ku: 11, ten: 67}, // ˨
+	{r: 0x02E9, men: 1, ku: 11, ten: 68}, // ˩
+	{r: 0x0300, men: 1, ku: 11, ten: 60},
+	{r: 0x0301, men: 1, ku: 11, ten: 58},
+	{r: 0x0302, men: 1, ku: 11, ten: 63},
+	{r: 0x0303, men: 1, ku: 11, ten: 93},
+	{r: 0x0304, men: 1, ku: 11, ten: 59},
+	{r: 0x0306, men: 1, ku: 11, ten: 55},
+	{r: 0x0308, men: 1, ku: 11, ten: 77},
+	{r: 0x030B, men: 1, ku: 11, ten: 57},
+	{r: 0x030C, men: 1, ku: 11, ten: 62},
+	{r: 0x030F, men: 1, ku: 11, ten: 61},
+	{r: 0x0318, men: 1, ku: 11, ten: 88},
+	{r: 0x0319, men: 1, ku: 11, ten: 89},
+	{r: 0x031A, men: 1, ku: 11, ten: 94},
+	{r: 0x031C, men: 1, ku: 11, ten: 74},
+	{r: 0x031D, men: 1, ku: 11, ten: 86},
+	{r: 0x031E, men: 1, ku: 11, ten: 87},
+	{r: 0x031F, men: 1, ku: 11, ten: 75},
+	{r: 0x0320, men: 1, ku: 11, ten: 76},
+	{r: 0x0324, men: 1, ku: 11, ten: 82},
+	{r: 0x0325, men: 1, ku: 11, ten: 71},
+	{r: 0x0329, men: 1, ku: 11, ten: 79},
+	{r: 0x032A, men: 1, ku: 11, ten: 90},
+	{r: 0x032C, men: 1, ku: 11, ten: 72},
+	{r: 0x032F, men: 1, ku: 11, ten: 80},
+	{r: 0x0330, men: 1, ku: 11, ten: 83},
+	{r: 0x0334, men: 1, ku: 11, ten: 85},
+	{r: 0x0339, men: 1, ku: 11, ten: 73},
+	{r: 0x033A, men: 1, ku: 11, ten: 91},
+	{r: 0x033B, men: 1, ku: 11, ten: 92},
+	{r: 0x033C, men: 1, ku: 11, ten: 84},
+	{r: 0x033D, men: 1, ku: 11, ten: 78},
+	{r: 0x0361, men: 1, ku: 11, ten: 50},
+	{r: 0x03C2, men: 1, ku: 6, ten: 57},   // ς
+	{r: 0x1E3E, men: 1, ku: 8, ten: 82},   // Ḿ
+	{r: 0x1E3F, men: 1, ku: 8, ten: 83},   // ḿ
+	{r: 0x1F70, men: 1, ku: 11, ten: 38},  // ὰ
+	{r: 0x1F71, men: 1, ku: 11, ten: 39},  // ά
+	{r: 0x1F72, men: 1, ku: 11, ten: 48},  // ὲ
+	{r: 0x1F73, men: 1, ku: 11, ten: 49},  // έ
+	{r: 0x2013, men: 1, ku: 3, ten: 92},   // –
+	{r: 0x2016, men: 1, ku: 1, ten: 34},   // ‖
+	{r: 0x2022, men: 1, ku: 3, ten: 32},   // •
+	{r: 0x203C, men: 1, ku: 8, ten: 75},   // ‼
+	{r: 0x203F, men: 1, ku: 11, ten: 56},  // ‿
+	{r: 0x2042, men: 1, ku: 12, ten: 94},  // ⁂
+	{r: 0x2047, men: 1, ku: 8, ten: 76},   // ⁇
+	{r: 0x2048, men: 1, ku: 8, ten: 77},   // ⁈
+	{r: 0x2049, men: 1, ku: 8, ten: 78},   // ⁉
+	{r: 0x2051, men: 1, ku: 12, ten: 93},  // ⁑
+	{r: 0x20AC, men: 1, ku: 9, ten: 1},    // €
+	{r: 0x210F, men: 1, ku: 3, ten: 61},   // ℏ
+	{r: 0x2113, men: 1, ku: 3, ten: 63},   // ℓ
+	{r: 0x2116, men: 1, ku: 13, ten: 66},  // №
+	{r: 0x2121, men: 1, ku: 13, ten: 68},  // ℡
+	{r: 0x2127, men: 1, ku: 3, ten: 64},   // ℧
+	{r: 0x2135, men: 1, ku: 3, ten: 60},   // ℵ
+	{r: 0x2153, men: 1, ku: 7, ten: 88},   // ⅓
+	{r: 0x2154, men: 1, ku: 7, ten: 89},   // ⅔
+	{r: 0x2155, men: 1, ku: 7, ten: 90},   // ⅕
+	{r: 0x2160, men: 1, ku: 13, ten: 21},  // Ⅰ
+	{r: 0x2161, men: 1, ku: 13, ten: 22},  // Ⅱ
+	{r: 0x2162, men: 1, ku: 13, ten: 23},  // Ⅲ
+	{r: 0x2163, men: 1, ku: 13, ten: 24},  // Ⅳ
+	{r: 0x2164, men: 1, ku: 13, ten: 25},  // Ⅴ
+	{r: 0x2165, men: 1, ku: 13, ten: 26},  // Ⅵ
+	{r: 0x2166, men: 1, ku: 13, ten: 27},  // Ⅶ
+	{r: 0x2167, men: 1, ku: 13, ten: 28},  // Ⅷ
+	{r: 0x2168, men: 1, ku: 13, ten: 29},  // Ⅸ
+	{r: 0x2169, men: 1, ku: 13, ten: 30},  // Ⅹ
+	{r: 0x216A, men: 1, ku: 13, ten: 31},  // Ⅺ
+	{r: 0x216B, men: 1, ku: 13, ten: 55},  // Ⅻ
+	{r: 0x2170, men: 1, ku: 12, ten: 21},  // ⅰ
+	{r: 0x2171, men: 1, ku: 12, ten: 22},  // ⅱ
+	{r: 0x2172, men: 1, ku: 12, ten: 23},  // ⅲ
+	{r: 0x2173, men: 1, ku: 12, ten: 24},  // ⅳ
+	{r: 0x2174, men: 1, ku: 12, ten: 25},  // ⅴ
+	{r: 0x2175, men: 1, ku: 12, ten: 26},  // ⅵ
+	{r: 0x2176, men: 1, ku: 12, ten: 27},  // ⅶ
+	{r: 0x2177, men: 1, ku: 12, ten: 28},  // ⅷ
+	{r: 0x2178, men: 1, ku: 12, ten: 29},  // ⅸ
+	{r: 0x2179, men: 1, ku: 12, ten: 30},  // ⅹ
+	{r: 0x217A, men: 1, ku: 12, ten: 31},  // ⅺ
+	{r: 0x217B, men: 1, ku: 12, ten: 32},  // ⅻ
+	{r: 0x2194, men: 1, ku: 2, ten: 81},   // ↔
+	{r: 0x2196, men: 1, ku: 3, ten: 7},    // ↖
+	{r: 0x2197, men: 1, ku: 3, ten: 5},    // ↗
+	{r: 0x2198, men: 1, ku: 3, ten: 6},    // ↘
+	{r: 0x2199, men: 1, ku: 3, ten: 8},    // ↙
+	{r: 0x21C4, men: 1, ku: 3, ten: 9},    // ⇄
+	{r: 0x21E6, men: 1, ku: 3, ten: 11},   // ⇦
+	{r: 0x21E7, men: 1, ku: 3, ten: 12},   // ⇧
+	{r: 0x21E8, men: 1, ku: 3, ten: 10},   // ⇨
+	{r: 0x21E9, men: 1, ku: 3, ten: 13},   // ⇩
+	{r: 0x2205, men: 1, ku: 2, ten: 39},   // ∅
+	{r: 0x2209, men: 1, ku: 2, ten: 38},   // ∉
+	{r: 0x2212, men: 1, ku: 1, ten: 61},   // −
+	{r: 0x2213, men: 1, ku: 3, ten: 59},   // ∓
+	{r: 0x221F, men: 1, ku: 13, ten: 88},  // ∟
+	{r: 0x2226, men: 1, ku: 2, ten: 53},   // ∦
+	{r: 0x222E, men: 1, ku: 13, ten: 83},  // ∮
+	{r: 0x2243, men: 1, ku: 2, ten: 76},   // ≃
+	{r: 0x2245, men: 1, ku: 2, ten: 77},   // ≅
+	{r: 0x2248, men: 1, ku: 2, ten: 78},   // ≈
+	{r: 0x2262, men: 1, ku: 2, ten: 75},   // ≢
+	{r: 0x2276, men: 1, ku: 2, ten: 79},   // ≶
+	{r: 0x2277, men: 1, ku: 2, ten: 80},   // ≷
+	{r: 0x2284, men: 1, ku: 2, ten: 34},   // ⊄
+	{r: 0x2285, men: 1, ku: 2, ten: 35},   // ⊅
+	{r: 0x228A, men: 1, ku: 2, ten: 36},   // ⊊
+	{r: 0x228B, men: 1, ku: 2, ten: 37},   // ⊋
+	{r: 0x2295, men: 1, ku: 2, ten: 49},   // ⊕
+	{r: 0x2296, men: 1, ku: 2, ten: 50},   // ⊖
+	{r: 0x2297, men: 1, ku: 2, ten: 51},   // ⊗
+	{r: 0x22BF, men: 1, ku: 13, ten: 89},  // ⊿
+	{r: 0x22DA, men: 1, ku: 7, ten: 86},   // ⋚
+	{r: 0x22DB, men: 1, ku: 7, ten: 87},   // ⋛
+	{r: 0x2305, men: 1, ku: 2, ten: 40},   // ⌅
+	{r: 0x2306, men: 1, ku: 2, ten: 41},   // ⌆
+	{r: 0x2318, men: 1, ku: 7, ten: 92},   // ⌘
+	{r: 0x23BE, men: 1, ku: 7, ten: 34},   // ⎾
+	{r: 0x23BF, men: 1, ku: 7, ten: 35},   // ⎿
+	{r: 0x23C0, men: 1, ku: 7, ten: 36},   // ⏀
+	{r: 0x23C1, men: 1, ku: 7, ten: 37},   // ⏁
+	{r: 0x23C2, men: 1, ku: 7, ten: 38},   // ⏂
+	{r: 0x23C3, men: 1, ku: 7, ten: 39},   // ⏃
+	{r: 0x23C4, men: 1, ku: 7, ten: 40},   // ⏄
+	{r: 0x23C5, men: 1, ku: 7, ten: 41},   // ⏅
+	{r: 0x23C6, men: 1, ku: 7, ten: 42},   // ⏆
+	{r: 0x23C7, men: 1, ku: 7, ten: 43},   // ⏇
+	{r: 0x23C8, men: 1, ku: 7, ten: 44},   // ⏈
+	{r: 0x23C9, men: 1, ku: 7, ten: 45},   // ⏉
+	{r: 0x23CA, men: 1, ku: 7, ten: 46},   // ⏊
+	{r: 0x23CB, men: 1, ku: 7, ten: 47},   // ⏋
+	{r: 0x23CC, men: 1, ku: 7, ten: 48},   // ⏌
+	{r: 0x23CE, men: 1, ku: 7, ten: 94},   // ⏎
+	{r: 0x2423, men: 1, ku: 7, ten: 93},   // ␣
+	{r: 0x2460, men: 1, ku: 13, ten: 1},   // ①
+	{r: 0x2461, men: 1, ku: 13, ten: 2},   // ②
+	{r: 0x2462, men: 1, ku: 13, ten: 3},   // ③
+	{r: 0x2463, men: 1, ku: 13, ten: 4},   // ④
+	{r: 0x2464, men: 1, ku: 13, ten: 5},   // ⑤
+	{r: 0x2465, men: 1, ku: 13, ten: 6},   // ⑥
+	{r: 0x2466, men: 1, ku: 13, ten: 7},   // ⑦
+	{r: 0x2467, men: 1, ku: 13, ten: 8},   // ⑧
+	{r: 0x2468, men: 1, ku: 13, ten: 9},   // ⑨
+	{r: 0x2469, men: 1, ku: 13, ten: 10},  // ⑩
+	{r: 0x246A, men: 1, ku: 13, ten: 11},  // ⑪
+	{r: 0x246B, men: 1, ku: 13, ten: 12},  // ⑫
+	{r: 0x246C, men: 1, ku: 13, ten: 13},  // ⑬
+	{r: 0x246D, men: 1, ku: 13, ten: 14},  // ⑭
+	{r: 0x246E, men: 1, ku: 13, ten: 15},  // ⑮
+	{r: 0x246F, men: 1, ku: 13, ten: 16},  // ⑯
+	{r: 0x2470, men: 1, ku: 13, ten: 17},  // ⑰
+	{r: 0x2471, men: 1, ku: 13, ten: 18},  // ⑱
+	{r: 0x2472, men: 1, ku: 13, ten: 19},  // ⑲
+	{r: 0x2473, men: 1, ku: 13, ten: 20},  // ⑳
+	{r: 0x24D0, men: 1, ku: 12, ten: 33},  // ⓐ
+	{r: 0x24D1, men: 1, ku: 12, ten: 34},  // ⓑ
+	{r: 0x24D2, men: 1, ku: 12, ten: 35},  // ⓒ
+	{r: 0x24D3, men: 1, ku: 12, ten: 36},  // ⓓ
+	{r: 0x24D4, men: 1, ku: 12, ten: 37},  // ⓔ
+	{r: 0x24D5, men: 1, ku: 12, ten: 38},  // ⓕ
+	{r: 0x24D6, men: 1, ku: 12, ten: 39},  // ⓖ
+	{r: 0x24D7, men: 1, ku: 12, ten: 40},  // ⓗ
+	{r: 0x24D8, men: 1, ku: 12, ten: 41},  // ⓘ
+	{r: 0x24D9, men: 1, ku: 12, ten: 42},  // ⓙ
+	{r: 0x24DA, men: 1, ku: 12, ten: 43},  // ⓚ
+	{r: 0x24DB, men: 1, ku: 12, ten: 44},  // ⓛ
+	{r: 0x24DC, men: 1, ku: 12, ten: 45},  // ⓜ
+	{r: 0x24DD, men: 1, ku: 12, ten: 46},  // ⓝ
+	{r: 0x24DE, men: 1, ku: 12, ten: 47},  // ⓞ
+	{r: 0x24DF, men: 1, ku: 12, ten: 48},  // ⓟ
+	{r: 0x24E0, men: 1, ku: 12, ten: 49},  // ⓠ
+	{r: 0x24E1, men: 1, ku: 12, ten: 50},  // ⓡ
+	{r: 0x24E2, men: 1, ku: 12, ten: 51},  // ⓢ
+	{r: 0x24E3, men: 1, ku: 12, ten: 52},  // ⓣ
+	{r: 0x24E4, men: 1, ku: 12, ten: 53},  // ⓤ
+	{r: 0x24E5, men: 1, ku: 12, ten: 54},  // ⓥ
+	{r: 0x24E6, men: 1, ku: 12, ten: 55},  // ⓦ
+	{r: 0x24E7, men: 1, ku: 12, ten: 56},  // ⓧ
+	{r: 0x24E8, men: 1, ku: 12, ten: 57},  // ⓨ
+	{r: 0x24E9, men: 1, ku: 12, ten: 58},  // ⓩ
+	{r: 0x24EB, men: 1, ku: 12, ten: 11},  // ⓫
+	{r: 0x24EC, men: 1, ku: 12, ten: 12},  // ⓬
+	{r: 0x24ED, men: 1, ku: 12, ten: 13},  // ⓭
+	{r: 0x24EE, men: 1, ku: 12, ten: 14},  // ⓮
+	{r: 0x24EF, men: 1, ku: 12, ten: 15},  // ⓯
+	{r: 0x24F0, men: 1, ku: 12, ten: 16},  // ⓰
+	{r: 0x24F1, men: 1, ku: 12, ten: 17},  // ⓱
+	{r: 0x24F2, men: 1, ku: 12, ten: 18},  // ⓲
+	{r: 0x24F3, men: 1, ku: 12, ten: 19},  // ⓳
+	{r: 0x24F4, men: 1, ku: 12, ten: 20},  // ⓴
+	{r: 0x24F5, men: 1, ku: 6, ten: 58},   // ⓵
+	{r: 0x24F6, men: 1, ku: 6, ten: 59},   // ⓶
+	{r: 0x24F7, men: 1, ku: 6, ten: 60},   // ⓷
+	{r: 0x24F8, men: 1, ku: 6, ten: 61},   // ⓸
+	{r: 0x24F9, men: 1, ku: 6, ten: 62},   // ⓹
+	{r: 0x24FA, men: 1, ku: 6, ten: 63},   // ⓺
+	{r: 0x24FB, men: 1, ku: 6, ten: 64},   // ⓻
+	{r: 0x24FC, men: 1, ku: 6, ten: 65},   // ⓼
+	{r: 0x24FD, men: 1, ku: 6, ten: 66},   // ⓽
+	{r: 0x24FE, men: 1, ku: 6, ten: 67},   // ⓾
+	{r: 0x25B1, men: 1, ku: 6, ten: 77},   // ▱
+	{r: 0x25B6, men: 1, ku: 3, ten: 2},    // ▶
+	{r: 0x25B7, men: 1, ku: 3, ten: 1},    // ▷
+	{r: 0x25C0, men: 1, ku: 3, ten: 4},    // ◀
+	{r: 0x25C1, men: 1, ku: 3, ten: 3},    // ◁
+	{r: 0x25C9, men: 1, ku: 3, ten: 27},   // ◉
+	{r: 0x25D0, men: 1, ku: 8, ten: 71},   // ◐
+	{r: 0x25D1, men: 1, ku: 8, ten: 72},   // ◑
+	{r: 0x25D2, men: 1, ku: 8, ten: 73},   // ◒
+	{r: 0x25D3, men: 1, ku: 8, ten: 74},   // ◓
+	{r: 0x25E6, men: 1, ku: 3, ten: 31},   // ◦
+	{r: 0x2600, men: 1, ku: 6, ten: 72},   // ☀
+	{r: 0x2601, men: 1, ku: 6, ten: 73},   // ☁
+	{r: 0x2602, men: 1, ku: 6, ten: 74},   // ☂
+	{r: 0x2603, men: 1, ku: 6, ten: 75},   // ☃
+	{r: 0x260E, men: 1, ku: 6, ten: 71},   // ☎
+	{r: 0x2616, men: 1, ku: 6, ten: 68},   // ☖
+	{r: 0x2617, men: 1, ku: 6, ten: 69},   // ☗
+	{r: 0x261E, men: 1, ku: 13, ten: 94},  // ☞
+	{r: 0x2660, men: 1, ku: 6, ten: 26},   // ♠
+	{r: 0x2661, men: 1, ku: 6, ten: 29},   // ♡
+	{r: 0x2662, men: 1, ku: 6, ten: 27},   // ♢
+	{r: 0x2663, men: 1, ku: 6, ten: 32},   // ♣
+	{r: 0x2664, men: 1, ku: 6, ten: 25},   // ♤
+	{r: 0x2665, men: 1, ku: 6, ten: 30},   // ♥
+	{r: 0x2666, men: 1, ku: 6, ten: 28},   // ♦
+	{r: 0x2667, men: 1, ku: 6, ten: 31},   // ♧
+	{r: 0x2668, men: 1, ku: 6, ten: 76},   // ♨
+	{r: 0x2669, men: 1, ku: 2, ten: 93},   // ♩
+	{r: 0x266B, men: 1, ku: 2, ten: 91},   // ♫
+	{r: 0x266C, men: 1, ku: 2, ten: 92},   // ♬
+	{r: 0x266E, men: 1, ku: 2, ten: 90},   // ♮
+	{r: 0x2713, men: 1, ku: 7, ten: 91},   // ✓
+	{r: 0x2756, men: 1, ku: 13, ten: 93},  // ❖
+	{r: 0x2776, men: 1, ku: 12, ten: 1},   // ❶
+	{r: 0x2777, men: 1, ku: 12, ten: 2},   // ❷
+	{r: 0x2778, men: 1, ku: 12, ten: 3},   // ❸
+	{r: 0x2779, men: 1, ku: 12, ten: 4},   // ❹
+	{r: 0x277A, men: 1, ku: 12, ten: 5},   // ❺
+	{r: 0x277B, men: 1, ku: 12, ten: 6},   // ❻
+	{r: 0x277C, men: 1, ku: 12, ten: 7},   // ❼
+	{r: 0x277D, men: 1, ku: 12, ten: 8},   // ❽
+	{r: 0x277E, men: 1, ku: 12, ten: 9},   // ❾
+	{r: 0x277F, men: 1, ku: 12, ten: 10},  // ❿
+	{r: 0x2934, men: 1, ku: 3, ten: 14},   // ⤴
+	{r: 0x2935, men: 1, ku: 3, ten: 15},   // ⤵
+	{r: 0x2985, men: 1, ku: 2, ten: 54},   // ⦅
+	{r: 0x2986, men: 1, ku: 2, ten: 55},   // ⦆
+	{r: 0x29BF, men: 1, ku: 3, ten: 26},   // ⦿
+	{r: 0x29FA, men: 1, ku: 3, ten: 93},   // ⧺
+	{r: 0x29FB, men: 1, ku: 3, ten: 94},   // ⧻
+	{r: 0x3016, men: 1, ku: 2, ten: 58},   // 〖
+	{r: 0x3017, men: 1, ku: 2, ten: 59},   // 〗
+	{r: 0x3018, men: 1, ku: 2, ten: 56},   // 〘
+	{r: 0x3019, men: 1, ku: 2, ten: 57},   // 〙
+	{r: 0x301C, men: 1, ku: 1, ten: 33},   // 〜
+	{r: 0x301D, men: 1, ku: 13, ten: 64},  // 〝
+	{r: 0x301F, men: 1, ku: 13, ten: 65},  // 〟
+	{r: 0x3020, men: 1, ku: 6, ten: 70},   // 〠
+	{r: 0x3033, men: 1, ku: 2, ten: 19},   // 〳
+	{r: 0x3034, men: 1, ku: 2, ten: 20},   // 〴
+	{r: 0x3035, men: 1, ku: 2, ten: 21},   // 〵
+	{r: 0x303B, men: 1, ku: 2, ten: 22},   // 〻
+	{r: 0x303C, men: 1, ku: 2, ten: 23},   // 〼
+	{r: 0x303D, men: 1, ku: 3, ten: 28},   // 〽
+	{r: 0x3094, men: 1, ku: 4, ten: 84},   // ゔ
+	{r: 0x3095, men: 1, ku: 4, ten: 85},   // ゕ
+	{r: 0x3096, men: 1, ku: 4, ten: 86},   // ゖ
+	{r: 0x309F, men: 1, ku: 2, ten: 25},   // ゟ
+	{r: 0x30A0, men: 1, ku: 3, ten: 91},   // ゠
+	{r: 0x30F7, men: 1, ku: 7, ten: 82},   // ヷ
+	{r: 0x30F8, men: 1, ku: 7, ten: 83},   // ヸ
+	{r: 0x30F9, men: 1, ku: 7, ten: 84},   // ヹ
+	{r: 0x30FA, men: 1, ku: 7, ten: 85},   // ヺ
+	{r: 0x30FF, men: 1, ku: 2, ten: 24},   // ヿ
+	{r: 0x31F0, men: 1, ku: 6, ten: 78},   // ㇰ
+	{r: 0x31F1, men: 1, ku: 6, ten: 79},   // ㇱ
+	{r: 0x31F2, men: 1, ku: 6, ten: 80},   // ㇲ
+	{r: 0x31F3, men: 1, ku: 6, ten: 81},   // ㇳ
+	{r: 0x31F4, men: 1, ku: 6, ten: 82},   // ㇴ
+	{r: 0x31F5, men: 1, ku: 6, ten: 83},   // ㇵ
+	{r: 0x31F6, men: 1, ku: 6, ten: 84},   // ㇶ
+	{r: 0x31F7, men: 1, ku: 6, ten: 85},   // ㇷ
+	{r: 0x31F8, men: 1, ku: 6, ten: 86},   // ㇸ
+	{r: 0x31F9, men: 1, ku: 6, ten: 87},   // ㇹ
+	{r: 0x31FA, men: 1, ku: 6, ten: 89},   // ㇺ
+	{r: 0x31FB, men: 1, ku: 6, ten: 90},   // ㇻ
+	{r: 0x31FC, men: 1, ku: 6, ten: 91},   // ㇼ
+	{r: 0x31FD, men: 1, ku: 6, ten: 92},   // ㇽ
+	{r: 0x31FE, men: 1, ku: 6, ten: 93},   // ㇾ
+	{r: 0x31FF, men: 1, ku: 6, ten: 94},   // ㇿ
+	{r: 0x3231, men: 1, ku: 13, ten: 74},  // ㈱
+	{r: 0x3232, men: 1, ku: 13, ten: 75},  // ㈲
+	{r: 0x3239, men: 1, ku: 13, ten: 76},  // ㈹
+	{r: 0x3251, men: 1, ku: 8, ten: 33},   // ㉑
+	{r: 0x3252, men: 1, ku: 8, ten: 34},   // ㉒
+	{r: 0x3253, men: 1, ku: 8, ten: 35},   // ㉓
+	{r: 0x3254, men: 1, ku: 8, ten: 36},   // ㉔
+	{r: 0x3255, men: 1, ku: 8, ten: 37},   // ㉕
+	{r: 0x3256, men: 1, ku: 8, ten: 38},   // ㉖
+	{r: 0x3257, men: 1, ku: 8, ten: 39},   // ㉗
+	{r: 0x3258, men: 1, ku: 8, ten: 40},   // ㉘
+	{r: 0x3259, men: 1, ku: 8, ten: 41},   // ㉙
+	{r: 0x325A, men: 1, ku: 8, ten: 42},   // ㉚
+	{r: 0x325B, men: 1, ku: 8, ten: 43},   // ㉛
+	{r: 0x325C, men: 1, ku: 8, ten: 44},   // ㉜
+	{r: 0x325D, men: 1, ku: 8, ten: 45},   // ㉝
+	{r: 0x325E, men: 1, ku: 8, ten: 46},   // ㉞
+	{r: 0x325F, men: 1, ku: 8, ten: 47},   // ㉟
+	{r: 0x32A4, men: 1, ku: 13, ten: 69},  // ㊤
+	{r: 0x32A5, men: 1, ku: 13, ten: 70},  // ㊥
+	{r: 0x32A6, men: 1, ku: 13, ten: 71},  // ㊦
+	{r: 0x32A7, men: 1, ku: 13, ten: 72},  // ㊧
+	{r: 0x32A8, men: 1, ku: 13, ten: 73},  // ㊨
+	{r: 0x32B1, men: 1, ku: 8, ten: 48},   // ㊱
+	{r: 0x32B2, men: 1, ku: 8, ten: 49},   // ㊲
+	{r: 0x32B3, men: 1, ku: 8, ten: 50},   // ㊳
+	{r: 0x32B4, men: 1, ku: 8, ten: 51},   // ㊴
+	{r: 0x32B5, men: 1, ku: 8, ten: 52},   // ㊵
+	{r: 0x32B6, men: 1, ku: 8, ten: 53},   // ㊶
+	{r: 0x32B7, men: 1, ku: 8, ten: 54},   // ㊷
+	{r: 0x32B8, men: 1, ku: 8, ten: 55},   // ㊸
+	{r: 0x32B9, men: 1, ku: 8, ten: 56},   // ㊹
+	{r: 0x32BA, men: 1, ku: 8, ten: 57},   // ㊺
+	{r: 0x32BB, men: 1, ku: 8, ten: 58},   // ㊻
+	{r: 0x32BC, men: 1, ku: 8, ten: 59},   // ㊼
+	{r: 0x32BD, men: 1, ku: 8, ten: 60},   // ㊽
+	{r: 0x32BE, men: 1, ku: 8, ten: 61},   // ㊾
+	{r: 0x32BF, men: 1, ku: 8, ten: 62},   // ㊿
+	{r: 0x32D0, men: 1, ku: 12, ten: 59},  // ㋐
+	{r: 0x32D1, men: 1, ku: 12, ten: 60},  // ㋑
+	{r: 0x32D2, men: 1, ku: 12, ten: 61},  // ㋒
+	{r: 0x32D3, men: 1, ku: 12, ten: 62},  // ㋓
+	{r: 0x32D4, men: 1, ku: 12, ten: 63},  // ㋔
+	{r: 0x32D5, men: 1, ku: 12, ten: 64},  // ㋕
+	{r: 0x32D6, men: 1, ku: 12, ten: 65},  // ㋖
+	{r: 0x32D7, men: 1, ku: 12, ten: 66},  // ㋗
+	{r: 0x32D8, men: 1, ku: 12, ten: 67},  // ㋘
+	{r: 0x32D9, men: 1, ku: 12, ten: 68},  // ㋙
+	{r: 0x32DA, men: 1, ku: 12, ten: 69},  // ㋚
+	{r: 0x32DB, men: 1, ku: 12, ten: 70},  // ㋛
+	{r: 0x32DC, men: 1, ku: 12, ten: 71},  // ㋜
+	{r: 0x32DD, men: 1, ku: 12, ten: 72},  // ㋝
+	{r: 0x32DE, men: 1, ku: 12, ten: 73},  // ㋞
+	{r: 0x32DF, men: 1, ku: 12, ten: 74},  // ㋟
+	{r: 0x32E0, men: 1, ku: 12, ten: 75},  // ㋠
+	{r: 0x32E1, men: 1, ku: 12, ten: 76},  // ㋡
+	{r: 0x32E2, men: 1, ku: 12, ten: 77},  // ㋢
+	{r: 0x32E3, men: 1, ku: 12, ten: 78},  // ㋣
+	{r: 0x32E5, men: 1, ku: 12, ten: 81},  // ㋥
+	{r: 0x32E9, men: 1, ku: 12, ten: 80},  // ㋩
+	{r: 0x32EC, men: 1, ku: 12, ten: 83},  // ㋬
+	{r: 0x32ED, men: 1, ku: 12, ten: 82},  // ㋭
+	{r: 0x32FA, men: 1, ku: 12, ten: 79},  // ㋺
+	{r: 0x3303, men: 1, ku: 13, ten: 38},  // ㌃
+	{r: 0x330D, men: 1, ku: 13, ten: 42},  // ㌍
+	{r: 0x3314, men: 1, ku: 13, ten: 33},  // ㌔
+	{r: 0x3318, men: 1, ku: 13, ten: 36},  // ㌘
+	{r: 0x3322, men: 1, ku: 13, ten: 34},  // ㌢
+	{r: 0x3323, men: 1, ku: 13, ten: 44},  // ㌣
+	{r: 0x3326, men: 1, ku: 13, ten: 43},  // ㌦
+	{r: 0x3327, men: 1, ku: 13, ten: 37},  // ㌧
+	{r: 0x332B, men: 1, ku: 13, ten: 45},  // ㌫
+	{r: 0x3336, men: 1, ku: 13, ten: 39},  // ㌶
+	{r: 0x333B, men: 1, ku: 13, ten: 47},  // ㌻
+	{r: 0x3349, men: 1, ku: 13, ten: 32},  // ㍉
+	{r: 0x334A, men: 1, ku: 13, ten: 46},  // ㍊
+	{r: 0x334D, men: 1, ku: 13, ten: 35},  // ㍍
+	{r: 0x3351, men: 1, ku: 13, ten: 40},  // ㍑
+	{r: 0x3357, men: 1, ku: 13, ten: 41},  // ㍗
+	{r: 0x337B, men: 1, ku: 13, ten: 63},  // ㍻
+	{r: 0x337C, men: 1, ku: 13, ten: 79},  // ㍼
+	{r: 0x337D, men: 1, ku: 13, ten: 78},  // ㍽
+	{r: 0x337E, men: 1, ku: 13, ten: 77},  // ㍾
+	{r: 0x338E, men: 1, ku: 13, ten: 51},  // ㎎
+	{r: 0x338F, men: 1, ku: 13, ten: 52},  // ㎏
+	{r: 0x339C, men: 1, ku: 13, ten: 48},  // ㎜
+	{r: 0x339D, men: 1, ku: 13, ten: 49},  // ㎝
+	{r: 0x339E, men: 1, ku: 13, ten: 50},  // ㎞
+	{r: 0x33A1, men: 1, ku: 13, ten: 54},  // ㎡
+	{r: 0x33C4, men: 1, ku: 13, ten: 53},  // ㏄
+	{r: 0x33CB, men: 1, ku: 3, ten: 62},   // ㏋
+	{r: 0x33CD, men: 1, ku: 13, ten: 67},  // ㏍
+	{r: 0x3402, men: 1, ku: 14, ten: 3},   // 㐂
+	{r: 0x3406, men: 2, ku: 1, ten: 13},   // 㐆
+	{r: 0x342C, men: 2, ku: 1, ten: 18},   // 㐬
+	{r: 0x342E, men: 2, ku: 1, ten: 19},   // 㐮
+	{r: 0x3468, men: 2, ku: 1, ten: 62},   // 㑨
+	{r: 0x346A, men: 2, ku: 1, ten: 54},   // 㑪
+	{r: 0x3492, men: 2, ku: 1, ten: 94},   // 㒒
+	{r: 0x34B5, men: 1, ku: 14, ten: 51},  // 㒵
+	{r: 0x34BC, men: 2, ku: 3, ten: 11},   // 㒼
+	{r: 0x34C1, men: 2, ku: 84, ten: 72},  // 㓁
+	{r: 0x34C7, men: 2, ku: 3, ten: 15},   // 㓇
+	{r: 0x34DB, men: 1, ku: 14, ten: 59},  // 㓛
+	{r: 0x351F, men: 2, ku: 3, ten: 40},   // 㔟
+	{r: 0x355D, men: 2, ku: 3, ten: 61},   // 㕝
+	{r: 0x355E, men: 2, ku: 3, ten: 62},   // 㕞
+	{r: 0x3563, men: 2, ku: 3, ten: 65},   // 㕣
+	{r: 0x356E, men: 2, ku: 3, ten: 71},   // 㕮
+	{r: 0x35A6, men: 2, ku: 4, ten: 3},    // 㖦
+	{r: 0x35A8, men: 2, ku: 4, ten: 6},    // 㖨
+	{r: 0x35C5, men: 2, ku: 4, ten: 15},   // 㗅
+	{r: 0x35DA, men: 2, ku: 4, ten: 24},   // 㗚
+	{r: 0x35F4, men: 2, ku: 4, ten: 34},   // 㗴
+	{r: 0x3605, men: 2, ku: 4, ten: 42},   // 㘅
+	{r: 0x364A, men: 2, ku: 4, ten: 89},   // 㙊
+	{r: 0x3691, men: 2, ku: 5, ten: 31},   // 㚑
+	{r: 0x3696, men: 2, ku: 5, ten: 35},   // 㚖
+	{r: 0x3699, men: 2, ku: 5, ten: 33},   // 㚙
+	{r: 0x36CF, men: 2, ku: 5, ten: 55},   // 㛏
+	{r: 0x3761, men: 2, ku: 8, ten: 3},    // 㝡
+	{r: 0x3762, men: 2, ku: 8, ten: 5},    // 㝢
+	{r: 0x376B, men: 2, ku: 8, ten: 9},    // 㝫
+	{r: 0x376C, men: 2, ku: 8, ten: 8},    // 㝬
+	{r: 0x3775, men: 2, ku: 8, ten: 12},   // 㝵
+	{r: 0x378D, men: 1, ku: 47, ten: 63},  // 㞍
+	{r: 0x37C1, men: 2, ku: 8, ten: 30},   // 㟁
+	{r: 0x37E2, men: 1, ku: 47, ten: 79},  // 㟢
+	{r: 0x37E8, men: 2, ku: 8, ten: 54},   // 㟨
+	{r: 0x37F4, men: 2, ku: 8, ten: 57},   // 㟴
+	{r: 0x37FD, men: 2, ku: 8, ten: 60},   // 㟽
+	{r: 0x3800, men: 2, ku: 8, ten: 62},   // 㠀
+	{r: 0x382F, men: 2, ku: 8, ten: 79},   // 㠯
+	{r: 0x3836, men: 2, ku: 8, ten: 81},   // 㠶
+	{r: 0x3840, men: 2, ku: 8, ten: 84},   // 㡀
+	{r: 0x385C, men: 2, ku: 8, ten: 89},   // 㡜
+	{r: 0x3861, men: 2, ku: 8, ten: 91},   // 㡡
+	{r: 0x38FA, men: 2, ku: 12, ten: 27},  // 㣺
+	{r: 0x3917, men: 2, ku: 12, ten: 38},  // 㤗
+	{r: 0x391A, men: 2, ku: 12, ten: 42},  // 㤚
+	{r: 0x396F, men: 2, ku: 12, ten: 64},  // 㥯
+	{r: 0x3A6E, men: 2, ku: 13, ten: 59},  // 㩮
+	{r: 0x3A73, men: 2, ku: 13, ten: 63},  // 㩳
+	{r: 0x3AD6, men: 2, ku: 13, ten: 81},  // 㫖
+	{r: 0x3AD7, men: 2, ku: 14, ten: 22},  // 㫗
+	{r: 0x3AEA, men: 2, ku: 13, ten: 92},  // 㫪
+	{r: 0x3B0E, men: 2, ku: 14, ten: 14},  // 㬎
+	{r: 0x3B1A, men: 2, ku: 14, ten: 18},  // 㬚
+	{r: 0x3B1C, men: 2, ku: 14, ten: 20},  // 㬜
+	{r: 0x3B22, men: 1, ku: 85, ten: 41},  // 㬢
+	{r: 0x3B6D, men: 2, ku: 14, ten: 77},  // 㭭
+	{r: 0x3B77, men: 2, ku: 14, ten: 69},  // 㭷
+	{r: 0x3B87, men: 2, ku: 15, ten: 8},   // 㮇
+	{r: 0x3B88, men: 2, ku: 15, ten: 9},   // 㮈
+	{r: 0x3B8D, men: 2, ku: 15, ten: 12},  // 㮍
+	{r: 0x3BA4, men: 2, ku: 15, ten: 20},  // 㮤
+	{r: 0x3BB6, men: 1, ku: 85, ten: 94},  // 㮶
+	{r: 0x3BC3, men: 1, ku: 86, ten: 1},   // 㯃
+	{r: 0x3BCD, men: 2, ku: 15, ten: 40},  // 㯍
+	{r: 0x3BF0, men: 2, ku: 15, ten: 61},  // 㯰
+	{r: 0x3C0F, men: 1, ku: 86, ten: 26},  // 㰏
+	{r: 0x3C26, men: 2, ku: 15, ten: 87},  // 㰦
+	{r: 0x3CC3, men: 2, ku: 78, ten: 27},  // 㳃
+	{r: 0x3CD2, men: 2, ku: 78, ten: 34},  // 㳒
+	{r: 0x3D11, men: 2, ku: 78, ten: 81},  // 㴑
+	{r: 0x3D1E, men: 2, ku: 78, ten: 94},  // 㴞
+	{r: 0x3D64, men: 2, ku: 79, ten: 32},  // 㵤
+	{r: 0x3D9A, men: 2, ku: 79, ten: 52},  // 㶚
+	{r: 0x3DC0, men: 2, ku: 79, ten: 80},  // 㷀
+	{r: 0x3DD4, men: 2, ku: 79, ten: 87},  // 㷔
+	{r: 0x3E05, men: 2, ku: 80, ten: 8},   // 㸅
+	{r: 0x3E3F, men: 1, ku: 87, ten: 70},  // 㸿
+	{r: 0x3E60, men: 2, ku: 80, ten: 31},  // 㹠
+	{r: 0x3E66, men: 2, ku: 80, ten: 33},  // 㹦
+	{r: 0x3E68, men: 2, ku: 80, ten: 34},  // 㹨
+	{r: 0x3E83, men: 2, ku: 80, ten: 41},  // 㺃
+	{r: 0x3E94, men: 2, ku: 80, ten: 48},  // 㺔
+	{r: 0x3F57, men: 2, ku: 81, ten: 20},  // 㽗
+	{r: 0x3F72, men: 1, ku: 88, ten: 45},  // 㽲
+	{r: 0x3F75, men: 2, ku: 81, ten: 38},  // 㽵
+	{r: 0x3F77, men: 2, ku: 81, ten: 40},  // 㽷
+	{r: 0x3FAE, men: 2, ku: 81, ten: 60},  // 㾮
+	{r: 0x3FC9, men: 2, ku: 81, ten: 71},  // 㿉
+	{r: 0x3FD7, men: 2, ku: 81, ten: 76},  // 㿗
+	{r: 0x4039, men: 2, ku: 82, ten: 2},   // 䀹
+	{r: 0x4058, men: 2, ku: 82, ten: 13},  // 䁘
+	{r: 0x4093, men: 2, ku: 82, ten: 25},  // 䂓
+	{r: 0x4105, men: 2, ku: 82, ten: 68},  // 䄅
+	{r: 0x4148, men: 2, ku: 82, ten: 84},  // 䅈
+	{r: 0x414F, men: 2, ku: 82, ten: 87},  // 䅏
+	{r: 0x4163, men: 2, ku: 82, ten: 93},  // 䅣
+	{r: 0x41B4, men: 2, ku: 83, ten: 19},  // 䆴
+	{r: 0x41BF, men: 2, ku: 83, ten: 23},  // 䆿
+	{r: 0x41E6, men: 2, ku: 83, ten: 39},  // 䇦
+	{r: 0x41EE, men: 2, ku: 83, ten: 43},  // 䇮
+	{r: 0x41F3, men: 2, ku: 83, ten: 40},  // 䇳
+	{r: 0x4207, men: 2, ku: 83, ten: 51},  // 䈇
+	{r: 0x420E, men: 2, ku: 83, ten: 55},  // 䈎
+	{r: 0x4264, men: 1, ku: 89, ten: 77},  // 䉤
+	{r: 0x42C6, men: 2, ku: 84, ten: 11},  // 䋆
+	{r: 0x42D6, men: 2, ku: 84, ten: 22},  // 䋖
+	{r: 0x42DD, men: 2, ku: 84, ten: 27},  // 䋝
+	{r: 0x4302, men: 2, ku: 84, ten: 46},  // 䌂
+	{r: 0x432B, men: 2, ku: 84, ten: 61},  // 䌫
+	{r: 0x4343, men: 2, ku: 84, ten: 65},  // 䍃
+	{r: 0x43EE, men: 2, ku: 85, ten: 30},  // 䏮
+	{r: 0x43F0, men: 2, ku: 85, ten: 34},  // 䏰
+	{r: 0x4408, men: 2, ku: 85, ten: 40},  // 䐈
+	{r: 0x4417, men: 2, ku: 85, ten: 42},  // 䐗
+	{r: 0x441C, men: 2, ku: 85, ten: 44},  // 䐜
+	{r: 0x4422, men: 2, ku: 85, ten: 47},  // 䐢
+	{r: 0x4453, men: 1, ku: 90, ten: 57},  // 䑓
+	{r: 0x445B, men: 1, ku: 90, ten: 58},  // 䑛
+	{r: 0x4476, men: 2, ku: 85, ten: 76},  // 䑶
+	{r: 0x447A, men: 2, ku: 85, ten: 78},  // 䑺
+	{r: 0x4491, men: 2, ku: 85, ten: 87},  // 䒑
+	{r: 0x44B3, men: 2, ku: 86, ten: 21},  // 䒳
+	{r: 0x44BE, men: 2, ku: 86, ten: 18},  // 䒾
+	{r: 0x44D4, men: 2, ku: 86, ten: 20},  // 䓔
+	{r: 0x4508, men: 2, ku: 86, ten: 57},  // 䔈
+	{r: 0x450D, men: 2, ku: 86, ten: 52},  // 䔍
+	{r: 0x4525, men: 2, ku: 86, ten: 77},  // 䔥
+	{r: 0x4543, men: 2, ku: 86, ten: 78},  // 䕃
+	{r: 0x459D, men: 1, ku: 91, ten: 49},  // 䖝
+	{r: 0x45B8, men: 2, ku: 87, ten: 47},  // 䖸
+	{r: 0x45E5, men: 2, ku: 87, ten: 76},  // 䗥
+	{r: 0x45EA, men: 1, ku: 91, ten: 64},  // 䗪
+	{r: 0x460F, men: 2, ku: 88, ten: 4},   // 䘏
+	{r: 0x4641, men: 2, ku: 88, ten: 26},  // 䙁
+	{r: 0x4665, men: 2, ku: 88, ten: 35},  // 䙥
+	{r: 0x46A1, men: 2, ku: 88, ten: 46},  // 䚡
+	{r: 0x46AF, men: 2, ku: 88, ten: 51},  // 䚯
+	{r: 0x470C, men: 2, ku: 88, ten: 75},  // 䜌
+	{r: 0x4764, men: 2, ku: 89, ten: 9},   // 䝤
+	{r: 0x47FD, men: 2, ku: 89, ten: 31},  // 䟽
+	{r: 0x4816, men: 2, ku: 89, ten: 41},  // 䠖
+	{r: 0x4844, men: 1, ku: 92, ten: 43},  // 䡄
+	{r: 0x484E, men: 2, ku: 89, ten: 60},  // 䡎
+	{r: 0x48B5, men: 2, ku: 90, ten: 7},   // 䢵
+	{r: 0x49B0, men: 1, ku: 93, ten: 56},  // 䦰
+	{r: 0x49E7, men: 2, ku: 91, ten: 74},  // 䧧
+	{r: 0x49FA, men: 2, ku: 91, ten: 80},  // 䧺
+	{r: 0x4A04, men: 2, ku: 91, ten: 85},  // 䨄
+	{r: 0x4A29, men: 2, ku: 91, ten: 88},  // 䨩
+	{r: 0x4ABC, men: 2, ku: 92, ten: 23},  // 䪼
+	{r: 0x4B3B, men: 2, ku: 92, ten: 53},  // 䬻
+	{r: 0x4BC2, men: 2, ku: 93, ten: 6},   // 䯂
+	{r: 0x4BCA, men: 2, ku: 93, ten: 8},   // 䯊
+	{r: 0x4BD2, men: 2, ku: 93, ten: 10},  // 䯒
+	{r: 0x4BE8, men: 2, ku: 93, ten: 17},  // 䯨
+	{r: 0x4C17, men: 1, ku: 94, ten: 30},  // 䰗
+	{r: 0x4C20, men: 2, ku: 93, ten: 31},  // 䰠
+	{r: 0x4CC4, men: 2, ku: 94, ten: 10},  // 䳄
+	{r: 0x4CD1, men: 2, ku: 94, ten: 13},  // 䳑
+	{r: 0x4D07, men: 2, ku: 94, ten: 43},  // 䴇
+	{r: 0x4D77, men: 2, ku: 94, ten: 64},  // 䵷
+	{r: 0x4E02, men: 2, ku: 1, ten: 2},    // 丂
+	{r: 0x4E0F, men: 2, ku: 1, ten: 3},    // 丏
+	{r: 0x4E12, men: 2, ku: 1, ten: 4},    // 丒
+	{r: 0x4E28, men: 1, ku: 14, ten: 4},   // 丨
+	{r: 0x4E29, men: 2, ku: 1, ten: 5},    // 丩
+	{r: 0x4E2B, men: 2, ku: 1, ten: 6},    // 丫
+	{r: 0x4E2C, men: 2, ku: 80, ten: 14},  // 丬
+	{r: 0x4E2E, men: 2, ku: 1, ten: 7},    // 丮
+	{r: 0x4E2F, men: 1, ku: 14, ten: 5},   // 丯
+	{r: 0x4E30, men: 1, ku: 14, ten: 6},   // 丰
+	{r: 0x4E40, men: 2, ku: 1, ten: 8},    // 乀
+	{r: 0x4E47, men: 2, ku: 1, ten: 9},    // 乇
+	{r: 0x4E48, men: 2, ku: 1, ten: 10},   // 么
+	{r: 0x4E51, men: 2, ku: 1, ten: 12},   // 乑
+	{r: 0x4E5A, men: 2, ku: 1, ten: 15},   // 乚
+	{r: 0x4E69, men: 2, ku: 1, ten: 16},   // 乩
+	{r: 0x4E8D, men: 1, ku: 14, ten: 7},   // 亍
+	{r: 0x4E9D, men: 2, ku: 1, ten: 17},   // 亝
+	{r: 0x4EB9, men: 2, ku: 1, ten: 20},   // 亹
+	{r: 0x4EBB, men: 2, ku: 1, ten: 21},   // 亻
+	{r: 0x4EBC, men: 2, ku: 1, ten: 23},   // 亼
+	{r: 0x4EC3, men: 2, ku: 1, ten: 24},   // 仃
+	{r: 0x4EC8, men: 2, ku: 1, ten: 25},   // 仈
+	{r: 0x4ED0, men: 2, ku: 1, ten: 26},   // 仐
+	{r: 0x4EDA, men: 2, ku: 1, ten: 28},   // 仚
+	{r: 0x4EE1, men: 1, ku: 14, ten: 8},   // 仡
+	{r: 0x4EEB, men: 2, ku: 1, ten: 27},   // 仫
+	{r: 0x4EF1, men: 2, ku: 1, ten: 29},   // 仱
+	{r: 0x4EF5, men: 2, ku: 1, ten: 30},   // 仵
+	{r: 0x4EFD, men: 1, ku: 14, ten: 9},   // 份
+	{r: 0x4EFF, men: 1, ku: 14, ten: 10},  // 仿
+	{r: 0x4F00, men: 2, ku: 1, ten: 31},   // 伀
+	{r: 0x4F03, men: 1, ku: 14, ten: 11},  // 伃
+	{r: 0x4F0B, men: 1, ku: 14, ten: 12},  // 伋
+	{r: 0x4F16, men: 2, ku: 1, ten: 32},   // 伖
+	{r: 0x4F37, men: 2, ku: 1, ten: 34},   // 伷
+	{r: 0x4F3E, men: 2, ku: 1, ten: 35},   // 伾
+	{r: 0x4F48, men: 1, ku: 14, ten: 14},  // 佈
+	{r: 0x4F49, men: 1, ku: 14, ten: 15},  // 佉
+	{r: 0x4F54, men: 2, ku: 1, ten: 36},   // 佔
+	{r: 0x4F56, men: 1, ku: 14, ten: 16},  // 佖
+	{r: 0x4F58, men: 2, ku: 1, ten: 37},   // 佘
+	{r: 0x4F5F, men: 1, ku: 14, ten: 17},  // 佟
+	{r: 0x4F60, men: 1, ku: 14, ten: 13},  // 你
+	{r: 0x4F64, men: 2, ku: 1, ten: 33},   // 佤
+	{r: 0x4F6A, men: 1, ku: 14, ten: 18},  // 佪
+	{r: 0x4F6C, men: 1, ku: 14, ten: 19},  // 佬
+	{r: 0x4F77, men: 2, ku: 1, ten: 39},   // 佷
+	{r: 0x4F78, men: 2, ku: 1, ten: 40},   // 佸
+	{r: 0x4F7A, men: 2, ku: 1, ten: 41},   // 佺
+	{r: 0x4F7D, men: 2, ku: 1, ten: 42},   // 佽
+	{r: 0x4F7E, men: 1, ku: 14, ten: 20},  // 佾
+	{r: 0x4F82, men: 2, ku: 1, ten: 43},   // 侂
+	{r: 0x4F85, men: 2, ku: 1, ten: 44},   // 侅
+	{r: 0x4F8A, men: 1, ku: 14, ten: 21},  // 侊
+	{r: 0x4F92, men: 2, ku: 1, ten: 45},   // 侒
+	{r: 0x4F94, men: 1, ku: 14, ten: 22},  // 侔
+	{r: 0x4F97, men: 1, ku: 14, ten: 23},  // 侗
+	{r: 0x4F9A, men: 2, ku: 1, ten: 46},   // 侚
+	{r: 0x4FB2, men: 2, ku: 1, ten: 48},   // 侲
+	{r: 0x4FBE, men: 2, ku: 1, ten: 49},   // 侾
+	{r: 0x4FC5, men: 2, ku: 1, ten: 50},   // 俅
+	{r: 0x4FC9, men: 1, ku: 14, ten: 25},  // 俉
+	{r: 0x4FCB, men: 2, ku: 1, ten: 51},   // 俋
+	{r: 0x4FCF, men: 2, ku: 1, ten: 52},   // 俏
+	{r: 0x4FD2, men: 2, ku: 1, ten: 53},   // 俒
+	{r: 0x4FE0, men: 1, ku: 14, ten: 26},  // 俠
+	{r: 0x4FE6, men: 2, ku: 1, ten: 47},   // 俦
+	{r: 0x4FF1, men: 1, ku: 14, ten: 1},   // 俱
+	{r: 0x4FF2, men: 2, ku: 1, ten: 55},   // 俲
+	{r: 0x5000, men: 2, ku: 1, ten: 56},   // 倀
+	{r: 0x5001, men: 1, ku: 14, ten: 27},  // 倁
+	{r: 0x5002, men: 1, ku: 14, ten: 28},  // 倂
+	{r: 0x500E, men: 1, ku: 14, ten: 29},  // 倎
+	{r: 0x5010, men: 2, ku: 1, ten: 57},   // 倐
+	{r: 0x5013, men: 2, ku: 1, ten: 58},   // 倓
+	{r: 0x5018, men: 1, ku: 14, ten: 30},  // 倘
+	{r: 0x501C, men: 2, ku: 1, ten: 59},   // 倜
+	{r: 0x501E, men: 2, ku: 1, ten: 60},   // 倞
+	{r: 0x5022, men: 2, ku: 1, ten: 61},   // 倢
+	{r: 0x5027, men: 1, ku: 14, ten: 31},  // 倧
+	{r: 0x502E, men: 1, ku: 14, ten: 32},  // 倮
+	{r: 0x503B, men: 1, ku: 14, ten: 34},  // 倻
+	{r: 0x5040, men: 1, ku: 14, ten: 33},  // 偀
+	{r: 0x5041, men: 1, ku: 14, ten: 35},  // 偁
+	{r: 0x5042, men: 2, ku: 1, ten: 63},   // 偂
+	{r: 0x5046, men: 2, ku: 1, ten: 64},   // 偆
+	{r: 0x504E, men: 2, ku: 1, ten: 65},   // 偎
+	{r: 0x5053, men: 2, ku: 1, ten: 66},   // 偓
+	{r: 0x5057, men: 2, ku: 1, ten: 67},   // 偗
+	{r: 0x5063, men: 2, ku: 1, ten: 68},   // 偣
+	{r: 0x5066, men: 2, ku: 1, ten: 69},   // 偦
+	{r: 0x506A, men: 2, ku: 1, ten: 70},   // 偪
+	{r: 0x5070, men: 2, ku: 1, ten: 71},   // 偰
+	{r: 0x5088, men: 2, ku: 1, ten: 73},   // 傈
+	{r: 0x5092, men: 2, ku: 1, ten: 74},   // 傒
+	{r: 0x5093, men: 2, ku: 1, ten: 75},   // 傓
+	{r: 0x5094, men: 1, ku: 14, ten: 36},  // 傔
+	{r: 0x5095, men: 2, ku: 1, ten: 76},   // 傕
+	{r: 0x5096, men: 2, ku: 1, ten: 77},   // 傖
+	{r: 0x509C, men: 2, ku: 1, ten: 78},   // 傜
+	{r: 0x50A3, men: 2, ku: 1, ten: 72},   // 傣
+	{r: 0x50AA, men: 2, ku: 1, ten: 79},   // 傪
+	{r: 0x50B1, men: 2, ku: 1, ten: 81},   // 傱
+	{r: 0x50BA, men: 2, ku: 1, ten: 82},   // 傺
+	{r: 0x50BB, men: 2, ku: 1, ten: 83},   // 傻
+	{r: 0x50C4, men: 2, ku: 1, ten: 84},   // 僄
+	{r: 0x50C7, men: 2, ku: 1, ten: 85},   // 僇
+	{r: 0x50CC, men: 1, ku: 14, ten: 37},  // 僌
+	{r: 0x50CE, men: 2, ku: 1, ten: 88},   // 僎
+	{r: 0x50D0, men: 1, ku: 14, ten: 39},  // 僐
+	{r: 0x50D4, men: 2, ku: 1, ten: 90},   // 僔
+	{r: 0x50D9, men: 2, ku: 1, ten: 91},   // 僙
+	{r: 0x50E1, men: 2, ku: 1, ten: 92},   // 僡
+	{r: 0x50E6, men: 1, ku: 14, ten: 40},  // 僦
+	{r: 0x50E9, men: 2, ku: 1, ten: 93},   // 僩
+	{r: 0x50F2, men: 1, ku: 14, ten: 38},  // 僲
+	{r: 0x50F3, men: 2, ku: 1, ten: 86},   // 僳
+	{r: 0x5103, men: 1, ku: 14, ten: 43},  // 儃
+	{r: 0x5106, men: 1, ku: 14, ten: 42},  // 儆
+	{r: 0x5108, men: 2, ku: 3, ten: 1},    // 儈
+	{r: 0x510B, men: 1, ku: 14, ten: 44},  // 儋
+	{r: 0x5117, men: 2, ku: 3, ten: 3},    // 儗
+	{r: 0x511B, men: 2, ku: 3, ten: 4},    // 儛
+	{r: 0x511E, men: 1, ku: 14, ten: 45},  // 儞
+	{r: 0x5135, men: 1, ku: 14, ten: 46},  // 儵
+	{r: 0x514A, men: 1, ku: 14, ten: 47},  // 兊
+	{r: 0x5155, men: 1, ku: 14, ten: 49},  // 兕
+	{r: 0x5157, men: 1, ku: 14, ten: 50},  // 兗
+	{r: 0x5160, men: 2, ku: 3, ten: 6},    // 兠
+	{r: 0x5173, men: 2, ku: 3, ten: 8},    // 关
+	{r: 0x517B, men: 2, ku: 84, ten: 84},  // 养
+	{r: 0x5183, men: 2, ku: 3, ten: 9},    // 冃
+	{r: 0x518B, men: 2, ku: 3, ten: 10},   // 冋
+	{r: 0x5198, men: 2, ku: 3, ten: 12},   // 冘
+	{r: 0x519D, men: 1, ku: 14, ten: 52},  // 冝
+	{r: 0x51A3, men: 2, ku: 3, ten: 13},   // 冣
+	{r: 0x51AD, men: 2, ku: 3, ten: 14},   // 冭
+	{r: 0x51BC, men: 2, ku: 3, ten: 16},   // 冼
+	{r: 0x51C3, men: 1, ku: 14, ten: 53},  // 凃
+	{r: 0x51CA, men: 1, ku: 14, ten: 54},  // 凊
+	{r: 0x51DE, men: 1, ku: 14, ten: 55},  // 凞
+	{r: 0x51E2, men: 1, ku: 14, ten: 56},  // 凢
+	{r: 0x51EE, men: 1, ku: 14, ten: 57},  // 凮
+	{r: 0x51F3, men: 2, ku: 3, ten: 19},   // 凳
+	{r: 0x51F4, men: 2, ku: 3, ten: 20},   // 凴
+	{r: 0x5201, men: 1, ku: 14, ten: 58},  // 刁
+	{r: 0x5202, men: 2, ku: 3, ten: 21},   // 刂
+	{r: 0x5212, men: 2, ku: 3, ten: 22},   // 划
+	{r: 0x5213, men: 1, ku: 14, ten: 60},  // 刓
+	{r: 0x5215, men: 1, ku: 14, ten: 61},  // 刕
+	{r: 0x5216, men: 2, ku: 3, ten: 23},   // 刖
+	{r: 0x5249, men: 1, ku: 14, ten: 62},  // 剉
+	{r: 0x5255, men: 2, ku: 3, ten: 25},   // 剕
+	{r: 0x5257, men: 1, ku: 14, ten: 63},  // 剗
+	{r: 0x525C, men: 2, ku: 3, ten: 26},   // 剜
+	{r: 0x525D, men: 1, ku: 15, ten: 94},  // 剝
+	{r: 0x5261, men: 1, ku: 14, ten: 64},  // 剡
+	{r: 0x526C, men: 2, ku: 3, ten: 27},   // 剬
+	{r: 0x5277, men: 2, ku: 3, ten: 28},   // 剷
+	{r: 0x5282, men: 2, ku: 3, ten: 30},   // 劂
+	{r: 0x5284, men: 2, ku: 3, ten: 29},   // 劄
+	{r: 0x5293, men: 1, ku: 14, ten: 65},  // 劓
+	{r: 0x5298, men: 2, ku: 3, ten: 32},   // 劘
+	{r: 0x52A4, men: 2, ku: 3, ten: 34},   // 劤
+	{r: 0x52A6, men: 2, ku: 3, ten: 35},   // 劦
+	{r: 0x52AF, men: 2, ku: 3, ten: 36},   // 劯
+	{r: 0x52BA, men: 2, ku: 3, ten: 37},   // 劺
+	{r: 0x52BB, men: 2, ku: 3, ten: 38},   // 劻
+	{r: 0x52C8, men: 1, ku: 14, ten: 66},  // 勈
+	{r: 0x52CA, men: 2, ku: 3, ten: 39},   // 勊
+	{r: 0x52CC, men: 1, ku: 14, ten: 68},  // 勌
+	{r: 0x52D0, men: 1, ku: 14, ten: 69},  // 勐
+	{r: 0x52D1, men: 2, ku: 3, ten: 41},   // 勑
+	{r: 0x52D6, men: 1, ku: 14, ten: 70},  // 勖
+	{r: 0x52DB, men: 1, ku: 14, ten: 71},  // 勛
+	{r: 0x52F0, men: 1, ku: 14, ten: 73},  // 勰
+	{r: 0x52F7, men: 2, ku: 3, ten: 43},   // 勷
+	{r: 0x52FB, men: 1, ku: 14, ten: 74},  // 勻
+	{r: 0x5300, men: 1, ku: 14, ten: 75},  // 匀
+	{r: 0x5307, men: 1, ku: 14, ten: 76},  // 匇
+	{r: 0x530A, men: 2, ku: 3, ten: 44},   // 匊
+	{r: 0x530B, men: 2, ku: 3, ten: 45},   // 匋
+	{r: 0x531C, men: 1, ku: 14, ten: 77},  // 匜
+	{r: 0x5324, men: 2, ku: 3, ten: 46},   // 匤
+	{r: 0x5335, men: 2, ku: 3, ten: 47},   // 匵
+	{r: 0x533E, men: 2, ku: 3, ten: 48},   // 匾
+	{r: 0x5342, men: 2, ku: 3, ten: 49},   // 卂
+	{r: 0x5361, men: 1, ku: 14, ten: 79},  // 卡
+	{r: 0x5363, men: 1, ku: 14, ten: 80},  // 卣
+	{r: 0x5367, men: 2, ku: 3, ten: 52},   // 卧
+	{r: 0x536C, men: 2, ku: 3, ten: 53},   // 卬
+	{r: 0x537A, men: 2, ku: 3, ten: 54},   // 卺
+	{r: 0x537D, men: 1, ku: 14, ten: 81},  // 卽
+	{r: 0x5393, men: 1, ku: 14, ten: 82},  // 厓
+	{r: 0x539D, men: 1, ku: 14, ten: 83},  // 厝
+	{r: 0x53A4, men: 2, ku: 3, ten: 55},   // 厤
+	{r: 0x53B2, men: 1, ku: 14, ten: 84},  // 厲
+	{r: 0x53B4, men: 2, ku: 3, ten: 56},   // 厴
+	{r: 0x53B7, men: 2, ku: 3, ten: 58},   // 厷
+	{r: 0x53C0, men: 2, ku: 3, ten: 59},   // 叀
+	{r: 0x53D5, men: 2, ku: 3, ten: 63},   // 叕
+	{r: 0x53DA, men: 2, ku: 3, ten: 64},   // 叚
+	{r: 0x53F4, men: 2, ku: 3, ten: 66},   // 叴
+	{r: 0x53F5, men: 2, ku: 3, ten: 67},   // 叵
+	{r: 0x5412, men: 1, ku: 14, ten: 85},  // 吒
+	{r: 0x541E, men: 1, ku: 47, ten: 94},  // 吞
+	{r: 0x5424, men: 2, ku: 3, ten: 69},   // 吤
+	{r: 0x5427, men: 1, ku: 14, ten: 86},  // 吧
+	{r: 0x5428, men: 2, ku: 3, ten: 70},   // 吨
+	{r: 0x5443, men: 2, ku: 3, ten: 72},   // 呃
+	{r: 0x544D, men: 1, ku: 14, ten: 87},  // 呍
+	{r: 0x5455, men: 2, ku: 3, ten: 68},   // 呕
+	{r: 0x5462, men: 2, ku: 3, ten: 73},   // 呢
+	{r: 0x5466, men: 2, ku: 3, ten: 74},   // 呦
+	{r: 0x546B, men: 1, ku: 14, ten: 89},  // 呫
+	{r: 0x546C, men: 2, ku: 3, ten: 75},   // 呬
+	{r: 0x5474, men: 1, ku: 14, ten: 90},  // 呴
+	{r: 0x547F, men: 1, ku: 14, ten: 91},  // 呿
+	{r: 0x5488, men: 1, ku: 14, ten: 92},  // 咈
+	{r: 0x548A, men: 2, ku: 3, ten: 76},   // 咊
+	{r: 0x548D, men: 2, ku: 3, ten: 77},   // 咍
+	{r: 0x5495, men: 2, ku: 3, ten: 78},   // 咕
+	{r: 0x5496, men: 1, ku: 14, ten: 93},  // 咖
+	{r: 0x549C, men: 1, ku: 14, ten: 88},  // 咜
+	{r: 0x54A0, men: 2, ku: 3, ten: 79},   // 咠
+	{r: 0x54A1, men: 1, ku: 14, ten: 94},  // 咡
+	{r: 0x54A6, men: 2, ku: 3, ten: 80},   // 咦
+	{r: 0x54A9, men: 1, ku: 15, ten: 1},   // 咩
+	{r: 0x54AD, men: 2, ku: 3, ten: 81},   // 咭
+	{r: 0x54AE, men: 2, ku: 3, ten: 82},   // 咮
+	{r: 0x54B7, men: 2, ku: 3, ten: 83},   // 咷
+	{r: 0x54BA, men: 2, ku: 3, ten: 84},   // 咺
+	{r: 0x54BF, men: 2, ku: 3, ten: 85},   // 咿
+	{r: 0x54C3, men: 2, ku: 3, ten: 86},   // 哃
+	{r: 0x54C6, men: 1, ku: 15, ten: 2},   // 哆
+	{r: 0x54EC, men: 2, ku: 3, ten: 88},   // 哬
+	{r: 0x54EF, men: 2, ku: 3, ten: 89},   // 哯
+	{r: 0x54F1, men: 2, ku: 3, ten: 90},   // 哱
+	{r: 0x54F3, men: 2, ku: 3, ten: 91},   // 哳
+	{r: 0x54FF, men: 1, ku: 15, ten: 3},   // 哿
+	{r: 0x5500, men: 2, ku: 3, ten: 92},   // 唀
+	{r: 0x5501, men: 2, ku: 3, ten: 93},   // 唁
+	{r: 0x5509, men: 2, ku: 3, ten: 94},   // 唉
+	{r: 0x550E, men: 1, ku: 15, ten: 4},   // 唎
+	{r: 0x552B, men: 1, ku: 15, ten: 5},   // 唫
+	{r: 0x5535, men: 1, ku: 15, ten: 6},   // 唵
+	{r: 0x553C, men: 2, ku: 4, ten: 1},    // 唼
+	{r: 0x5541, men: 2, ku: 4, ten: 2},    // 啁
+	{r: 0x5547, men: 2, ku: 4, ten: 4},    // 啇
+	{r: 0x554A, men: 2, ku: 4, ten: 5},    // 啊
+	{r: 0x5550, men: 1, ku: 15, ten: 7},   // 啐
+	{r: 0x555E, men: 1, ku: 15, ten: 8},   // 啞
+	{r: 0x5560, men: 2, ku: 4, ten: 7},    // 啠
+	{r: 0x5561, men: 2, ku: 4, ten: 8},    // 啡
+	{r: 0x5564, men: 2, ku: 4, ten: 9},    // 啤
+	{r: 0x557D, men: 2, ku: 4, ten: 11},   // 啽
+	{r: 0x5581, men: 1, ku: 15, ten: 9},   // 喁
+	{r: 0x5582, men: 2, ku: 4, ten: 12},   // 喂
+	{r: 0x5586, men: 1, ku: 15, ten: 10},  // 喆
+	{r: 0x5588, men: 2, ku: 4, ten: 13},   // 喈
+	{r: 0x558E, men: 1, ku: 15, ten: 11},  // 喎
+	{r: 0x5591, men: 2, ku: 4, ten: 14},   // 喑
+	{r: 0x55AD, men: 1, ku: 15, ten: 13},  // 喭
+	{r: 0x55BF, men: 2, ku: 4, ten: 19},   // 喿
+	{r: 0x55C9, men: 2, ku: 4, ten: 20},   // 嗉
+	{r: 0x55CC, men: 2, ku: 4, ten: 21},   // 嗌
+	{r: 0x55CE, men: 1, ku: 15, ten: 14},  // 嗎
+	{r: 0x55D1, men: 2, ku: 4, ten: 22},   // 嗑
+	{r: 0x55D2, men: 2, ku: 4, ten: 16},   // 嗒
+	{r: 0x55DD, men: 2, ku: 4, ten: 23},   // 嗝
+	{r: 0x55E2, men: 2, ku: 4, ten: 25},   // 嗢
+	{r: 0x55E9, men: 2, ku: 4, ten: 27},   // 嗩
+	{r: 0x5607, men: 2, ku: 4, ten: 30},   // 嘇
+	{r: 0x5608, men: 1, ku: 15, ten: 16},  // 嘈
+	{r: 0x560E, men: 1, ku: 15, ten: 17},  // 嘎
+	{r: 0x5610, men: 2, ku: 4, ten: 31},   // 嘐
+	{r: 0x5628, men: 2, ku: 4, ten: 28},   // 嘨
+	{r: 0x5630, men: 2, ku: 4, ten: 32},   // 嘰
+	{r: 0x5637, men: 2, ku: 4, ten: 33},   // 嘷
+	{r: 0x563B, men: 1, ku: 15, ten: 18},  // 嘻
+	{r: 0x563D, men: 2, ku: 4, ten: 35},   // 嘽
+	{r: 0x563F, men: 2, ku: 4, ten: 36},   // 嘿
+	{r: 0x5640, men: 2, ku: 4, ten: 37},   // 噀
+	{r: 0x5647, men: 2, ku: 4, ten: 38},   // 噇
+	{r: 0x5649, men: 1, ku: 15, ten: 19},  // 噉
+	{r: 0x5653, men: 1, ku: 84, ten: 7},   // 噓
+	{r: 0x565E, men: 2, ku: 4, ten: 39},   // 噞
+	{r: 0x5660, men: 2, ku: 4, ten: 40},   // 噠
+	{r: 0x5666, men: 1, ku: 15, ten: 21},  // 噦
+	{r: 0x566D, men: 2, ku: 4, ten: 41},   // 噭
+	{r: 0x566F, men: 1, ku: 15, ten: 23},  // 噯
+	{r: 0x5671, men: 1, ku: 15, ten: 24},  // 噱
+	{r: 0x5672, men: 1, ku: 15, ten: 25},  // 噲
+	{r: 0x5676, men: 1, ku: 15, ten: 20},  // 噶
+	{r: 0x5688, men: 2, ku: 4, ten: 43},   // 嚈
+	{r: 0x568C, men: 2, ku: 4, ten: 44},   // 嚌
+	{r: 0x5695, men: 2, ku: 4, ten: 45},   // 嚕
+	{r: 0x5699, men: 1, ku: 15, ten: 26},  // 嚙
+	{r: 0x569A, men: 2, ku: 4, ten: 46},   // 嚚
+	{r: 0x569D, men: 2, ku: 4, ten: 47},   // 嚝
+	{r: 0x569E, men: 1, ku: 15, ten: 27},  // 嚞
+	{r: 0x56A8, men: 2, ku: 4, ten: 48},   // 嚨
+	{r: 0x56A9, men: 1, ku: 15, ten: 28},  // 嚩
+	{r: 0x56AC, men: 1, ku: 15, ten: 29},  // 嚬
+	{r: 0x56AD, men: 2, ku: 4, ten: 49},   // 嚭
+	{r: 0x56B2, men: 2, ku: 4, ten: 50},   // 嚲
+	{r: 0x56B3, men: 1, ku: 15, ten: 30},  // 嚳
+	{r: 0x56C5, men: 2, ku: 4, ten: 51},   // 囅
+	{r: 0x56C9, men: 1, ku: 15, ten: 31},  // 囉
+	{r: 0x56CA, men: 1, ku: 15, ten: 32},  // 囊
+	{r: 0x56CD, men: 2, ku: 4, ten: 52},   // 囍
+	{r: 0x56DF, men: 2, ku: 4, ten: 53},   // 囟
+	{r: 0x56E8, men: 2, ku: 4, ten: 54},   // 囨
+	{r: 0x56F6, men: 2, ku: 4, ten: 55},   // 囶
+	{r: 0x56F7, men: 2, ku: 4, ten: 56},   // 囷
+	{r: 0x570A, men: 1, ku: 15, ten: 33},  // 圊
+	{r: 0x5715, men: 2, ku: 4, ten: 58},   // 圕
+	{r: 0x5721, men: 1, ku: 15, ten: 35},  // 圡
+	{r: 0x5723, men: 2, ku: 4, ten: 59},   // 圣
+	{r: 0x5729, men: 2, ku: 4, ten: 61},   // 圩
+	{r: 0x572F, men: 1, ku: 15, ten: 36},  // 圯
+	{r: 0x5733, men: 1, ku: 15, ten: 37},  // 圳
+	{r: 0x5734, men: 1, ku: 15, ten: 38},  // 圴
+	{r: 0x5745, men: 2, ku: 4, ten: 63},   // 坅
+	{r: 0x5746, men: 2, ku: 4, ten: 64},   // 坆
+	{r: 0x574C, men: 2, ku: 4, ten: 65},   // 坌
+	{r: 0x574D, men: 2, ku: 4, ten: 66},   // 坍
+	{r: 0x5768, men: 2, ku: 4, ten: 68},   // 坨
+	{r: 0x576F, men: 2, ku: 4, ten: 69},   // 坯
+	{r: 0x5770, men: 1, ku: 15, ten: 39},  // 坰
+	{r: 0x5773, men: 2, ku: 4, ten: 70},   // 坳
+	{r: 0x5774, men: 2, ku: 4, ten: 71},   // 坴
+	{r: 0x5775, men: 2, ku: 4, ten: 72},   // 坵
+	{r: 0x5777, men: 1, ku: 15, ten: 40},  // 坷
+	{r: 0x577B, men: 2, ku: 4, ten: 73},   // 坻
+	{r: 0x577C, men: 1, ku: 15, ten: 41},  // 坼
+	{r: 0x579A, men: 2, ku: 4, ten: 77},   // 垚
+	{r: 0x579C, men: 1, ku: 15, ten: 42},  // 垜
+	{r: 0x579D, men: 2, ku: 4, ten: 78},   // 垝
+	{r: 0x579E, men: 2, ku: 4, ten: 79},   // 垞
+	{r: 0x57A8, men: 2, ku: 4, ten: 80},   // 垨
+	{r: 0x57AC, men: 2, ku: 4, ten: 76},   // 垬
+	{r: 0x57B8, men: 1, ku: 15, ten: 45},  // 垸
+	{r: 0x57C7, men: 1, ku: 15, ten: 46},  // 埇
+	{r: 0x57C8, men: 1, ku: 15, ten: 47},  // 埈
+	{r: 0x57CC, men: 2, ku: 4, ten: 83},   // 埌
+	{r: 0x57CF, men: 1, ku: 15, ten: 48},  // 埏
+	{r: 0x57D7, men: 2, ku: 4, ten: 81},   // 埗
+	{r: 0x57DE, men: 2, ku: 4, ten: 86},   // 埞
+	{r: 0x57E4, men: 1, ku: 15, ten: 49},  // 埤
+	{r: 0x57E6, men: 2, ku: 4, ten: 87},   // 埦
+	{r: 0x57ED, men: 1, ku: 15, ten: 50},  // 埭
+	{r: 0x57F0, men: 2, ku: 4, ten: 88},   // 埰
+	{r: 0x57F5, men: 1, ku: 15, ten: 51},  // 埵
+	{r: 0x57F6, men: 1, ku: 15, ten: 52},  // 埶
+	{r: 0x57F8, men: 2, ku: 4, ten: 90},   // 埸
+	{r: 0x57FB, men: 2, ku: 4, ten: 91},   // 埻
+	{r: 0x57FD, men: 2, ku: 4, ten: 92},   // 埽
+	{r: 0x57FF, men: 1, ku: 15, ten: 53},  // 埿
+	{r: 0x5804, men: 2, ku: 4, ten: 93},   // 堄
+	{r: 0x5809, men: 1, ku: 15, ten: 54},  // 堉
+	{r: 0x581E, men: 2, ku: 4, ten: 94},   // 堞
+	{r: 0x5820, men: 2, ku: 5, ten: 1},    // 堠
+	{r: 0x5827, men: 2, ku: 5, ten: 2},    // 堧
+	{r: 0x5832, men: 2, ku: 5, ten: 3},    // 堲
+	{r: 0x5839, men: 2, ku: 5, ten: 4},    // 堹
+	{r: 0x5849, men: 2, ku: 5, ten: 6},    // 塉
+	{r: 0x584C, men: 2, ku: 5, ten: 7},    // 塌
+	{r: 0x5861, men: 1, ku: 15, ten: 56},  // 塡
+	{r: 0x5864, men: 1, ku: 15, ten: 57},  // 塤
+	{r: 0x5867, men: 2, ku: 5, ten: 8},    // 塧
+	{r: 0x587C, men: 1, ku: 15, ten: 59},  // 塼
+	{r: 0x5889, men: 1, ku: 15, ten: 60},  // 墉
+	{r: 0x588A, men: 2, ku: 5, ten: 9},    // 墊
+	{r: 0x588B, men: 2, ku: 5, ten: 10},   // 墋
+	{r: 0x588D, men: 2, ku: 5, ten: 11},   // 墍
+	{r: 0x588F, men: 2, ku: 5, ten: 12},   // 墏
+	{r: 0x5890, men: 2, ku: 5, ten: 13},   // 墐
+	{r: 0x5894, men: 2, ku: 5, ten: 14},   // 墔
+	{r: 0x589D, men: 2, ku: 5, ten: 15},   // 墝
+	{r: 0x589E, men: 1, ku: 15, ten: 61},  // 增
+	{r: 0x58A9, men: 1, ku: 15, ten: 63},  // 墩
+	{r: 0x58AA, men: 2, ku: 5, ten: 16},   // 墪
+	{r: 0x58B1, men: 2, ku: 5, ten: 17},   // 墱
+	{r: 0x58C3, men: 2, ku: 5, ten: 19},   // 壃
+	{r: 0x58CD, men: 2, ku: 5, ten: 20},   // 壍
+	{r: 0x58CE, men: 1, ku: 15, ten: 66},  // 壎
+	{r: 0x58D2, men: 1, ku: 15, ten: 65},  // 壒
+	{r: 0x58D4, men: 1, ku: 15, ten: 67},  // 壔
+	{r: 0x58DA, men: 1, ku: 15, ten: 68},  // 壚
+	{r: 0x58E0, men: 1, ku: 15, ten: 69},  // 壠
+	{r: 0x58E2, men: 2, ku: 5, ten: 21},   // 壢
+	{r: 0x58E9, men: 1, ku: 15, ten: 70},  // 壩
+	{r: 0x58F3, men: 2, ku: 5, ten: 22},   // 壳
+	{r: 0x58F4, men: 2, ku: 5, ten: 23},   // 壴
+	{r: 0x5905, men: 2, ku: 5, ten: 24},   // 夅
+	{r: 0x5906, men: 2, ku: 5, ten: 25},   // 夆
+	{r: 0x590B, men: 2, ku: 5, ten: 26},   // 夋
+	{r: 0x590C, men: 1, ku: 15, ten: 71},  // 夌
+	{r: 0x590D, men: 2, ku: 5, ten: 27},   // 复
+	{r: 0x5914, men: 2, ku: 5, ten: 28},   // 夔
+	{r: 0x5924, men: 2, ku: 5, ten: 29},   // 夤
+	{r: 0x593D, men: 2, ku: 5, ten: 32},   // 夽
+	{r: 0x5946, men: 2, ku: 5, ten: 34},   // 奆
+	{r: 0x595B, men: 2, ku: 5, ten: 37},   // 奛
+	{r: 0x595D, men: 1, ku: 15, ten: 73},  // 奝
+	{r: 0x595F, men: 2, ku: 5, ten: 38},   // 奟
+	{r: 0x596D, men: 1, ku: 15, ten: 74},  // 奭
+	{r: 0x5975, men: 2, ku: 5, ten: 40},   // 奵
+	{r: 0x5976, men: 2, ku: 5, ten: 41},   // 奶
+	{r: 0x597C, men: 2, ku: 5, ten: 42},   // 奼
+	{r: 0x598B, men: 1, ku: 15, ten: 75},  // 妋
+	{r: 0x5992, men: 1, ku: 15, ten: 76},  // 妒
+	{r: 0x599F, men: 2, ku: 5, ten: 43},   // 妟
+	{r: 0x59A4, men: 1, ku: 15, ten: 77},  // 妤
+	{r: 0x59AE, men: 2, ku: 5, ten: 44},   // 妮
+	{r: 0x59BC, men: 2, ku: 5, ten: 45},   // 妼
+	{r: 0x59C3, men: 1, ku: 15, ten: 78},  // 姃
+	{r: 0x59C8, men: 2, ku: 5, ten: 46},   // 姈
+	{r: 0x59CD, men: 2, ku: 5, ten: 47},   // 姍
+	{r: 0x59D2, men: 1, ku: 15, ten: 79},  // 姒
+	{r: 0x59DD, men: 1, ku: 15, ten: 80},  // 姝
+	{r: 0x59DE, men: 2, ku: 5, ten: 48},   // 姞
+	{r: 0x59E3, men: 2, ku: 5, ten: 49},   // 姣
+	{r: 0x59E4, men: 2, ku: 5, ten: 50},   // 姤
+	{r: 0x59E7, men: 2, ku: 5, ten: 51},   // 姧
+	{r: 0x59EE, men: 2, ku: 5, ten: 52},   // 姮
+	{r: 0x59F8, men: 1, ku: 94, ten: 90},  // 姸
+	{r: 0x5A0C, men: 2, ku: 5, ten: 56},   // 娌
+	{r: 0x5A0D, men: 2, ku: 5, ten: 57},   // 娍
+	{r: 0x5A13, men: 1, ku: 15, ten: 81},  // 娓
+	{r: 0x5A17, men: 2, ku: 5, ten: 58},   // 娗
+	{r: 0x5A23, men: 1, ku: 15, ten: 82},  // 娣
+	{r: 0x5A27, men: 2, ku: 5, ten: 59},   // 娧
+	{r: 0x5A2D, men: 2, ku: 5, ten: 60},   // 娭
+	{r: 0x5A55, men: 2, ku: 5, ten: 61},   // 婕
+	{r: 0x5A65, men: 2, ku: 5, ten: 62},   // 婥
+	{r: 0x5A67, men: 1, ku: 15, ten: 83},  // 婧
+	{r: 0x5A6D, men: 1, ku: 15, ten: 84},  // 婭
+	{r: 0x5A77, men: 1, ku: 15, ten: 85},  // 婷
+	{r: 0x5A7A, men: 2, ku: 5, ten: 63},   // 婺
+	{r: 0x5A7E, men: 1, ku: 15, ten: 86},  // 婾
+	{r: 0x5A84, men: 1, ku: 15, ten: 87},  // 媄
+	{r: 0x5A8B, men: 2, ku: 5, ten: 64},   // 媋
+	{r: 0x5A9C, men: 2, ku: 5, ten: 65},   // 媜
+	{r: 0x5A9E, men: 1, ku: 15, ten: 88},  // 媞
+	{r: 0x5A9F, men: 2, ku: 5, ten: 66},   // 媟
+	{r: 0x5AA0, men: 2, ku: 5, ten: 67},   // 媠
+	{r: 0x5AA2, men: 2, ku: 5, ten: 68},   // 媢
+	{r: 0x5AA7, men: 1, ku: 15, ten: 89},  // 媧
+	{r: 0x5AB1, men: 2, ku: 5, ten: 69},   // 媱
+	{r: 0x5AB3, men: 2, ku: 5, ten: 70},   // 媳
+	{r: 0x5AB5, men: 2, ku: 5, ten: 71},   // 媵
+	{r: 0x5ABA, men: 2, ku: 5, ten: 72},   // 媺
+	{r: 0x5ABF, men: 2, ku: 5, ten: 73},   // 媿
+	{r: 0x5AC4, men: 1, ku: 15, ten: 90},  // 嫄
+	{r: 0x5ADA, men: 2, ku: 5, ten: 74},   // 嫚
+	{r: 0x5ADC, men: 2, ku: 5, ten: 75},   // 嫜
+	{r: 0x5AE0, men: 2, ku: 5, ten: 76},   // 嫠
+	{r: 0x5AE5, men: 2, ku: 5, ten: 77},   // 嫥
+	{r: 0x5AEE, men: 2, ku: 5, ten: 79},   // 嫮
+	{r: 0x5AF0, men: 2, ku: 5, ten: 78},   // 嫰
+	{r: 0x5AF5, men: 2, ku: 5, ten: 80},   // 嫵
+	{r: 0x5B00, men: 2, ku: 5, ten: 81},   // 嬀
+	{r: 0x5B08, men: 2, ku: 5, ten: 82},   // 嬈
+	{r: 0x5B17, men: 2, ku: 5, ten: 83},   // 嬗
+	{r: 0x5B19, men: 1, ku: 15, ten: 92},  // 嬙
+	{r: 0x5B25, men: 1, ku: 15, ten: 93},  // 嬥
+	{r: 0x5B2D, men: 2, ku: 5, ten: 85},   // 嬭
+	{r: 0x5B34, men: 2, ku: 5, ten: 84},   // 嬴
+	{r: 0x5B41, men: 1, ku: 47, ten: 53},  // 孁
+	{r: 0x5B4C, men: 2, ku: 5, ten: 86},   // 孌
+	{r: 0x5B52, men: 2, ku: 5, ten: 87},   // 孒
+	{r: 0x5B56, men: 1, ku: 47, ten: 54},  // 孖
+	{r: 0x5B68, men: 2, ku: 5, ten: 88},   // 孨
+	{r: 0x5B6F, men: 2, ku: 5, ten: 89},   // 孯
+	{r: 0x5B7C, men: 2, ku: 5, ten: 90},   // 孼
+	{r: 0x5B7D, men: 1, ku: 47, ten: 55},  // 孽
+	{r: 0x5B7F, men: 2, ku: 5, ten: 91},   // 孿
+	{r: 0x5B81, men: 2, ku: 5, ten: 92},   // 宁
+	{r: 0x5B84, men: 2, ku: 5, ten: 93},   // 宄
+	{r: 0x5B93, men: 1, ku: 47, ten: 56},  // 宓
+	{r: 0x5B96, men: 2, ku: 8, ten: 1},    // 宖
+	{r: 0x5BAC, men: 2, ku: 8, ten: 2},    // 宬
+	{r: 0x5BC0, men: 2, ku: 8, ten: 4},    // 寀
+	{r: 0x5BCE, men: 2, ku: 8, ten: 6},    // 寎
+	{r: 0x5BD6, men: 2, ku: 8, ten: 7},    // 寖
+	{r: 0x5BD8, men: 1, ku: 47, ten: 57},  // 寘
+	{r: 0x5BEC, men: 1, ku: 47, ten: 58},  // 寬
+	{r: 0x5BF1, men: 2, ku: 8, ten: 10},   // 寱
+	{r: 0x5BFD, men: 2, ku: 8, ten: 11},   // 寽
+	{r: 0x5C03, men: 2, ku: 8, ten: 13},   // 尃
+	{r: 0x5C12, men: 1, ku: 47, ten: 59},  // 尒
+	{r: 0x5C1E, men: 1, ku: 47, ten: 60},  // 尞
+	{r: 0x5C23, men: 1, ku: 47, ten: 61},  // 尣
+	{r: 0x5C29, men: 2, ku: 8, ten: 14},   // 尩
+	{r: 0x5C2B, men: 1, ku: 47, ten: 62},  // 尫
+	{r: 0x5C30, men: 2, ku: 8, ten: 15},   // 尰
+	{r: 0x5C5B, men: 1, ku: 94, ten: 91},  // 屛
+	{r: 0x5C5F, men: 2, ku: 8, ten: 17},   // 屟
+	{r: 0x5C62, men: 1, ku: 47, ten: 64},  // 屢
+	{r: 0x5C63, men: 2, ku: 8, ten: 18},   // 屣
+	{r: 0x5C67, men: 2, ku: 8, ten: 19},   // 屧
+	{r: 0x5C68, men: 2, ku: 8, ten: 20},   // 屨
+	{r: 0x5C69, men: 2, ku: 8, ten: 21},   // 屩
+	{r: 0x5C70, men: 2, ku: 8, ten: 22},   // 屰
+	{r: 0x5C7A, men: 1, ku: 47, ten: 68},  // 屺
+	{r: 0x5C7C, men: 2, ku: 8, ten: 25},   // 屼
+	{r: 0x5C88, men: 2, ku: 8, ten: 28},   // 岈
+	{r: 0x5C8A, men: 2, ku: 8, ten: 29},   // 岊
+	{r: 0x5C8F, men: 1, ku: 47, ten: 69},  // 岏
+	{r: 0x5C9F, men: 1, ku: 47, ten: 70},  // 岟
+	{r: 0x5CA0, men: 2, ku: 8, ten: 33},   // 岠
+	{r: 0x5CA2, men: 2, ku: 8, ten: 34},   // 岢
+	{r: 0x5CA3, men: 1, ku: 47, ten: 71},  // 岣
+	{r: 0x5CA6, men: 2, ku: 8, ten: 35},   // 岦
+	{r: 0x5CA7, men: 2, ku: 8, ten: 36},   // 岧
+	{r: 0x5CAA, men: 1, ku: 47, ten: 72},  // 岪
+	{r: 0x5CAD, men: 2, ku: 8, ten: 38},   // 岭
+	{r: 0x5CB5, men: 2, ku: 8, ten: 39},   // 岵
+	{r: 0x5CBA, men: 1, ku: 47, ten: 73},  // 岺
+	{r: 0x5CC9, men: 2, ku: 8, ten: 41},   // 峉
+	{r: 0x5CCB, men: 1, ku: 47, ten: 74},  // 峋
+	{r: 0x5CD0, men: 1, ku: 47, ten: 75},  // 峐
+	{r: 0x5CD2, men: 1, ku: 47, ten: 76},  // 峒
+	{r: 0x5CF4, men: 1, ku: 47, ten: 77},  // 峴
+	{r: 0x5D06, men: 2, ku: 8, ten: 44},   // 崆
+	{r: 0x5D0D, men: 1, ku: 47, ten: 80},  // 崍
+	{r: 0x5D10, men: 2, ku: 8, ten: 45},   // 崐
+	{r: 0x5D1D, men: 2, ku: 8, ten: 47},   // 崝
+	{r: 0x5D20, men: 2, ku: 8, ten: 48},   // 崠
+	{r: 0x5D24, men: 2, ku: 8, ten: 49},   // 崤
+	{r: 0x5D26, men: 2, ku: 8, ten: 50},   // 崦
+	{r: 0x5D27, men: 1, ku: 47, ten: 81},  // 崧
+	{r: 0x5D2B, men: 2, ku: 8, ten: 46},   // 崫
+	{r: 0x5D31, men: 2, ku: 8, ten: 51},   // 崱
+	{r: 0x5D39, men: 2, ku: 8, ten: 52},   // 崹
+	{r: 0x5D42, men: 2, ku: 8, ten: 53},   // 嵂
+	{r: 0x5D46, men: 1, ku: 47, ten: 83},  // 嵆
+	{r: 0x5D47, men: 1, ku: 47, ten: 84},  // 嵇
+	{r: 0x5D4A, men: 1, ku: 47, ten: 86},  // 嵊
+	{r: 0x5D53, men: 1, ku: 47, ten: 85},  // 嵓
+	{r: 0x5D61, men: 2, ku: 8, ten: 55},   // 嵡
+	{r: 0x5D6A, men: 2, ku: 8, ten: 56},   // 嵪
+	{r: 0x5D6D, men: 1, ku: 47, ten: 87},  // 嵭
+	{r: 0x5D70, men: 2, ku: 8, ten: 58},   // 嵰
+	{r: 0x5D81, men: 1, ku: 47, ten: 88},  // 嶁
+	{r: 0x5D88, men: 2, ku: 8, ten: 61},   // 嶈
+	{r: 0x5D92, men: 2, ku: 8, ten: 63},   // 嶒
+	{r: 0x5D94, men: 2, ku: 8, ten: 64},   // 嶔
+	{r: 0x5D97, men: 2, ku: 8, ten: 65},   // 嶗
+	{r: 0x5D99, men: 2, ku: 8, ten: 66},   // 嶙
+	{r: 0x5DA0, men: 1, ku: 47, ten: 89},  // 嶠
+	{r: 0x5DA4, men: 1, ku: 47, ten: 90},  // 嶤
+	{r: 0x5DA7, men: 1, ku: 47, ten: 91},  // 嶧
+	{r: 0x5DB0, men: 2, ku: 8, ten: 67},   // 嶰
+	{r: 0x5DB2, men: 2, ku: 8, ten: 68},   // 嶲
+	{r: 0x5DB4, men: 2, ku: 8, ten: 69},   // 嶴
+	{r: 0x5DB8, men: 1, ku: 47, ten: 92},  // 嶸
+	{r: 0x5DB9, men: 2, ku: 8, ten: 71},   // 嶹
+	{r: 0x5DCB, men: 1, ku: 47, ten: 93},  // 巋
+	{r: 0x5DD1, men: 2, ku: 8, ten: 72},   // 巑
+	{r: 0x5DD7, men: 2, ku: 8, ten: 73},   // 巗
+	{r: 0x5DD8, men: 2, ku: 8, ten: 74},   // 巘
+	{r: 0x5DE0, men: 2, ku: 8, ten: 75},   // 巠
+	{r: 0x5DE2, men: 1, ku: 84, ten: 8},   // 巢
+	{r: 0x5DE4, men: 2, ku: 8, ten: 77},   // 巤
+	{r: 0x5DE9, men: 2, ku: 8, ten: 78},   // 巩
+	{r: 0x5E00, men: 2, ku: 8, ten: 80},   // 帀
+	{r: 0x5E12, men: 2, ku: 8, ten: 82},   // 帒
+	{r: 0x5E14, men: 1, ku: 84, ten: 9},   // 帔
+	{r: 0x5E15, men: 2, ku: 8, ten: 83},   // 帕
+	{r: 0x5E18, men: 1, ku: 84, ten: 10},  // 帘
+	{r: 0x5E1F, men: 2, ku: 8, ten: 85},   // 帟
+	{r: 0x5E2E, men: 2, ku: 8, ten: 86},   // 帮
+	{r: 0x5E3E, men: 2, ku: 8, ten: 87},   // 帾
+	{r: 0x5E49, men: 2, ku: 8, ten: 88},   // 幉
+	{r: 0x5E56, men: 2, ku: 8, ten: 90},   // 幖
+	{r: 0x5E58, men: 1, ku: 84, ten: 11},  // 幘
+	{r: 0x5E5E, men: 1, ku: 84, ten: 12},  // 幞
+	{r: 0x5E6B, men: 2, ku: 8, ten: 92},   // 幫
+	{r: 0x5E6C, men: 2, ku: 8, ten: 93},   // 幬
+	{r: 0x5E6D, men: 2, ku: 8, ten: 94},   // 幭
+	{r: 0x5E6E, men: 2, ku: 12, ten: 1},   // 幮
+	{r: 0x5E77, men: 1, ku: 94, ten: 92},  // 幷
+	{r: 0x5EA5, men: 2, ku: 12, ten: 3},   // 庥
+	{r: 0x5EAA, men: 2, ku: 12, ten: 4},   // 庪
+	{r: 0x5EAC, men: 2, ku: 12, ten: 5},   // 庬
+	{r: 0x5EB9, men: 2, ku: 12, ten: 6},   // 庹
+	{r: 0x5EBE, men: 1, ku: 84, ten: 13},  // 庾
+	{r: 0x5EBF, men: 2, ku: 12, ten: 7},   // 庿
+	{r: 0x5EC6, men: 2, ku: 12, ten: 8},   // 廆
+	{r: 0x5ECB, men: 1, ku: 84, ten: 15},  // 廋
+	{r: 0x5ED2, men: 2, ku: 12, ten: 9},   // 廒
+	{r: 0x5ED9, men: 2, ku: 12, ten: 10},  // 廙
+	{r: 0x5EF9, men: 1, ku: 84, ten: 16},  // 廹
+	{r: 0x5EFD, men: 2, ku: 12, ten: 12},  // 廽
+	{r: 0x5F00, men: 1, ku: 84, ten: 17},  // 开
+	{r: 0x5F02, men: 1, ku: 84, ten: 18},  // 异
+	{r: 0x5F07, men: 1, ku: 84, ten: 19},  // 弇
+	{r: 0x5F08, men: 2, ku: 12, ten: 13},  // 弈
+	{r: 0x5F0E, men: 2, ku: 12, ten: 14},  // 弎
+	{r: 0x5F1C, men: 2, ku: 12, ten: 15},  // 弜
+	{r: 0x5F1D, men: 1, ku: 84, ten: 20},  // 弝
+	{r: 0x5F1E, men: 2, ku: 12, ten: 17},  // 弞
+	{r: 0x5F23, men: 1, ku: 84, ten: 21},  // 弣
+	{r: 0x5F34, men: 1, ku: 84, ten: 22},  // 弴
+	{r: 0x5F36, men: 1, ku: 84, ten: 23},  // 弶
+	{r: 0x5F3D, men: 1, ku: 84, ten: 24},  // 弽
+	{r: 0x5F40, men: 1, ku: 84, ten: 25},  // 彀
+	{r: 0x5F45, men: 1, ku: 84, ten: 26},  // 彅
+	{r: 0x5F47, men: 2, ku: 12, ten: 18},  // 彇
+	{r: 0x5F54, men: 1, ku: 84, ten: 27},  // 彔
+	{r: 0x5F58, men: 1, ku: 84, ten: 28},  // 彘
+	{r: 0x5F63, men: 2, ku: 12, ten: 19},  // 彣
+	{r: 0x5F64, men: 1, ku: 84, ten: 29},  // 彤
+	{r: 0x5F67, men: 1, ku: 84, ten: 30},  // 彧
+	{r: 0x5F72, men: 2, ku: 12, ten: 20},  // 彲
+	{r: 0x5F7D, men: 1, ku: 84, ten: 31},  // 彽
+	{r: 0x5F7E, men: 2, ku: 12, ten: 21},  // 彾
+	{r: 0x5F89, men: 1, ku: 84, ten: 32},  // 徉
+	{r: 0x5F8F, men: 2, ku: 12, ten: 22},  // 徏
+	{r: 0x5F9C, men: 1, ku: 84, ten: 33},  // 徜
+	{r: 0x5FA2, men: 2, ku: 12, ten: 23},  // 徢
+	{r: 0x5FA4, men: 2, ku: 12, ten: 24},  // 徤
+	{r: 0x5FA7, men: 1, ku: 84, ten: 34},  // 徧
+	{r: 0x5FAF, men: 1, ku: 84, ten: 35},  // 徯
+	{r: 0x5FB5, men: 1, ku: 84, ten: 36},  // 徵
+	{r: 0x5FB7, men: 1, ku: 84, ten: 37},  // 德
+	{r: 0x5FB8, men: 2, ku: 12, ten: 25},  // 徸
+	{r: 0x5FC4, men: 2, ku: 12, ten: 26},  // 忄
+	{r: 0x5FC7, men: 2, ku: 12, ten: 28},  // 忇
+	{r: 0x5FC9, men: 1, ku: 84, ten: 38},  // 忉
+	{r: 0x5FCB, men: 2, ku: 12, ten: 29},  // 忋
+	{r: 0x5FD2, men: 2, ku: 12, ten: 30},  // 忒
+	{r: 0x5FD3, men: 2, ku: 12, ten: 31},  // 忓
+	{r: 0x5FD4, men: 2, ku: 12, ten: 32},  // 忔
+	{r: 0x5FDE, men: 1, ku: 84, ten: 39},  // 忞
+	{r: 0x5FE1, men: 1, ku: 84, ten: 40},  // 忡
+	{r: 0x5FE2, men: 2, ku: 12, ten: 33},  // 忢
+	{r: 0x5FE9, men: 1, ku: 84, ten: 41},  // 忩
+	{r: 0x5FEE, men: 2, ku: 12, ten: 34},  // 忮
+	{r: 0x5FEF, men: 2, ku: 12, ten: 35},  // 忯
+	{r: 0x5FF3, men: 2, ku: 12, ten: 36},  // 忳
+	{r: 0x5FFC, men: 2, ku: 12, ten: 37},  // 忼
+	{r: 0x600D, men: 1, ku: 84, ten: 42},  // 怍
+	{r: 0x6014, men: 1, ku: 84, ten: 43},  // 怔
+	{r: 0x6017, men: 2, ku: 12, ten: 39},  // 怗
+	{r: 0x6018, men: 1, ku: 84, ten: 44},  // 怘
+	{r: 0x6022, men: 2, ku: 12, ten: 40},  // 怢
+	{r: 0x6024, men: 2, ku: 12, ten: 41},  // 怤
+	{r: 0x6033, men: 1, ku: 84, ten: 45},  // 怳
+	{r: 0x6035, men: 1, ku: 84, ten: 46},  // 怵
+	{r: 0x6047, men: 1, ku: 84, ten: 47},  // 恇
+	{r: 0x604C, men: 2, ku: 12, ten: 43},  // 恌
+	{r: 0x607F, men: 2, ku: 12, ten: 44},  // 恿
+	{r: 0x608A, men: 2, ku: 12, ten: 45},  // 悊
+	{r: 0x6095, men: 2, ku: 12, ten: 46},  // 悕
+	{r: 0x609D, men: 1, ku: 84, ten: 49},  // 悝
+	{r: 0x609E, men: 1, ku: 84, ten: 50},  // 悞
+	{r: 0x60A8, men: 2, ku: 12, ten: 47},  // 您
+	{r: 0x60B0, men: 2, ku: 12, ten: 49},  // 悰
+	{r: 0x60B1, men: 2, ku: 12, ten: 50},  // 悱
+	{r: 0x60BE, men: 2, ku: 12, ten: 51},  // 悾
+	{r: 0x60C8, men: 2, ku: 12, ten: 52},  // 惈
+	{r: 0x60CB, men: 1, ku: 84, ten: 51},  // 惋
+	{r: 0x60D4, men: 1, ku: 84, ten: 52},  // 惔
+	{r: 0x60D5, men: 1, ku: 84, ten: 53},  // 惕
+	{r: 0x60D9, men: 2, ku: 12, ten: 53},  // 惙
+	{r: 0x60DB, men: 2, ku: 12, ten: 54},  // 惛
+	{r: 0x60DD, men: 1, ku: 84, ten: 54},  // 惝
+	{r: 0x60EE, men: 2, ku: 12, ten: 55},  // 惮
+	{r: 0x60F2, men: 2, ku: 12, ten: 56},  // 惲
+	{r: 0x60F5, men: 2, ku: 12, ten: 57},  // 惵
+	{r: 0x60F8, men: 1, ku: 84, ten: 55},  // 惸
+	{r: 0x6110, men: 2, ku: 12, ten: 58},  // 愐
+	{r: 0x6112, men: 2, ku: 12, ten: 59},  // 愒
+	{r: 0x6113, men: 2, ku: 12, ten: 60},  // 愓
+	{r: 0x6119, men: 2, ku: 12, ten: 61},  // 愙
+	{r: 0x611C, men: 1, ku: 84, ten: 56},  // 愜
+	{r: 0x611E, men: 2, ku: 12, ten: 62},  // 愞
+	{r: 0x612B, men: 1, ku: 84, ten: 57},  // 愫
+	{r: 0x6130, men: 1, ku: 84, ten: 58},  // 愰
+	{r: 0x6137, men: 1, ku: 84, ten: 59},  // 愷
+	{r: 0x613A, men: 2, ku: 12, ten: 63},  // 愺
+	{r: 0x6141, men: 2, ku: 12, ten: 65},  // 慁
+	{r: 0x6146, men: 2, ku: 12, ten: 66},  // 慆
+	{r: 0x6160, men: 2, ku: 12, ten: 67},  // 慠
+	{r: 0x617C, men: 2, ku: 12, ten: 68},  // 慼
+	{r: 0x618D, men: 1, ku: 84, ten: 61},  // 憍
+	{r: 0x6192, men: 2, ku: 12, ten: 70},  // 憒
+	{r: 0x6193, men: 2, ku: 12, ten: 71},  // 憓
+	{r: 0x6197, men: 2, ku: 12, ten: 72},  // 憗
+	{r: 0x6198, men: 2, ku: 12, ten: 73},  // 憘
+	{r: 0x61A5, men: 2, ku: 12, ten: 74},  // 憥
+	{r: 0x61A8, men: 2, ku: 12, ten: 75},  // 憨
+	{r: 0x61AD, men: 2, ku: 12, ten: 76},  // 憭
+	{r: 0x61B9, men: 1, ku: 84, ten: 64},  // 憹
+	{r: 0x61BC, men: 1, ku: 84, ten: 63},  // 憼
+	{r: 0x61D5, men: 2, ku: 12, ten: 78},  // 懕
+	{r: 0x61DD, men: 2, ku: 12, ten: 79},  // 懝
+	{r: 0x61DF, men: 2, ku: 12, ten: 80},  // 懟
+	{r: 0x61F5, men: 2, ku: 12, ten: 81},  // 懵
+	{r: 0x6215, men: 2, ku: 12, ten: 83},  // 戕
+	{r: 0x6222, men: 1, ku: 84, ten: 66},  // 戢
+	{r: 0x6223, men: 2, ku: 12, ten: 84},  // 戣
+	{r: 0x6229, men: 2, ku: 12, ten: 85},  // 戩
+	{r: 0x623E, men: 1, ku: 84, ten: 67},  // 戾
+	{r: 0x6243, men: 1, ku: 84, ten: 68},  // 扃
+	{r: 0x6246, men: 2, ku: 12, ten: 86},  // 扆
+	{r: 0x624C, men: 2, ku: 12, ten: 87},  // 扌
+	{r: 0x6251, men: 2, ku: 12, ten: 88},  // 扑
+	{r: 0x6252, men: 2, ku: 12, ten: 89},  // 扒
+	{r: 0x6256, men: 1, ku: 84, ten: 69},  // 扖
+	{r: 0x625A, men: 1, ku: 84, ten: 70},  // 扚
+	{r: 0x6261, men: 2, ku: 12, ten: 90},  // 扡
+	{r: 0x6264, men: 2, ku: 12, ten: 91},  // 扤
+	{r: 0x626D, men: 2, ku: 12, ten: 93},  // 扭
+	{r: 0x626F, men: 1, ku: 84, ten: 71},  // 扯
+	{r: 0x6273, men: 2, ku: 12, ten: 94},  // 扳
+	{r: 0x627B, men: 2, ku: 12, ten: 92},  // 扻
+	{r: 0x6285, men: 1, ku: 84, ten: 72},  // 抅
+	{r: 0x6299, men: 2, ku: 13, ten: 1},   // 抙
+	{r: 0x62A6, men: 2, ku: 13, ten: 2},   // 抦
+	{r: 0x62C4, men: 1, ku: 84, ten: 73},  // 拄
+	{r: 0x62D5, men: 2, ku: 13, ten: 3},   // 拕
+	{r: 0x62D6, men: 1, ku: 84, ten: 74},  // 拖
+	{r: 0x62FC, men: 1, ku: 84, ten: 75},  // 拼
+	{r: 0x62FD, men: 2, ku: 13, ten: 5},   // 拽
+	{r: 0x6303, men: 2, ku: 13, ten: 6},   // 挃
+	{r: 0x630A, men: 1, ku: 84, ten: 76},  // 挊
+	{r: 0x630D, men: 2, ku: 13, ten: 7},   // 挍
+	{r: 0x6310, men: 2, ku: 13, ten: 8},   // 挐
+	{r: 0x6318, men: 1, ku: 84, ten: 77},  // 挘
+	{r: 0x6332, men: 2, ku: 13, ten: 11},  // 挲
+	{r: 0x6335, men: 2, ku: 13, ten: 12},  // 挵
+	{r: 0x6339, men: 1, ku: 84, ten: 78},  // 挹
+	{r: 0x633B, men: 2, ku: 13, ten: 13},  // 挻
+	{r: 0x633C, men: 2, ku: 13, ten: 14},  // 挼
+	{r: 0x6341, men: 2, ku: 13, ten: 15},  // 捁
+	{r: 0x6343, men: 1, ku: 84, ten: 79},  // 捃
+	{r: 0x6344, men: 2, ku: 13, ten: 16},  // 捄
+	{r: 0x634E, men: 2, ku: 13, ten: 17},  // 捎
+	{r: 0x6359, men: 2, ku: 13, ten: 19},  // 捙
+	{r: 0x6365, men: 1, ku: 84, ten: 80},  // 捥
+	{r: 0x636C, men: 2, ku: 13, ten: 22},  // 捬
+	{r: 0x637C, men: 1, ku: 84, ten: 81},  // 捼
+	{r: 0x6384, men: 2, ku: 13, ten: 23},  // 掄
+	{r: 0x6394, men: 2, ku: 13, ten: 26},  // 掔
+	{r: 0x6399, men: 2, ku: 13, ten: 24},  // 掙
+	{r: 0x63BD, men: 2, ku: 13, ten: 27},  // 掽
+	{r: 0x63D4, men: 2, ku: 13, ten: 29},  // 揔
+	{r: 0x63D5, men: 2, ku: 13, ten: 30},  // 揕
+	{r: 0x63DC, men: 2, ku: 13, ten: 31},  // 揜
+	{r: 0x63E0, men: 2, ku: 13, ten: 32},  // 揠
+	{r: 0x63E5, men: 1, ku: 84, ten: 82},  // 揥
+	{r: 0x63EB, men: 2, ku: 13, ten: 33},  // 揫
+	{r: 0x63EC, men: 2, ku: 13, ten: 34},  // 揬
+	{r: 0x63ED, men: 1, ku: 84, ten: 83},  // 揭
+	{r: 0x63F2, men: 2, ku: 13, ten: 35},  // 揲
+	{r: 0x63F5, men: 1, ku: 84, ten: 84},  // 揵
+	{r: 0x63F7, men: 2, ku: 13, ten: 28},  // 揷
+	{r: 0x6409, men: 2, ku: 13, ten: 36},  // 搉
+	{r: 0x6410, men: 1, ku: 84, ten: 85},  // 搐
+	{r: 0x6414, men: 1, ku: 84, ten: 86},  // 搔
+	{r: 0x641E, men: 2, ku: 13, ten: 37},  // 搞
+	{r: 0x6422, men: 1, ku: 84, ten: 87},  // 搢
+	{r: 0x6425, men: 2, ku: 13, ten: 38},  // 搥
+	{r: 0x6429, men: 2, ku: 13, ten: 39},  // 搩
+	{r: 0x642F, men: 2, ku: 13, ten: 40},  // 搯
+	{r: 0x6451, men: 1, ku: 84, ten: 89},  // 摑
+	{r: 0x645A, men: 2, ku: 13, ten: 41},  // 摚
+	{r: 0x645B, men: 2, ku: 13, ten: 42},  // 摛
+	{r: 0x645D, men: 2, ku: 13, ten: 43},  // 摝
+	{r: 0x6460, men: 1, ku: 84, ten: 90},  // 摠
+	{r: 0x646D, men: 1, ku: 84, ten: 91},  // 摭
+	{r: 0x6473, men: 2, ku: 13, ten: 44},  // 摳
+	{r: 0x6479, men: 1, ku: 84, ten: 88},  // 摹
+	{r: 0x647D, men: 2, ku: 13, ten: 45},  // 摽
+	{r: 0x6487, men: 2, ku: 13, ten: 46},  // 撇
+	{r: 0x6491, men: 2, ku: 13, ten: 47},  // 撑
+	{r: 0x649D, men: 2, ku: 13, ten: 48},  // 撝
+	{r: 0x649F, men: 2, ku: 13, ten: 49},  // 撟
+	{r: 0x64BE, men: 1, ku: 84, ten: 93},  // 撾
+	{r: 0x64BF, men: 1, ku: 84, ten: 94},  // 撿
+	{r: 0x64C4, men: 1, ku: 85, ten: 1},   // 擄
+	{r: 0x64CA, men: 1, ku: 85, ten: 2},   // 擊
+	{r: 0x64CB, men: 2, ku: 13, ten: 50},  // 擋
+	{r: 0x64CC, men: 2, ku: 13, ten: 51},  // 擌
+	{r: 0x64CE, men: 1, ku: 84, ten: 92},  // 擎
+	{r: 0x64D0, men: 1, ku: 85, ten: 3},   // 擐
+	{r: 0x64D5, men: 2, ku: 13, ten: 52},  // 擕
+	{r: 0x64D7, men: 2, ku: 13, ten: 53},  // 擗
+	{r: 0x64E4, men: 2, ku: 13, ten: 55},  // 擤
+	{r: 0x64E5, men: 2, ku: 13, ten: 56},  // 擥
+	{r: 0x64F7, men: 1, ku: 85, ten: 4},   // 擷
+	{r: 0x64FB, men: 1, ku: 85, ten: 5},   // 擻
+	{r: 0x64FF, men: 2, ku: 13, ten: 57},  // 擿
+	{r: 0x6504, men: 2, ku: 13, ten: 58},  // 攄
+	{r: 0x650F, men: 2, ku: 13, ten: 60},  // 攏
+	{r: 0x6514, men: 2, ku: 13, ten: 61},  // 攔
+	{r: 0x6516, men: 2, ku: 13, ten: 62},  // 攖
+	{r: 0x651E, men: 2, ku: 13, ten: 64},  // 攞
+	{r: 0x6522, men: 1, ku: 85, ten: 6},   // 攢
+	{r: 0x6529, men: 1, ku: 85, ten: 7},   // 攩
+	{r: 0x6532, men: 2, ku: 13, ten: 65},  // 攲
+	{r: 0x6544, men: 2, ku: 13, ten: 66},  // 敄
+	{r: 0x6554, men: 2, ku: 13, ten: 67},  // 敔
+	{r: 0x6567, men: 1, ku: 85, ten: 9},   // 敧
+	{r: 0x656B, men: 2, ku: 13, ten: 68},  // 敫
+	{r: 0x657A, men: 2, ku: 13, ten: 69},  // 敺
+	{r: 0x6581, men: 2, ku: 13, ten: 70},  // 斁
+	{r: 0x6584, men: 2, ku: 13, ten: 71},  // 斄
+	{r: 0x6585, men: 2, ku: 13, ten: 72},  // 斅
+	{r: 0x658A, men: 2, ku: 13, ten: 73},  // 斊
+	{r: 0x659D, men: 1, ku: 85, ten: 10},  // 斝
+	{r: 0x65B2, men: 2, ku: 13, ten: 74},  // 斲
+	{r: 0x65B5, men: 2, ku: 13, ten: 75},  // 斵
+	{r: 0x65B8, men: 2, ku: 13, ten: 76},  // 斸
+	{r: 0x65BF, men: 2, ku: 13, ten: 77},  // 斿
+	{r: 0x65C2, men: 2, ku: 13, ten: 78},  // 旂
+	{r: 0x65C9, men: 2, ku: 13, ten: 79},  // 旉
+	{r: 0x65D4, men: 2, ku: 13, ten: 80},  // 旔
+	{r: 0x65F2, men: 2, ku: 13, ten: 82},  // 旲
+	{r: 0x65F9, men: 2, ku: 13, ten: 83},  // 旹
+	{r: 0x65FC, men: 2, ku: 13, ten: 84},  // 旼
+	{r: 0x6600, men: 1, ku: 85, ten: 12},  // 昀
+	{r: 0x6604, men: 2, ku: 13, ten: 85},  // 昄
+	{r: 0x6608, men: 2, ku: 13, ten: 86},  // 昈
+	{r: 0x6609, men: 1, ku: 85, ten: 13},  // 昉
+	{r: 0x6615, men: 1, ku: 85, ten: 14},  // 昕
+	{r: 0x661E, men: 1, ku: 85, ten: 15},  // 昞
+	{r: 0x6621, men: 2, ku: 13, ten: 87},  // 昡
+	{r: 0x6622, men: 1, ku: 85, ten: 17},  // 昢
+	{r: 0x6624, men: 1, ku: 85, ten: 18},  // 昤
+	{r: 0x662A, men: 2, ku: 13, ten: 88},  // 昪
+	{r: 0x662B, men: 1, ku: 85, ten: 19},  // 昫
+	{r: 0x6630, men: 1, ku: 85, ten: 20},  // 昰
+	{r: 0x6631, men: 1, ku: 85, ten: 21},  // 昱
+	{r: 0x6633, men: 1, ku: 85, ten: 22},  // 昳
+	{r: 0x663A, men: 1, ku: 85, ten: 16},  // 昺
+	{r: 0x6645, men: 2, ku: 13, ten: 89},  // 晅
+	{r: 0x6648, men: 1, ku: 85, ten: 24},  // 晈
+	{r: 0x664C, men: 1, ku: 85, ten: 25},  // 晌
+	{r: 0x664E, men: 2, ku: 13, ten: 91},  // 晎
+	{r: 0x6651, men: 2, ku: 13, ten: 90},  // 晑
+	{r: 0x6657, men: 2, ku: 13, ten: 94},  // 晗
+	{r: 0x6659, men: 1, ku: 85, ten: 27},  // 晙
+	{r: 0x665A, men: 1, ku: 85, ten: 28},  // 晚
+	{r: 0x665B, men: 2, ku: 14, ten: 1},   // 晛
+	{r: 0x6661, men: 1, ku: 85, ten: 29},  // 晡
+	{r: 0x6663, men: 2, ku: 14, ten: 2},   // 晣
+	{r: 0x6665, men: 1, ku: 85, ten: 30},  // 晥
+	{r: 0x666A, men: 2, ku: 14, ten: 5},   // 晪
+	{r: 0x666B, men: 2, ku: 14, ten: 6},   // 晫
+	{r: 0x666C, men: 2, ku: 14, ten: 7},   // 晬
+	{r: 0x666D, men: 2, ku: 14, ten: 8},   // 晭
+	{r: 0x6673, men: 1, ku: 85, ten: 31},  // 晳
+	{r: 0x6677, men: 1, ku: 85, ten: 32},  // 晷
+	{r: 0x6678, men: 1, ku: 85, ten: 33},  // 晸
+	{r: 0x667B, men: 2, ku: 14, ten: 9},   // 晻
+	{r: 0x6680, men: 2, ku: 14, ten: 10},  // 暀
+	{r: 0x668D, men: 1, ku: 85, ten: 34},  // 暍
+	{r: 0x6690, men: 2, ku: 14, ten: 11},  // 暐
+	{r: 0x6692, men: 2, ku: 14, ten: 12},  // 暒
+	{r: 0x6699, men: 2, ku: 14, ten: 13},  // 暙
+	{r: 0x66A0, men: 1, ku: 85, ten: 36},  // 暠
+	{r: 0x66AD, men: 2, ku: 14, ten: 15},  // 暭
+	{r: 0x66B1, men: 2, ku: 14, ten: 16},  // 暱
+	{r: 0x66B2, men: 1, ku: 85, ten: 37},  // 暲
+	{r: 0x66B5, men: 2, ku: 14, ten: 17},  // 暵
+	{r: 0x66BB, men: 1, ku: 85, ten: 38},  // 暻
+	{r: 0x66BF, men: 2, ku: 14, ten: 19},  // 暿
+	{r: 0x66C6, men: 1, ku: 85, ten: 39},  // 曆
+	{r: 0x66C8, men: 1, ku: 85, ten: 40},  // 曈
+	{r: 0x66DB, men: 1, ku: 85, ten: 42},  // 曛
+	{r: 0x66E8, men: 1, ku: 85, ten: 43},  // 曨
+	{r: 0x66EC, men: 2, ku: 14, ten: 21},  // 曬
+	{r: 0x66FA, men: 1, ku: 85, ten: 44},  // 曺
+	{r: 0x66FB, men: 1, ku: 85, ten: 23},  // 曻
+	{r: 0x6701, men: 2, ku: 14, ten: 23},  // 朁
+	{r: 0x6705, men: 2, ku: 14, ten: 24},  // 朅
+	{r: 0x6712, men: 2, ku: 14, ten: 25},  // 朒
+	{r: 0x6713, men: 1, ku: 85, ten: 45},  // 朓
+	{r: 0x6719, men: 2, ku: 14, ten: 27},  // 朙
+	{r: 0x6733, men: 1, ku: 85, ten: 47},  // 朳
+	{r: 0x6747, men: 1, ku: 85, ten: 49},  // 杇
+	{r: 0x6748, men: 1, ku: 85, ten: 50},  // 杈
+	{r: 0x674C, men: 2, ku: 14, ten: 30},  // 杌
+	{r: 0x674D, men: 2, ku: 14, ten: 31},  // 杍
+	{r: 0x6754, men: 2, ku: 14, ten: 32},  // 杔
+	{r: 0x675D, men: 2, ku: 14, ten: 33},  // 杝
+	{r: 0x6766, men: 1, ku: 85, ten: 48},  // 杦
+	{r: 0x6774, men: 2, ku: 14, ten: 37},  // 杴
+	{r: 0x6776, men: 2, ku: 14, ten: 38},  // 杶
+	{r: 0x677B, men: 1, ku: 85, ten: 51},  // 杻
+	{r: 0x6781, men: 1, ku: 85, ten: 52},  // 极
+	{r: 0x6792, men: 2, ku: 14, ten: 40},  // 枒
+	{r: 0x6793, men: 1, ku: 85, ten: 53},  // 枓
+	{r: 0x6798, men: 1, ku: 85, ten: 54},  // 枘
+	{r: 0x679B, men: 1, ku: 85, ten: 55},  // 枛
+	{r: 0x67B0, men: 2, ku: 14, ten: 44},  // 枰
+	{r: 0x67B2, men: 2, ku: 14, ten: 45},  // 枲
+	{r: 0x67BB, men: 1, ku: 85, ten: 56},  // 枻
+	{r: 0x67C0, men: 1, ku: 85, ten: 58},  // 柀
+	{r: 0x67C3, men: 2, ku: 14, ten: 46},  // 柃
+	{r: 0x67C8, men: 2, ku: 14, ten: 47},  // 柈
+	{r: 0x67D2, men: 2, ku: 14, ten: 48},  // 柒
+	{r: 0x67D7, men: 1, ku: 85, ten: 59},  // 柗
+	{r: 0x67D9, men: 2, ku: 14, ten: 49},  // 柙
+	{r: 0x67DB, men: 2, ku: 14, ten: 50},  // 柛
+	{r: 0x67F0, men: 2, ku: 14, ten: 51},  // 柰
+	{r: 0x67F7, men: 2, ku: 14, ten: 52},  // 柷
+	{r: 0x67F9, men: 1, ku: 85, ten: 57},  // 柹
+	{r: 0x67FC, men: 1, ku: 85, ten: 60},  // 柼
+	{r: 0x6801, men: 1, ku: 85, ten: 61},  // 栁
+	{r: 0x6810, men: 2, ku: 14, ten: 43},  // 栐
+	{r: 0x6818, men: 2, ku: 14, ten: 56},  // 栘
+	{r: 0x681D, men: 1, ku: 85, ten: 63},  // 栝
+	{r: 0x681F, men: 2, ku: 14, ten: 57},  // 栟
+	{r: 0x682C, men: 1, ku: 85, ten: 64},  // 栬
+	{r: 0x682D, men: 2, ku: 14, ten: 58},  // 栭
+	{r: 0x6831, men: 1, ku: 85, ten: 65},  // 栱
+	{r: 0x6833, men: 2, ku: 14, ten: 60},  // 栳
+	{r: 0x683B, men: 2, ku: 14, ten: 61},  // 栻
+	{r: 0x683E, men: 2, ku: 14, ten: 62},  // 栾
+	{r: 0x6844, men: 2, ku: 14, ten: 63},  // 桄
+	{r: 0x6845, men: 2, ku: 14, ten: 64},  // 桅
+	{r: 0x6849, men: 2, ku: 14, ten: 65},  // 桉
+	{r: 0x684C, men: 2, ku: 14, ten: 66},  // 桌
+	{r: 0x6852, men: 1, ku: 85, ten: 62},  // 桒
+	{r: 0x6855, men: 2, ku: 14, ten: 67},  // 桕
+	{r: 0x6857, men: 2, ku: 14, ten: 68},  // 桗
+	{r: 0x685B, men: 1, ku: 85, ten: 66},  // 桛
+	{r: 0x686B, men: 2, ku: 14, ten: 70},  // 桫
+	{r: 0x686E, men: 2, ku: 14, ten: 71},  // 桮
+	{r: 0x6872, men: 1, ku: 85, ten: 67},  // 桲
+	{r: 0x6875, men: 1, ku: 85, ten: 68},  // 桵
+	{r: 0x687A, men: 2, ku: 14, ten: 72},  // 桺
+	{r: 0x687C, men: 2, ku: 14, ten: 73},  // 桼
+	{r: 0x6882, men: 2, ku: 14, ten: 74},  // 梂
+	{r: 0x6890, men: 2, ku: 14, ten: 75},  // 梐
+	{r: 0x6896, men: 2, ku: 14, ten: 76},  // 梖
+	{r: 0x6898, men: 2, ku: 14, ten: 78},  // 梘
+	{r: 0x6899, men: 2, ku: 14, ten: 79},  // 梙
+	{r: 0x689A, men: 2, ku: 14, ten: 80},  // 梚
+	{r: 0x689C, men: 2, ku: 14, ten: 81},  // 梜
+	{r: 0x68A3, men: 1, ku: 85, ten: 70},  // 梣
+	{r: 0x68A5, men: 1, ku: 85, ten: 71},  // 梥
+	{r: 0x68AA, men: 2, ku: 14, ten: 82},  // 梪
+	{r: 0x68AB, men: 2, ku: 14, ten: 83},  // 梫
+	{r: 0x68B2, men: 1, ku: 85, ten: 72},  // 梲
+	{r: 0x68B4, men: 2, ku: 14, ten: 84},  // 梴
+	{r: 0x68BB, men: 2, ku: 14, ten: 85},  // 梻
+	{r: 0x68C3, men: 2, ku: 14, ten: 90},  // 棃
+	{r: 0x68C5, men: 2, ku: 14, ten: 91},  // 棅
+	{r: 0x68C8, men: 1, ku: 85, ten: 73},  // 棈
+	{r: 0x68CC, men: 2, ku: 14, ten: 92},  // 棌
+	{r: 0x68CF, men: 2, ku: 14, ten: 93},  // 棏
+	{r: 0x68D0, men: 1, ku: 85, ten: 74},  // 棐
+	{r: 0x68D6, men: 2, ku: 14, ten: 94},  // 棖
+	{r: 0x68D9, men: 2, ku: 15, ten: 1},   // 棙
+	{r: 0x68E4, men: 2, ku: 15, ten: 2},   // 棤
+	{r: 0x68E5, men: 2, ku: 15, ten: 3},   // 棥
+	{r: 0x68E8, men: 1, ku: 85, ten: 75},  // 棨
+	{r: 0x68EC, men: 2, ku: 15, ten: 4},   // 棬
+	{r: 0x68ED, men: 1, ku: 85, ten: 76},  // 棭
+	{r: 0x68F0, men: 1, ku: 85, ten: 77},  // 棰
+	{r: 0x68F1, men: 1, ku: 85, ten: 78},  // 棱
+	{r: 0x68F7, men: 2, ku: 15, ten: 5},   // 棷
+	{r: 0x68FB, men: 2, ku: 14, ten: 86},  // 棻
+	{r: 0x68FC, men: 1, ku: 85, ten: 79},  // 棼
+	{r: 0x6903, men: 2, ku: 15, ten: 6},   // 椃
+	{r: 0x6907, men: 2, ku: 15, ten: 7},   // 椇
+	{r: 0x690A, men: 1, ku: 85, ten: 80},  // 椊
+	{r: 0x6935, men: 1, ku: 85, ten: 83},  // 椵
+	{r: 0x693B, men: 2, ku: 15, ten: 11},  // 椻
+	{r: 0x6942, men: 1, ku: 85, ten: 84},  // 楂
+	{r: 0x6946, men: 2, ku: 15, ten: 13},  // 楆
+	{r: 0x6949, men: 1, ku: 85, ten: 81},  // 楉
+	{r: 0x6957, men: 1, ku: 85, ten: 85},  // 楗
+	{r: 0x6963, men: 1, ku: 85, ten: 86},  // 楣
+	{r: 0x6964, men: 1, ku: 85, ten: 87},  // 楤
+	{r: 0x6968, men: 1, ku: 85, ten: 88},  // 楨
+	{r: 0x6969, men: 2, ku: 15, ten: 14},  // 楩
+	{r: 0x696C, men: 2, ku: 15, ten: 15},  // 楬
+	{r: 0x6972, men: 2, ku: 15, ten: 16},  // 楲
+	{r: 0x697A, men: 2, ku: 15, ten: 17},  // 楺
+	{r: 0x697F, men: 2, ku: 15, ten: 18},  // 楿
+	{r: 0x6980, men: 1, ku: 85, ten: 89},  // 榀
+	{r: 0x6992, men: 2, ku: 15, ten: 19},  // 榒
+	{r: 0x6996, men: 2, ku: 15, ten: 21},  // 榖
+	{r: 0x6998, men: 2, ku: 15, ten: 22},  // 榘
+	{r: 0x69A5, men: 1, ku: 85, ten: 91},  // 榥
+	{r: 0x69A6, men: 2, ku: 15, ten: 23},  // 榦
+	{r: 0x69AD, men: 1, ku: 85, ten: 92},  // 榭
+	{r: 0x69B0, men: 2, ku: 15, ten: 24},  // 榰
+	{r: 0x69B7, men: 2, ku: 15, ten: 25},  // 榷
+	{r: 0x69BA, men: 2, ku: 15, ten: 26},  // 榺
+	{r: 0x69BC, men: 2, ku: 15, ten: 27},  // 榼
+	{r: 0x69C0, men: 2, ku: 15, ten: 28},  // 槀
+	{r: 0x69CF, men: 1, ku: 85, ten: 93},  // 槏
+	{r: 0x69D1, men: 2, ku: 15, ten: 29},  // 槑
+	{r: 0x69D6, men: 2, ku: 15, ten: 30},  // 槖
+	{r: 0x69E2, men: 1, ku: 86, ten: 2},   // 槢
+	{r: 0x69E3, men: 2, ku: 15, ten: 36},  // 槣
+	{r: 0x69E9, men: 1, ku: 86, ten: 3},   // 槩
+	{r: 0x69EA, men: 1, ku: 86, ten: 4},   // 槪
+	{r: 0x69EE, men: 2, ku: 15, ten: 37},  // 槮
+	{r: 0x69EF, men: 2, ku: 15, ten: 38},  // 槯
+	{r: 0x69F3, men: 2, ku: 15, ten: 39},  // 槳
+	{r: 0x69F4, men: 2, ku: 15, ten: 41},  // 槴
+	{r: 0x69F5, men: 1, ku: 86, ten: 5},   // 槵
+	{r: 0x69F6, men: 1, ku: 86, ten: 6},   // 槶
+	{r: 0x69FE, men: 2, ku: 15, ten: 42},  // 槾
+	{r: 0x6A0F, men: 1, ku: 86, ten: 7},   // 樏
+	{r: 0x6A11, men: 2, ku: 15, ten: 43},  // 樑
+	{r: 0x6A15, men: 1, ku: 86, ten: 8},   // 樕
+	{r: 0x6A1A, men: 2, ku: 15, ten: 44},  // 樚
+	{r: 0x6A1D, men: 2, ku: 15, ten: 45},  // 樝
+	{r: 0x6A30, men: 2, ku: 15, ten: 33},  // 樰
+	{r: 0x6A32, men: 2, ku: 15, ten: 47},  // 樲
+	{r: 0x6A33, men: 2, ku: 15, ten: 48},  // 樳
+	{r: 0x6A34, men: 2, ku: 15, ten: 49},  // 樴
+	{r: 0x6A3B, men: 1, ku: 86, ten: 10},  // 樻
+	{r: 0x6A3E, men: 1, ku: 86, ten: 11},  // 樾
+	{r: 0x6A3F, men: 2, ku: 15, ten: 50},  // 樿
+	{r: 0x6A45, men: 1, ku: 86, ten: 12},  // 橅
+	{r: 0x6A46, men: 2, ku: 15, ten: 51},  // 橆
+	{r: 0x6A49, men: 2, ku: 15, ten: 52},  // 橉
+	{r: 0x6A4E, men: 2, ku: 15, ten: 54},  // 橎
+	{r: 0x6A50, men: 1, ku: 86, ten: 13},  // 橐
+	{r: 0x6A52, men: 2, ku: 15, ten: 55},  // 橒
+	{r: 0x6A56, men: 1, ku: 86, ten: 14},  // 橖
+	{r: 0x6A5B, men: 1, ku: 86, ten: 15},  // 橛
+	{r: 0x6A64, men: 2, ku: 15, ten: 56},  // 橤
+	{r: 0x6A6B, men: 1, ku: 86, ten: 16},  // 橫
+	{r: 0x6A73, men: 1, ku: 86, ten: 17},  // 橳
+	{r: 0x6A7A, men: 2, ku: 15, ten: 53},  // 橺
+	{r: 0x6A7E, men: 2, ku: 15, ten: 58},  // 橾
+	{r: 0x6A83, men: 2, ku: 15, ten: 59},  // 檃
+	{r: 0x6A89, men: 1, ku: 86, ten: 19},  // 檉
+	{r: 0x6A8B, men: 2, ku: 15, ten: 60},  // 檋
+	{r: 0x6A91, men: 2, ku: 15, ten: 62},  // 檑
+	{r: 0x6A94, men: 1, ku: 86, ten: 20},  // 檔
+	{r: 0x6A9D, men: 1, ku: 86, ten: 21},  // 檝
+	{r: 0x6A9E, men: 1, ku: 86, ten: 22},  // 檞
+	{r: 0x6A9F, men: 2, ku: 15, ten: 63},  // 檟
+	{r: 0x6AA1, men: 2, ku: 15, ten: 64},  // 檡
+	{r: 0x6AA5, men: 1, ku: 86, ten: 23},  // 檥
+	{r: 0x6AAB, men: 2, ku: 15, ten: 66},  // 檫
+	{r: 0x6ABD, men: 2, ku: 15, ten: 67},  // 檽
+	{r: 0x6AC6, men: 2, ku: 15, ten: 68},  // 櫆
+	{r: 0x6AD0, men: 2, ku: 15, ten: 70},  // 櫐
+	{r: 0x6AD4, men: 2, ku: 15, ten: 69},  // 櫔
+	{r: 0x6ADC, men: 2, ku: 15, ten: 71},  // 櫜
+	{r: 0x6ADD, men: 2, ku: 15, ten: 72},  // 櫝
+	{r: 0x6AE4, men: 1, ku: 86, ten: 24},  // 櫤
+	{r: 0x6AE7, men: 1, ku: 86, ten: 25},  // 櫧
+	{r: 0x6AEC, men: 2, ku: 15, ten: 75},  // 櫬
+	{r: 0x6AF1, men: 2, ku: 15, ten: 76},  // 櫱
+	{r: 0x6AF2, men: 2, ku: 15, ten: 77},  // 櫲
+	{r: 0x6AF3, men: 2, ku: 15, ten: 78},  // 櫳
+	{r: 0x6AFD, men: 2, ku: 15, ten: 79},  // 櫽
+	{r: 0x6B0B, men: 2, ku: 15, ten: 81},  // 欋
+	{r: 0x6B0F, men: 2, ku: 15, ten: 82},  // 欏
+	{r: 0x6B10, men: 2, ku: 15, ten: 83},  // 欐
+	{r: 0x6B11, men: 2, ku: 15, ten: 84},  // 欑
+	{r: 0x6B17, men: 2, ku: 15, ten: 86},  // 欗
+	{r: 0x6B1B, men: 1, ku: 86, ten: 28},  // 欛
+	{r: 0x6B1E, men: 1, ku: 86, ten: 29},  // 欞
+	{r: 0x6B2C, men: 1, ku: 86, ten: 30},  // 欬
+	{r: 0x6B2F, men: 2, ku: 15, ten: 88},  // 欯
+	{r: 0x6B35, men: 1, ku: 86, ten: 31},  // 欵
+	{r: 0x6B46, men: 1, ku: 86, ten: 32},  // 歆
+	{r: 0x6B4A, men: 2, ku: 15, ten: 89},  // 歊
+	{r: 0x6B56, men: 1, ku: 86, ten: 33},  // 歖
+	{r: 0x6B58, men: 2, ku: 15, ten: 90},  // 歘
+	{r: 0x6B60, men: 1, ku: 86, ten: 34},  // 歠
+	{r: 0x6B65, men: 1, ku: 86, ten: 35},  // 步
+	{r: 0x6B67, men: 1, ku: 86, ten: 36},  // 歧
+	{r: 0x6B6C, men: 2, ku: 15, ten: 91},  // 歬
+	{r: 0x6B75, men: 2, ku: 15, ten: 92},  // 歵
+	{r: 0x6B77, men: 1, ku: 86, ten: 37},  // 歷
+	{r: 0x6B7A, men: 2, ku: 15, ten: 93},  // 歺
+	{r: 0x6B81, men: 2, ku: 15, ten: 94},  // 殁
+	{r: 0x6B82, men: 1, ku: 86, ten: 38},  // 殂
+	{r: 0x6B9B, men: 2, ku: 78, ten: 1},   // 殛
+	{r: 0x6BA9, men: 1, ku: 86, ten: 39},  // 殩
+	{r: 0x6BAD, men: 1, ku: 86, ten: 40},  // 殭
+	{r: 0x6BAE, men: 2, ku: 78, ten: 2},   // 殮
+	{r: 0x6BBD, men: 2, ku: 78, ten: 4},   // 殽
+	{r: 0x6BBE, men: 2, ku: 78, ten: 5},   // 殾
+	{r: 0x6BC7, men: 2, ku: 78, ten: 6},   // 毇
+	{r: 0x6BC8, men: 2, ku: 78, ten: 7},   // 毈
+	{r: 0x6BC9, men: 2, ku: 78, ten: 8},   // 毉
+	{r: 0x6BCF, men: 1, ku: 86, ten: 42},  // 每
+	{r: 0x6BD6, men: 1, ku: 86, ten: 43},  // 毖
+	{r: 0x6BD7, men: 1, ku: 86, ten: 44},  // 毗
+	{r: 0x6BDA, men: 2, ku: 78, ten: 9},   // 毚
+	{r: 0x6BE6, men: 2, ku: 78, ten: 10},  // 毦
+	{r: 0x6BE7, men: 2, ku: 78, ten: 11},  // 毧
+	{r: 0x6BEE, men: 2, ku: 78, ten: 12},  // 毮
+	{r: 0x6BF1, men: 2, ku: 78, ten: 13},  // 毱
+	{r: 0x6BFF, men: 1, ku: 86, ten: 45},  // 毿
+	{r: 0x6C02, men: 2, ku: 78, ten: 14},  // 氂
+	{r: 0x6C05, men: 1, ku: 86, ten: 46},  // 氅
+	{r: 0x6C0A, men: 2, ku: 78, ten: 15},  // 氊
+	{r: 0x6C0E, men: 2, ku: 78, ten: 16},  // 氎
+	{r: 0x6C10, men: 1, ku: 86, ten: 47},  // 氐
+	{r: 0x6C33, men: 1, ku: 86, ten: 48},  // 氳
+	{r: 0x6C35, men: 2, ku: 78, ten: 17},  // 氵
+	{r: 0x6C36, men: 2, ku: 78, ten: 18},  // 氶
+	{r: 0x6C3A, men: 2, ku: 78, ten: 19},  // 氺
+	{r: 0x6C3F, men: 2, ku: 78, ten: 21},  // 氿
+	{r: 0x6C4D, men: 2, ku: 78, ten: 22},  // 汍
+	{r: 0x6C59, men: 1, ku: 86, ten: 49},  // 汙
+	{r: 0x6C5B, men: 2, ku: 78, ten: 23},  // 汛
+	{r: 0x6C5C, men: 1, ku: 86, ten: 50},  // 汜
+	{r: 0x6C67, men: 2, ku: 78, ten: 47},  // 汧
+	{r: 0x6C6D, men: 2, ku: 78, ten: 24},  // 汭
+	{r: 0x6C74, men: 1, ku: 86, ten: 52},  // 汴
+	{r: 0x6C76, men: 1, ku: 86, ten: 53},  // 汶
+	{r: 0x6C84, men: 2, ku: 78, ten: 25},  // 沄
+	{r: 0x6C85, men: 1, ku: 86, ten: 54},  // 沅
+	{r: 0x6C86, men: 1, ku: 86, ten: 55},  // 沆
+	{r: 0x6C89, men: 2, ku: 78, ten: 26},  // 沉
+	{r: 0x6C94, men: 2, ku: 78, ten: 28},  // 沔
+	{r: 0x6C95, men: 2, ku: 78, ten: 29},  // 沕
+	{r: 0x6C97, men: 2, ku: 78, ten: 30},  // 沗
+	{r: 0x6C98, men: 1, ku: 86, ten: 56},  // 沘
+	{r: 0x6C9C, men: 1, ku: 86, ten: 57},  // 沜
+	{r: 0x6CAA, men: 1, ku: 86, ten: 51},  // 沪
+	{r: 0x6CAD, men: 2, ku: 78, ten: 31},  // 沭
+	{r: 0x6CC2, men: 2, ku: 78, ten: 32},  // 泂
+	{r: 0x6CC6, men: 1, ku: 86, ten: 59},  // 泆
+	{r: 0x6CD0, men: 2, ku: 78, ten: 33},  // 泐
+	{r: 0x6CD4, men: 1, ku: 86, ten: 60},  // 泔
+	{r: 0x6CD6, men: 2, ku: 78, ten: 35},  // 泖
+	{r: 0x6CDA, men: 2, ku: 78, ten: 36},  // 泚
+	{r: 0x6CDC, men: 2, ku: 78, ten: 37},  // 泜
+	{r: 0x6CE0, men: 1, ku: 86, ten: 61},  // 泠
+	{r: 0x6CE9, men: 2, ku: 78, ten: 38},  // 泩
+	{r: 0x6CEB, men: 1, ku: 86, ten: 62},  // 泫
+	{r: 0x6CEC, men: 2, ku: 78, ten: 39},  // 泬
+	{r: 0x6CED, men: 2, ku: 78, ten: 40},  // 泭
+	{r: 0x6CEE, men: 1, ku: 86, ten: 63},  // 泮
+	{r: 0x6CFB, men: 1, ku: 86, ten: 58},  // 泻
+	{r: 0x6D00, men: 2, ku: 78, ten: 42},  // 洀
+	{r: 0x6D04, men: 1, ku: 86, ten: 65},  // 洄
+	{r: 0x6D0A, men: 2, ku: 78, ten: 43},  // 洊
+	{r: 0x6D0E, men: 1, ku: 86, ten: 66},  // 洎
+	{r: 0x6D24, men: 2, ku: 78, ten: 44},  // 洤
+	{r: 0x6D26, men: 2, ku: 78, ten: 45},  // 洦
+	{r: 0x6D27, men: 2, ku: 78, ten: 46},  // 洧
+	{r: 0x6D2E, men: 1, ku: 86, ten: 67},  // 洮
+	{r: 0x6D2F, men: 2, ku: 78, ten: 48},  // 洯
+	{r: 0x6D31, men: 1, ku: 86, ten: 68},  // 洱
+	{r: 0x6D34, men: 2, ku: 78, ten: 69},  // 洴
+	{r: 0x6D39, men: 1, ku: 86, ten: 69},  // 洹
+	{r: 0x6D3C, men: 2, ku: 78, ten: 49},  // 洼
+	{r: 0x6D3F, men: 1, ku: 86, ten: 70},  // 洿
+	{r: 0x6D58, men: 1, ku: 86, ten: 71},  // 浘
+	{r: 0x6D5B, men: 2, ku: 78, ten: 50},  // 浛
+	{r: 0x6D5E, men: 2, ku: 78, ten: 51},  // 浞
+	{r: 0x6D60, men: 2, ku: 78, ten: 52},  // 浠
+	{r: 0x6D65, men: 1, ku: 86, ten: 72},  // 浥
+	{r: 0x6D70, men: 2, ku: 78, ten: 53},  // 浰
+	{r: 0x6D80, men: 2, ku: 78, ten: 54},  // 涀
+	{r: 0x6D81, men: 2, ku: 78, ten: 55},  // 涁
+	{r: 0x6D82, men: 1, ku: 86, ten: 74},  // 涂
+	{r: 0x6D87, men: 1, ku: 86, ten: 75},  // 涇
+	{r: 0x6D89, men: 1, ku: 86, ten: 76},  // 涉
+	{r: 0x6D8A, men: 2, ku: 78, ten: 56},  // 涊
+	{r: 0x6D8D, men: 2, ku: 78, ten: 57},  // 涍
+	{r: 0x6D91, men: 2, ku: 78, ten: 58},  // 涑
+	{r: 0x6D94, men: 1, ku: 86, ten: 77},  // 涔
+	{r: 0x6D98, men: 2, ku: 78, ten: 59},  // 涘
+	{r: 0x6DAA, men: 1, ku: 86, ten: 78},  // 涪
+	{r: 0x6DAB, men: 2, ku: 78, ten: 65},  // 涫
+	{r: 0x6DAC, men: 1, ku: 86, ten: 79},  // 涬
+	{r: 0x6DAE, men: 2, ku: 78, ten: 66},  // 涮
+	{r: 0x6DB4, men: 2, ku: 78, ten: 67},  // 涴
+	{r: 0x6DBF, men: 1, ku: 86, ten: 80},  // 涿
+	{r: 0x6DC2, men: 2, ku: 78, ten: 68},  // 淂
+	{r: 0x6DC4, men: 1, ku: 86, ten: 81},  // 淄
+	{r: 0x6DC8, men: 2, ku: 78, ten: 70},  // 淈
+	{r: 0x6DCE, men: 2, ku: 78, ten: 71},  // 淎
+	{r: 0x6DCF, men: 2, ku: 78, ten: 72},  // 淏
+	{r: 0x6DD0, men: 2, ku: 78, ten: 73},  // 淐
+	{r: 0x6DD6, men: 1, ku: 86, ten: 82},  // 淖
+	{r: 0x6DDA, men: 1, ku: 86, ten: 83},  // 淚
+	{r: 0x6DDB, men: 1, ku: 86, ten: 84},  // 淛
+	{r: 0x6DDD, men: 1, ku: 86, ten: 85},  // 淝
+	{r: 0x6DDF, men: 2, ku: 78, ten: 74},  // 淟
+	{r: 0x6DE9, men: 2, ku: 78, ten: 75},  // 淩
+	{r: 0x6DF6, men: 2, ku: 78, ten: 76},  // 淶
+	{r: 0x6DFC, men: 1, ku: 86, ten: 86},  // 淼
+	{r: 0x6E17, men: 2, ku: 78, ten: 61},  // 渗
+	{r: 0x6E1E, men: 2, ku: 78, ten: 78},  // 渞
+	{r: 0x6E22, men: 2, ku: 78, ten: 79},  // 渢
+	{r: 0x6E27, men: 2, ku: 78, ten: 80},  // 渧
+	{r: 0x6E32, men: 2, ku: 78, ten: 82},  // 渲
+	{r: 0x6E34, men: 1, ku: 86, ten: 88},  // 渴
+	{r: 0x6E36, men: 2, ku: 78, ten: 77},  // 渶
+	{r: 0x6E3C, men: 2, ku: 78, ten: 83},  // 渼
+	{r: 0x6E44, men: 1, ku: 86, ten: 89},  // 湄
+	{r: 0x6E48, men: 2, ku: 78, ten: 84},  // 湈
+	{r: 0x6E49, men: 2, ku: 78, ten: 85},  // 湉
+	{r: 0x6E4B, men: 2, ku: 78, ten: 86},  // 湋
+	{r: 0x6E4C, men: 2, ku: 78, ten: 87},  // 湌
+	{r: 0x6E4F, men: 2, ku: 78, ten: 88},  // 湏
+	{r: 0x6E51, men: 2, ku: 78, ten: 89},  // 湑
+	{r: 0x6E53, men: 2, ku: 78, ten: 90},  // 湓
+	{r: 0x6E54, men: 2, ku: 78, ten: 91},  // 湔
+	{r: 0x6E57, men: 2, ku: 78, ten: 92},  // 湗
+	{r: 0x6E5C, men: 1, ku: 86, ten: 90},  // 湜
+	{r: 0x6E5E, men: 1, ku: 86, ten: 91},  // 湞
+	{r: 0x6E63, men: 2, ku: 78, ten: 93},  // 湣
+	{r: 0x6E93, men: 2, ku: 79, ten: 1},   // 溓
+	{r: 0x6EA7, men: 2, ku: 79, ten: 2},   // 溧
+	{r: 0x6EAB, men: 1, ku: 86, ten: 92},  // 溫
+	{r: 0x6EB1, men: 1, ku: 86, ten: 93},  // 溱
+	{r: 0x6EB4, men: 2, ku: 79, ten: 3},   // 溴
+	{r: 0x6EBF, men: 2, ku: 79, ten: 4},   // 溿
+	{r: 0x6EC1, men: 1, ku: 86, ten: 94},  // 滁
+	{r: 0x6EC3, men: 2, ku: 79, ten: 5},   // 滃
+	{r: 0x6EC7, men: 1, ku: 87, ten: 1},   // 滇
+	{r: 0x6ECA, men: 2, ku: 79, ten: 6},   // 滊
+	{r: 0x6ECE, men: 1, ku: 87, ten: 2},   // 滎
+	{r: 0x6ED9, men: 2, ku: 79, ten: 7},   // 滙
+	{r: 0x6EEB, men: 2, ku: 79, ten: 9},   // 滫
+	{r: 0x6EF9, men: 2, ku: 79, ten: 10},  // 滹
+	{r: 0x6EFB, men: 2, ku: 79, ten: 11},  // 滻
+	{r: 0x6F0A, men: 2, ku: 79, ten: 12},  // 漊
+	{r: 0x6F0C, men: 2, ku: 79, ten: 13},  // 漌
+	{r: 0x6F10, men: 1, ku: 87, ten: 3},   // 漐
+	{r: 0x6F18, men: 2, ku: 79, ten: 14},  // 漘
+	{r: 0x6F1A, men: 1, ku: 87, ten: 4},   // 漚
+	{r: 0x6F25, men: 2, ku: 79, ten: 15},  // 漥
+	{r: 0x6F2A, men: 1, ku: 87, ten: 6},   // 漪
+	{r: 0x6F2F, men: 1, ku: 87, ten: 7},   // 漯
+	{r: 0x6F33, men: 1, ku: 87, ten: 8},   // 漳
+	{r: 0x6F35, men: 2, ku: 79, ten: 8},   // 漵
+	{r: 0x6F36, men: 2, ku: 79, ten: 16},  // 漶
+	{r: 0x6F3C, men: 2, ku: 79, ten: 17},  // 漼
+	{r: 0x6F51, men: 1, ku: 87, ten: 9},   // 潑
+	{r: 0x6F52, men: 2, ku: 79, ten: 19},  // 潒
+	{r: 0x6F57, men: 2, ku: 79, ten: 20},  // 潗
+	{r: 0x6F59, men: 1, ku: 87, ten: 10},  // 潙
+	{r: 0x6F5A, men: 2, ku: 79, ten: 21},  // 潚
+	{r: 0x6F5E, men: 1, ku: 87, ten: 11},  // 潞
+	{r: 0x6F60, men: 2, ku: 79, ten: 22},  // 潠
+	{r: 0x6F61, men: 1, ku: 87, ten: 12},  // 潡
+	{r: 0x6F62, men: 1, ku: 87, ten: 13},  // 潢
+	{r: 0x6F68, men: 2, ku: 79, ten: 23},  // 潨
+	{r: 0x6F7D, men: 2, ku: 79, ten: 25},  // 潽
+	{r: 0x6F7E, men: 1, ku: 87, ten: 14},  // 潾
+	{r: 0x6F88, men: 1, ku: 87, ten: 15},  // 澈
+	{r: 0x6F8C, men: 1, ku: 87, ten: 16},  // 澌
+	{r: 0x6F8D, men: 1, ku: 87, ten: 17},  // 澍
+	{r: 0x6F90, men: 2, ku: 79, ten: 26},  // 澐
+	{r: 0x6F94, men: 1, ku: 87, ten: 18},  // 澔
+	{r: 0x6F96, men: 2, ku: 79, ten: 27},  // 澖
+	{r: 0x6F98, men: 2, ku: 79, ten: 24},  // 澘
+	{r: 0x6F9F, men: 2, ku: 79, ten: 29},  // 澟
+	{r: 0x6FA0, men: 1, ku: 87, ten: 19},  // 澠
+	{r: 0x6FA5, men: 2, ku: 79, ten: 30},  // 澥
+	{r: 0x6FA7, men: 1, ku: 87, ten: 20},  // 澧
+	{r: 0x6FAF, men: 2, ku: 79, ten: 31},  // 澯
+	{r: 0x6FB5, men: 2, ku: 79, ten: 33},  // 澵
+	{r: 0x6FB6, men: 1, ku: 87, ten: 21},  // 澶
+	{r: 0x6FBC, men: 1, ku: 87, ten: 22},  // 澼
+	{r: 0x6FBE, men: 2, ku: 79, ten: 28},  // 澾
+	{r: 0x6FC7, men: 1, ku: 87, ten: 23},  // 濇
+	{r: 0x6FC8, men: 2, ku: 79, ten: 34},  // 濈
+	{r: 0x6FC9, men: 2, ku: 79, ten: 35},  // 濉
+	{r: 0x6FCA, men: 1, ku: 87, ten: 24},  // 濊
+	{r: 0x6FDA, men: 2, ku: 79, ten: 36},  // 濚
+	{r: 0x6FDE, men: 2, ku: 79, ten: 37},  // 濞
+	{r: 0x6FE9, men: 2, ku: 79, ten: 38},  // 濩
+	{r: 0x6FF0, men: 1, ku: 87, ten: 26},  // 濰
+	{r: 0x6FF5, men: 1, ku: 87, ten: 27},  // 濵
+	{r: 0x6FF9, men: 1, ku: 87, ten: 25},  // 濹
+	{r: 0x6FFC, men: 2, ku: 79, ten: 40},  // 濼
+	{r: 0x7000, men: 2, ku: 79, ten: 41},  // 瀀
+	{r: 0x7005, men: 1, ku: 87, ten: 28},  // 瀅
+	{r: 0x7006, men: 1, ku: 87, ten: 29},  // 瀆
+	{r: 0x7007, men: 2, ku: 79, ten: 42},  // 瀇
+	{r: 0x700A, men: 2, ku: 79, ten: 43},  // 瀊
+	{r: 0x7023, men: 2, ku: 79, ten: 44},  // 瀣
+	{r: 0x7028, men: 1, ku: 87, ten: 30},  // 瀨
+	{r: 0x7039, men: 2, ku: 79, ten: 46},  // 瀹
+	{r: 0x703A, men: 2, ku: 79, ten: 47},  // 瀺
+	{r: 0x703C, men: 2, ku: 79, ten: 48},  // 瀼
+	{r: 0x7043, men: 2, ku: 79, ten: 49},  // 灃
+	{r: 0x7047, men: 2, ku: 79, ten: 50},  // 灇
+	{r: 0x704A, men: 1, ku: 87, ten: 31},  // 灊
+	{r: 0x704B, men: 2, ku: 79, ten: 51},  // 灋
+	{r: 0x704E, men: 1, ku: 87, ten: 34},  // 灎
+	{r: 0x7054, men: 2, ku: 79, ten: 53},  // 灔
+	{r: 0x705D, men: 1, ku: 87, ten: 32},  // 灝
+	{r: 0x705E, men: 1, ku: 87, ten: 33},  // 灞
+	{r: 0x7064, men: 1, ku: 87, ten: 35},  // 灤
+	{r: 0x7065, men: 2, ku: 79, ten: 54},  // 灥
+	{r: 0x7069, men: 2, ku: 79, ten: 55},  // 灩
+	{r: 0x706C, men: 2, ku: 79, ten: 56},  // 灬
+	{r: 0x706E, men: 2, ku: 79, ten: 57},  // 灮
+	{r: 0x7075, men: 1, ku: 87, ten: 36},  // 灵
+	{r: 0x7076, men: 2, ku: 79, ten: 58},  // 灶
+	{r: 0x707E, men: 2, ku: 79, ten: 59},  // 灾
+	{r: 0x7081, men: 2, ku: 79, ten: 60},  // 炁
+	{r: 0x7085, men: 1, ku: 87, ten: 37},  // 炅
+	{r: 0x7086, men: 2, ku: 79, ten: 61},  // 炆
+	{r: 0x7095, men: 2, ku: 79, ten: 62},  // 炕
+	{r: 0x7097, men: 2, ku: 79, ten: 63},  // 炗
+	{r: 0x709F, men: 2, ku: 79, ten: 66},  // 炟
+	{r: 0x70A4, men: 1, ku: 87, ten: 38},  // 炤
+	{r: 0x70AB, men: 1, ku: 87, ten: 39},  // 炫
+	{r: 0x70B1, men: 2, ku: 79, ten: 67},  // 炱
+	{r: 0x70B7, men: 1, ku: 87, ten: 40},  // 炷
+	{r: 0x70BB, men: 2, ku: 79, ten: 64},  // 炻
+	{r: 0x70CA, men: 2, ku: 79, ten: 70},  // 烊
+	{r: 0x70D1, men: 2, ku: 79, ten: 71},  // 烑
+	{r: 0x70D3, men: 2, ku: 79, ten: 72},  // 烓
+	{r: 0x70D4, men: 1, ku: 87, ten: 41},  // 烔
+	{r: 0x70D8, men: 1, ku: 87, ten: 42},  // 烘
+	{r: 0x70DC, men: 2, ku: 79, ten: 73},  // 烜
+	{r: 0x70E4, men: 1, ku: 87, ten: 43},  // 烤
+	{r: 0x70EC, men: 2, ku: 79, ten: 69},  // 烬
+	{r: 0x7103, men: 2, ku: 79, ten: 74},  // 焃
+	{r: 0x7104, men: 2, ku: 79, ten: 75},  // 焄
+	{r: 0x7106, men: 2, ku: 79, ten: 76},  // 焆
+	{r: 0x7107, men: 2, ku: 79, ten: 77},  // 焇
+	{r: 0x7108, men: 2, ku: 79, ten: 78},  // 焈
+	{r: 0x710C, men: 2, ku: 79, ten: 79},  // 焌
+	{r: 0x710F, men: 1, ku: 87, ten: 44},  // 焏
+	{r: 0x711E, men: 1, ku: 87, ten: 46},  // 焞
+	{r: 0x7120, men: 1, ku: 87, ten: 47},  // 焠
+	{r: 0x712B, men: 1, ku: 87, ten: 45},  // 焫
+	{r: 0x712E, men: 1, ku: 87, ten: 48},  // 焮
+	{r: 0x712F, men: 2, ku: 79, ten: 81},  // 焯
+	{r: 0x7130, men: 1, ku: 87, ten: 49},  // 焰
+	{r: 0x7131, men: 2, ku: 79, ten: 82},  // 焱
+	{r: 0x7146, men: 1, ku: 87, ten: 50},  // 煆
+	{r: 0x7147, men: 1, ku: 87, ten: 51},  // 煇
+	{r: 0x714A, men: 2, ku: 79, ten: 84},  // 煊
+	{r: 0x7150, men: 2, ku: 79, ten: 83},  // 煐
+	{r: 0x7151, men: 1, ku: 87, ten: 52},  // 煑
+	{r: 0x7152, men: 1, ku: 87, ten: 54},  // 煒
+	{r: 0x7153, men: 2, ku: 79, ten: 85},  // 煓
+	{r: 0x715C, men: 1, ku: 87, ten: 55},  // 煜
+	{r: 0x715E, men: 2, ku: 79, ten: 86},  // 煞
+	{r: 0x7160, men: 1, ku: 87, ten: 56},  // 煠
+	{r: 0x7168, men: 1, ku: 87, ten: 57},  // 煨
+	{r: 0x7180, men: 2, ku: 79, ten: 89},  // 熀
+	{r: 0x7185, men: 1, ku: 87, ten: 59},  // 熅
+	{r: 0x7187, men: 1, ku: 87, ten: 60},  // 熇
+	{r: 0x7192, men: 1, ku: 87, ten: 61},  // 熒
+	{r: 0x7196, men: 2, ku: 79, ten: 88},  // 熖
+	{r: 0x719B, men: 2, ku: 79, ten: 90},  // 熛
+	{r: 0x71A0, men: 2, ku: 79, ten: 91},  // 熠
+	{r: 0x71A2, men: 2, ku: 79, ten: 92},  // 熢
+	{r: 0x71AE, men: 2, ku: 79, ten: 93},  // 熮
+	{r: 0x71AF, men: 2, ku: 79, ten: 94},  // 熯
+	{r: 0x71B3, men: 2, ku: 80, ten: 1},   // 熳
+	{r: 0x71BA, men: 1, ku: 87, ten: 63},  // 熺
+	{r: 0x71C1, men: 1, ku: 87, ten: 62},  // 燁
+	{r: 0x71C4, men: 1, ku: 87, ten: 64},  // 燄
+	{r: 0x71CB, men: 2, ku: 80, ten: 3},   // 燋
+	{r: 0x71D3, men: 2, ku: 80, ten: 4},   // 燓
+	{r: 0x71D9, men: 2, ku: 80, ten: 5},   // 燙
+	{r: 0x71DC, men: 2, ku: 80, ten: 6},   // 燜
+	{r: 0x71FE, men: 1, ku: 87, ten: 65},  // 燾
+	{r: 0x7200, men: 1, ku: 87, ten: 66},  // 爀
+	{r: 0x7207, men: 2, ku: 80, ten: 7},   // 爇
+	{r: 0x7215, men: 1, ku: 87, ten: 67},  // 爕
+	{r: 0x722B, men: 2, ku: 80, ten: 10},  // 爫
+	{r: 0x7234, men: 2, ku: 80, ten: 11},  // 爴
+	{r: 0x7238, men: 2, ku: 80, ten: 12},  // 爸
+	{r: 0x7239, men: 2, ku: 80, ten: 13},  // 爹
+	{r: 0x7242, men: 2, ku: 80, ten: 15},  // 牂
+	{r: 0x7253, men: 2, ku: 80, ten: 16},  // 牓
+	{r: 0x7255, men: 1, ku: 87, ten: 68},  // 牕
+	{r: 0x7256, men: 1, ku: 87, ten: 69},  // 牖
+	{r: 0x7257, men: 2, ku: 80, ten: 17},  // 牗
+	{r: 0x7263, men: 2, ku: 80, ten: 18},  // 牣
+	{r: 0x726E, men: 2, ku: 80, ten: 20},  // 牮
+	{r: 0x726F, men: 2, ku: 80, ten: 21},  // 牯
+	{r: 0x7278, men: 2, ku: 80, ten: 22},  // 牸
+	{r: 0x727F, men: 2, ku: 80, ten: 23},  // 牿
+	{r: 0x728D, men: 1, ku: 87, ten: 71},  // 犍
+	{r: 0x728E, men: 2, ku: 80, ten: 24},  // 犎
+	{r: 0x729B, men: 1, ku: 87, ten: 72},  // 犛
+	{r: 0x72AD, men: 2, ku: 80, ten: 26},  // 犭
+	{r: 0x72AE, men: 2, ku: 80, ten: 27},  // 犮
+	{r: 0x72B0, men: 2, ku: 80, ten: 28},  // 犰
+	{r: 0x72B1, men: 2, ku: 80, ten: 29},  // 犱
+	{r: 0x72BE, men: 1, ku: 87, ten: 73},  // 犾
+	{r: 0x72C0, men: 1, ku: 87, ten: 74},  // 狀
+	{r: 0x72C1, men: 2, ku: 80, ten: 30},  // 狁
+	{r: 0x72CC, men: 2, ku: 80, ten: 32},  // 狌
+	{r: 0x72F3, men: 2, ku: 80, ten: 35},  // 狳
+	{r: 0x72FA, men: 2, ku: 80, ten: 36},  // 狺
+	{r: 0x72FB, men: 1, ku: 87, ten: 75},  // 狻
+	{r: 0x7307, men: 2, ku: 80, ten: 37},  // 猇
+	{r: 0x7312, men: 2, ku: 80, ten: 38},  // 猒
+	{r: 0x7318, men: 2, ku: 80, ten: 39},  // 猘
+	{r: 0x7319, men: 2, ku: 80, ten: 40},  // 猙
+	{r: 0x7327, men: 1, ku: 87, ten: 77},  // 猧
+	{r: 0x7328, men: 1, ku: 87, ten: 78},  // 猨
+	{r: 0x732C, men: 2, ku: 80, ten: 43},  // 猬
+	{r: 0x7331, men: 2, ku: 80, ten: 44},  // 猱
+	{r: 0x7333, men: 2, ku: 80, ten: 45},  // 猳
+	{r: 0x7339, men: 2, ku: 80, ten: 42},  // 猹
+	{r: 0x733D, men: 2, ku: 80, ten: 46},  // 猽
+	{r: 0x7350, men: 1, ku: 87, ten: 80},  // 獐
+	{r: 0x7352, men: 2, ku: 80, ten: 47},  // 獒
+	{r: 0x7366, men: 1, ku: 87, ten: 81},  // 獦
+	{r: 0x736B, men: 2, ku: 80, ten: 49},  // 獫
+	{r: 0x736C, men: 2, ku: 80, ten: 50},  // 獬
+	{r: 0x736E, men: 2, ku: 80, ten: 52},  // 獮
+	{r: 0x736F, men: 2, ku: 80, ten: 53},  // 獯
+	{r: 0x7371, men: 2, ku: 80, ten: 54},  // 獱
+	{r: 0x7377, men: 2, ku: 80, ten: 55},  // 獷
+	{r: 0x737C, men: 1, ku: 87, ten: 82},  // 獼
+	{r: 0x7381, men: 2, ku: 80, ten: 56},  // 玁
+	{r: 0x7385, men: 2, ku: 80, ten: 57},  // 玅
+	{r: 0x738A, men: 2, ku: 80, ten: 58},  // 玊
+	{r: 0x7394, men: 2, ku: 80, ten: 59},  // 玔
+	{r: 0x7395, men: 1, ku: 87, ten: 83},  // 玕
+	{r: 0x7398, men: 2, ku: 80, ten: 60},  // 玘
+	{r: 0x739C, men: 2, ku: 80, ten: 61},  // 玜
+	{r: 0x739E, men: 2, ku: 80, ten: 62},  // 玞
+	{r: 0x739F, men: 1, ku: 87, ten: 84},  // 玟
+	{r: 0x73A0, men: 1, ku: 87, ten: 85},  // 玠
+	{r: 0x73A2, men: 1, ku: 87, ten: 86},  // 玢
+	{r: 0x73A5, men: 2, ku: 80, ten: 63},  // 玥
+	{r: 0x73A6, men: 1, ku: 87, ten: 87},  // 玦
+	{r: 0x73A8, men: 2, ku: 80, ten: 64},  // 玨
+	{r: 0x73AB, men: 1, ku: 87, ten: 88},  // 玫
+	{r: 0x73B5, men: 2, ku: 80, ten: 65},  // 玵
+	{r: 0x73B7, men: 2, ku: 80, ten: 66},  // 玷
+	{r: 0x73B9, men: 2, ku: 80, ten: 67},  // 玹
+	{r: 0x73BC, men: 2, ku: 80, ten: 68},  // 玼
+	{r: 0x73BF, men: 2, ku: 80, ten: 69},  // 玿
+	{r: 0x73C5, men: 2, ku: 80, ten: 70},  // 珅
+	{r: 0x73C9, men: 1, ku: 87, ten: 89},  // 珉
+	{r: 0x73CB, men: 2, ku: 80, ten: 71},  // 珋
+	{r: 0x73CF, men: 1, ku: 87, ten: 90},  // 珏
+	{r: 0x73D6, men: 1, ku: 87, ten: 91},  // 珖
+	{r: 0x73D9, men: 1, ku: 87, ten: 92},  // 珙
+	{r: 0x73E1, men: 2, ku: 80, ten: 72},  // 珡
+	{r: 0x73E3, men: 1, ku: 87, ten: 93},  // 珣
+	{r: 0x73E7, men: 2, ku: 80, ten: 73},  // 珧
+	{r: 0x73E9, men: 1, ku: 87, ten: 94},  // 珩
+	{r: 0x73F9, men: 2, ku: 80, ten: 74},  // 珹
+	{r: 0x73FA, men: 2, ku: 80, ten: 76},  // 珺
+	{r: 0x7401, men: 2, ku: 80, ten: 77},  // 琁
+	{r: 0x7407, men: 1, ku: 88, ten: 1},   // 琇
+	{r: 0x740A, men: 1, ku: 88, ten: 2},   // 琊
+	{r: 0x7413, men: 2, ku: 80, ten: 75},  // 琓
+	{r: 0x741A, men: 1, ku: 88, ten: 3},   // 琚
+	{r: 0x741B, men: 1, ku: 88, ten: 4},   // 琛
+	{r: 0x7424, men: 2, ku: 80, ten: 78},  // 琤
+	{r: 0x7426, men: 1, ku: 88, ten: 6},   // 琦
+	{r: 0x7428, men: 1, ku: 88, ten: 7},   // 琨
+	{r: 0x742A, men: 1, ku: 88, ten: 8},   // 琪
+	{r: 0x742B, men: 1, ku: 88, ten: 9},   // 琫
+	{r: 0x742C, men: 1, ku: 88, ten: 10},  // 琬
+	{r: 0x742E, men: 1, ku: 88, ten: 11},  // 琮
+	{r: 0x742F, men: 1, ku: 88, ten: 12},  // 琯
+	{r: 0x7430, men: 1, ku: 88, ten: 13},  // 琰
+	{r: 0x7431, men: 2, ku: 80, ten: 79},  // 琱
+	{r: 0x7439, men: 2, ku: 80, ten: 80},  // 琹
+	{r: 0x7440, men: 2, ku: 80, ten: 82},  // 瑀
+	{r: 0x7443, men: 2, ku: 80, ten: 83},  // 瑃
+	{r: 0x7444, men: 1, ku: 88, ten: 14},  // 瑄
+	{r: 0x7446, men: 1, ku: 88, ten: 15},  // 瑆
+	{r: 0x7447, men: 1, ku: 88, ten: 16},  // 瑇
+	{r: 0x744B, men: 1, ku: 88, ten: 17},  // 瑋
+	{r: 0x744D, men: 2, ku: 80, ten: 84},  // 瑍
+	{r: 0x7452, men: 2, ku: 80, ten: 85},  // 瑒
+	{r: 0x7453, men: 2, ku: 80, ten: 81},  // 瑓
+	{r: 0x7457, men: 1, ku: 88, ten: 18},  // 瑗
+	{r: 0x745D, men: 2, ku: 80, ten: 86},  // 瑝
+	{r: 0x7462, men: 1, ku: 88, ten: 19},  // 瑢
+	{r: 0x746B, men: 1, ku: 88, ten: 20},  // 瑫
+	{r: 0x746D, men: 1, ku: 88, ten: 21},  // 瑭
+	{r: 0x7471, men: 2, ku: 80, ten: 87},  // 瑱
+	{r: 0x7481, men: 2, ku: 80, ten: 88},  // 璁
+	{r: 0x7485, men: 2, ku: 80, ten: 89},  // 璅
+	{r: 0x7486, men: 1, ku: 88, ten: 22},  // 璆
+	{r: 0x7487, men: 1, ku: 88, ten: 23},  // 璇
+	{r: 0x7488, men: 2, ku: 80, ten: 90},  // 璈
+	{r: 0x7489, men: 1, ku: 88, ten: 24},  // 璉
+	{r: 0x7490, men: 1, ku: 88, ten: 29},  // 璐
+	{r: 0x7492, men: 2, ku: 80, ten: 92},  // 璒
+	{r: 0x7497, men: 2, ku: 80, ten: 93},  // 璗
+	{r: 0x7498, men: 1, ku: 88, ten: 25},  // 璘
+	{r: 0x7499, men: 2, ku: 80, ten: 94},  // 璙
+	{r: 0x749C, men: 1, ku: 88, ten: 26},  // 璜
+	{r: 0x749F, men: 1, ku: 88, ten: 27},  // 璟
+	{r: 0x74A0, men: 2, ku: 81, ten: 1},   // 璠
+	{r: 0x74A1, men: 2, ku: 81, ten: 2},   // 璡
+	{r: 0x74A3, men: 1, ku: 88, ten: 28},  // 璣
+	{r: 0x74A5, men: 2, ku: 81, ten: 3},   // 璥
+	{r: 0x74A6, men: 1, ku: 88, ten: 30},  // 璦
+	{r: 0x74A8, men: 1, ku: 88, ten: 31},  // 璨
+	{r: 0x74A9, men: 1, ku: 88, ten: 32},  // 璩
+	{r: 0x74AA, men: 2, ku: 81, ten: 4},   // 璪
+	{r: 0x74AB, men: 2, ku: 81, ten: 5},   // 璫
+	{r: 0x74B5, men: 1, ku: 88, ten: 33},  // 璵
+	{r: 0x74B9, men: 2, ku: 81, ten: 6},   // 璹
+	{r: 0x74BA, men: 2, ku: 81, ten: 8},   // 璺
+	{r: 0x74BB, men: 2, ku: 81, ten: 7},   // 璻
+	{r: 0x74BF, men: 1, ku: 88, ten: 34},  // 璿
+	{r: 0x74C8, men: 1, ku: 88, ten: 35},  // 瓈
+	{r: 0x74C9, men: 1, ku: 88, ten: 36},  // 瓉
+	{r: 0x74D6, men: 2, ku: 81, ten: 9},   // 瓖
+	{r: 0x74D8, men: 2, ku: 81, ten: 10},  // 瓘
+	{r: 0x74DA, men: 1, ku: 88, ten: 37},  // 瓚
+	{r: 0x74DE, men: 2, ku: 81, ten: 11},  // 瓞
+	{r: 0x74EB, men: 2, ku: 81, ten: 13},  // 瓫
+	{r: 0x74EF, men: 2, ku: 81, ten: 12},  // 瓯
+	{r: 0x74FA, men: 2, ku: 81, ten: 15},  // 瓺
+	{r: 0x74FF, men: 1, ku: 88, ten: 38},  // 瓿
+	{r: 0x7501, men: 1, ku: 88, ten: 39},  // 甁
+	{r: 0x7517, men: 1, ku: 88, ten: 40},  // 甗
+	{r: 0x7520, men: 2, ku: 81, ten: 17},  // 甠
+	{r: 0x7524, men: 2, ku: 81, ten: 18},  // 甤
+	{r: 0x752A, men: 2, ku: 81, ten: 19},  // 甪
+	{r: 0x752F, men: 1, ku: 88, ten: 41},  // 甯
+	{r: 0x753D, men: 2, ku: 81, ten: 22},  // 甽
+	{r: 0x753E, men: 2, ku: 81, ten: 23},  // 甾
+	{r: 0x7540, men: 2, ku: 81, ten: 24},  // 畀
+	{r: 0x7548, men: 2, ku: 81, ten: 25},  // 畈
+	{r: 0x754E, men: 2, ku: 81, ten: 26},  // 畎
+	{r: 0x7550, men: 2, ku: 81, ten: 27},  // 畐
+	{r: 0x7552, men: 2, ku: 81, ten: 28},  // 畒
+	{r: 0x756C, men: 2, ku: 81, ten: 29},  // 畬
+	{r: 0x756F, men: 1, ku: 88, ten: 42},  // 畯
+	{r: 0x7571, men: 2, ku: 81, ten: 31},  // 畱
+	{r: 0x7572, men: 2, ku: 81, ten: 30},  // 畲
+	{r: 0x7579, men: 1, ku: 88, ten: 43},  // 畹
+	{r: 0x757A, men: 2, ku: 81, ten: 32},  // 畺
+	{r: 0x757D, men: 2, ku: 81, ten: 33},  // 畽
+	{r: 0x757E, men: 2, ku: 81, ten: 34},  // 畾
+	{r: 0x7581, men: 2, ku: 81, ten: 35},  // 疁
+	{r: 0x758C, men: 2, ku: 81, ten: 37},  // 疌
+	{r: 0x7592, men: 1, ku: 88, ten: 44},  // 疒
+	{r: 0x75A2, men: 2, ku: 81, ten: 39},  // 疢
+	{r: 0x75B0, men: 2, ku: 81, ten: 41},  // 疰
+	{r: 0x75B7, men: 2, ku: 81, ten: 42},  // 疷
+	{r: 0x75BF, men: 2, ku: 81, ten: 43},  // 疿
+	{r: 0x75C0, men: 2, ku: 81, ten: 44},  // 痀
+	{r: 0x75C6, men: 2, ku: 81, ten: 45},  // 痆
+	{r: 0x75CE, men: 1, ku: 88, ten: 46},  // 痎
+	{r: 0x75CF, men: 2, ku: 81, ten: 46},  // 痏
+	{r: 0x75D3, men: 2, ku: 81, ten: 47},  // 痓
+	{r: 0x75DD, men: 2, ku: 81, ten: 48},  // 痝
+	{r: 0x75DF, men: 2, ku: 81, ten: 49},  // 痟
+	{r: 0x75E0, men: 2, ku: 81, ten: 50},  // 痠
+	{r: 0x75E4, men: 1, ku: 88, ten: 47},  // 痤
+	{r: 0x75E7, men: 2, ku: 81, ten: 51},  // 痧
+	{r: 0x75EC, men: 2, ku: 81, ten: 52},  // 痬
+	{r: 0x75EE, men: 2, ku: 81, ten: 53},  // 痮
+	{r: 0x75F1, men: 2, ku: 81, ten: 54},  // 痱
+	{r: 0x75F9, men: 2, ku: 81, ten: 55},  // 痹
+	{r: 0x7600, men: 1, ku: 88, ten: 48},  // 瘀
+	{r: 0x7602, men: 1, ku: 88, ten: 49},  // 瘂
+	{r: 0x7603, men: 2, ku: 81, ten: 56},  // 瘃
+	{r: 0x7607, men: 2, ku: 81, ten: 58},  // 瘇
+	{r: 0x7608, men: 1, ku: 88, ten: 50},  // 瘈
+	{r: 0x760F, men: 2, ku: 81, ten: 59},  // 瘏
+	{r: 0x7613, men: 2, ku: 81, ten: 62},  // 瘓
+	{r: 0x7615, men: 1, ku: 88, ten: 51},  // 瘕
+	{r: 0x7616, men: 1, ku: 88, ten: 52},  // 瘖
+	{r: 0x7618, men: 2, ku: 81, ten: 57},  // 瘘
+	{r: 0x7619, men: 1, ku: 88, ten: 53},  // 瘙
+	{r: 0x761B, men: 2, ku: 81, ten: 63},  // 瘛
+	{r: 0x761C, men: 2, ku: 81, ten: 64},  // 瘜
+	{r: 0x761E, men: 1, ku: 88, ten: 54},  // 瘞
+	{r: 0x7625, men: 2, ku: 81, ten: 66},  // 瘥
+	{r: 0x7626, men: 1, ku: 94, ten: 93},  // 瘦
+	{r: 0x7628, men: 2, ku: 81, ten: 67},  // 瘨
+	{r: 0x762D, men: 1, ku: 88, ten: 55},  // 瘭
+	{r: 0x7633, men: 2, ku: 81, ten: 69},  // 瘳
+	{r: 0x7635, men: 1, ku: 88, ten: 56},  // 瘵
+	{r: 0x763C, men: 2, ku: 81, ten: 68},  // 瘼
+	{r: 0x7641, men: 2, ku: 81, ten: 72},  // 癁
+	{r: 0x7643, men: 1, ku: 88, ten: 57},  // 癃
+	{r: 0x7649, men: 2, ku: 81, ten: 74},  // 癉
+	{r: 0x764B, men: 1, ku: 88, ten: 58},  // 癋
+	{r: 0x7655, men: 2, ku: 81, ten: 75},  // 癕
+	{r: 0x7664, men: 1, ku: 88, ten: 59},  // 癤
+	{r: 0x7665, men: 1, ku: 88, ten: 60},  // 癥
+	{r: 0x766D, men: 1, ku: 88, ten: 61},  // 癭
+	{r: 0x766E, men: 2, ku: 81, ten: 77},  // 癮
+	{r: 0x766F, men: 1, ku: 88, ten: 62},  // 癯
+	{r: 0x7671, men: 1, ku: 88, ten: 63},  // 癱
+	{r: 0x7681, men: 1, ku: 88, ten: 64},  // 皁
+	{r: 0x7695, men: 2, ku: 81, ten: 78},  // 皕
+	{r: 0x769B, men: 1, ku: 88, ten: 65},  // 皛
+	{r: 0x769C, men: 2, ku: 81, ten: 79},  // 皜
+	{r: 0x769D, men: 1, ku: 88, ten: 66},  // 皝
+	{r: 0x769E, men: 1, ku: 88, ten: 67},  // 皞
+	{r: 0x76A0, men: 2, ku: 81, ten: 81},  // 皠
+	{r: 0x76A1, men: 2, ku: 81, ten: 80},  // 皡
+	{r: 0x76A6, men: 1, ku: 88, ten: 68},  // 皦
+	{r: 0x76A7, men: 2, ku: 81, ten: 82},  // 皧
+	{r: 0x76A8, men: 2, ku: 81, ten: 83},  // 皨
+	{r: 0x76AA, men: 1, ku: 88, ten: 69},  // 皪
+	{r: 0x76AF, men: 2, ku: 81, ten: 84},  // 皯
+	{r: 0x76B6, men: 1, ku: 88, ten: 70},  // 皶
+	{r: 0x76C5, men: 1, ku: 88, ten: 71},  // 盅
+	{r: 0x76C9, men: 2, ku: 81, ten: 86},  // 盉
+	{r: 0x76CC, men: 1, ku: 88, ten: 72},  // 盌
+	{r: 0x76CE, men: 1, ku: 88, ten: 73},  // 盎
+	{r: 0x76D4, men: 1, ku: 88, ten: 74},  // 盔
+	{r: 0x76E6, men: 1, ku: 88, ten: 75},  // 盦
+	{r: 0x76E8, men: 2, ku: 81, ten: 88},  // 盨
+	{r: 0x76EC, men: 2, ku: 81, ten: 89},  // 盬
+	{r: 0x76F1, men: 1, ku: 88, ten: 76},  // 盱
+	{r: 0x76FC, men: 1, ku: 88, ten: 77},  // 盼
+	{r: 0x770A, men: 1, ku: 88, ten: 78},  // 眊
+	{r: 0x7717, men: 2, ku: 81, ten: 91},  // 眗
+	{r: 0x7719, men: 1, ku: 88, ten: 79},  // 眙
+	{r: 0x771A, men: 2, ku: 81, ten: 92},  // 眚
+	{r: 0x772D, men: 2, ku: 81, ten: 93},  // 眭
+	{r: 0x7734, men: 1, ku: 88, ten: 80},  // 眴
+	{r: 0x7735, men: 2, ku: 81, ten: 94},  // 眵
+	{r: 0x7736, men: 1, ku: 88, ten: 81},  // 眶
+	{r: 0x7746, men: 1, ku: 88, ten: 82},  // 睆
+	{r: 0x774D, men: 1, ku: 88, ten: 83},  // 睍
+	{r: 0x774E, men: 1, ku: 88, ten: 84},  // 睎
+	{r: 0x7758, men: 2, ku: 82, ten: 5},   // 睘
+	{r: 0x775C, men: 1, ku: 88, ten: 85},  // 睜
+	{r: 0x775F, men: 1, ku: 88, ten: 86},  // 睟
+	{r: 0x7760, men: 2, ku: 82, ten: 6},   // 睠
+	{r: 0x7762, men: 1, ku: 88, ten: 87},  // 睢
+	{r: 0x776A, men: 2, ku: 82, ten: 7},   // 睪
+	{r: 0x7772, men: 2, ku: 82, ten: 9},   // 睲
+	{r: 0x777A, men: 1, ku: 88, ten: 88},  // 睺
+	{r: 0x777C, men: 2, ku: 82, ten: 10},  // 睼
+	{r: 0x777D, men: 2, ku: 82, ten: 11},  // 睽
+	{r: 0x7780, men: 1, ku: 88, ten: 89},  // 瞀
+	{r: 0x7794, men: 1, ku: 88, ten: 90},  // 瞔
+	{r: 0x779A, men: 2, ku: 82, ten: 14},  // 瞚
+	{r: 0x779F, men: 2, ku: 82, ten: 15},  // 瞟
+	{r: 0x77A2, men: 2, ku: 82, ten: 16},  // 瞢
+	{r: 0x77A4, men: 2, ku: 82, ten: 17},  // 瞤
+	{r: 0x77A9, men: 2, ku: 82, ten: 18},  // 瞩
+	{r: 0x77AA, men: 1, ku: 88, ten: 91},  // 瞪
+	{r: 0x77DE, men: 2, ku: 82, ten: 19},  // 矞
+	{r: 0x77DF, men: 2, ku: 82, ten: 20},  // 矟
+	{r: 0x77E0, men: 1, ku: 88, ten: 92},  // 矠
+	{r: 0x77E4, men: 2, ku: 82, ten: 21},  // 矤
+	{r: 0x77E6, men: 2, ku: 82, ten: 22},  // 矦
+	{r: 0x77EA, men: 2, ku: 82, ten: 23},  // 矪
+	{r: 0x77EC, men: 2, ku: 82, ten: 24},  // 矬
+	{r: 0x77F0, men: 2, ku: 82, ten: 26},  // 矰
+	{r: 0x77F4, men: 2, ku: 82, ten: 27},  // 矴
+	{r: 0x77FB, men: 2, ku: 82, ten: 28},  // 矻
+	{r: 0x7805, men: 2, ku: 82, ten: 30},  // 砅
+	{r: 0x7806, men: 2, ku: 82, ten: 31},  // 砆
+	{r: 0x7809, men: 2, ku: 82, ten: 32},  // 砉
+	{r: 0x780D, men: 2, ku: 82, ten: 33},  // 砍
+	{r: 0x7819, men: 2, ku: 82, ten: 34},  // 砙
+	{r: 0x7821, men: 2, ku: 82, ten: 35},  // 砡
+	{r: 0x782C, men: 2, ku: 82, ten: 36},  // 砬
+	{r: 0x782D, men: 1, ku: 88, ten: 93},  // 砭
+	{r: 0x7843, men: 1, ku: 89, ten: 1},   // 硃
+	{r: 0x7847, men: 2, ku: 82, ten: 37},  // 硇
+	{r: 0x784E, men: 1, ku: 89, ten: 2},   // 硎
+	{r: 0x784F, men: 1, ku: 89, ten: 3},   // 硏
+	{r: 0x7851, men: 1, ku: 89, ten: 4},   // 硑
+	{r: 0x7864, men: 2, ku: 82, ten: 38},  // 硤
+	{r: 0x7868, men: 1, ku: 89, ten: 5},   // 硨
+	{r: 0x786A, men: 2, ku: 82, ten: 39},  // 硪
+	{r: 0x786E, men: 1, ku: 89, ten: 6},   // 确
+	{r: 0x788A, men: 2, ku: 82, ten: 41},  // 碊
+	{r: 0x7894, men: 2, ku: 82, ten: 42},  // 碔
+	{r: 0x789D, men: 2, ku: 82, ten: 44},  // 碝
+	{r: 0x789E, men: 2, ku: 82, ten: 45},  // 碞
+	{r: 0x789F, men: 2, ku: 82, ten: 46},  // 碟
+	{r: 0x78A4, men: 2, ku: 82, ten: 43},  // 碤
+	{r: 0x78AD, men: 1, ku: 89, ten: 10},  // 碭
+	{r: 0x78B0, men: 1, ku: 89, ten: 8},   // 碰
+	{r: 0x78BB, men: 2, ku: 82, ten: 47},  // 碻
+	{r: 0x78C8, men: 2, ku: 82, ten: 48},  // 磈
+	{r: 0x78CC, men: 2, ku: 82, ten: 49},  // 磌
+	{r: 0x78CE, men: 2, ku: 82, ten: 50},  // 磎
+	{r: 0x78D5, men: 2, ku: 82, ten: 51},  // 磕
+	{r: 0x78E0, men: 2, ku: 82, ten: 52},  // 磠
+	{r: 0x78E1, men: 2, ku: 82, ten: 53},  // 磡
+	{r: 0x78E4, men: 1, ku: 89, ten: 11},  // 磤
+	{r: 0x78E6, men: 2, ku: 82, ten: 54},  // 磦
+	{r: 0x78F2, men: 1, ku: 89, ten: 12},  // 磲
+	{r: 0x78F7, men: 1, ku: 89, ten: 14},  // 磷
+	{r: 0x78F9, men: 2, ku: 82, ten: 55},  // 磹
+	{r: 0x78FA, men: 2, ku: 82, ten: 56},  // 磺
+	{r: 0x78FB, men: 2, ku: 82, ten: 57},  // 磻
+	{r: 0x78FE, men: 2, ku: 82, ten: 58},  // 磾
+	{r: 0x7900, men: 1, ku: 89, ten: 13},  // 礀
+	{r: 0x7910, men: 2, ku: 82, ten: 60},  // 礐
+	{r: 0x791B, men: 2, ku: 82, ten: 61},  // 礛
+	{r: 0x791C, men: 1, ku: 89, ten: 15},  // 礜
+	{r: 0x7925, men: 2, ku: 82, ten: 63},  // 礥
+	{r: 0x792E, men: 1, ku: 89, ten: 16},  // 礮
+	{r: 0x7930, men: 2, ku: 82, ten: 62},  // 礰
+	{r: 0x7931, men: 1, ku: 89, ten: 17},  // 礱
+	{r: 0x7934, men: 1, ku: 89, ten: 18},  // 礴
+	{r: 0x793B, men: 2, ku: 82, ten: 64},  // 礻
+	{r: 0x7945, men: 1, ku: 89, ten: 21},  // 祅
+	{r: 0x7946, men: 1, ku: 89, ten: 22},  // 祆
+	{r: 0x794A, men: 2, ku: 82, ten: 65},  // 祊
+	{r: 0x7958, men: 2, ku: 82, ten: 66},  // 祘
+	{r: 0x795B, men: 2, ku: 82, ten: 67},  // 祛
+	{r: 0x795C, men: 1, ku: 89, ten: 26},  // 祜
+	{r: 0x7967, men: 2, ku: 82, ten: 69},  // 祧
+	{r: 0x7972, men: 2, ku: 82, ten: 70},  // 祲
+	{r: 0x7979, men: 1, ku: 89, ten: 30},  // 祹
+	{r: 0x7994, men: 2, ku: 82, ten: 71},  // 禔
+	{r: 0x7995, men: 2, ku: 82, ten: 72},  // 禕
+	{r: 0x7996, men: 2, ku: 82, ten: 73},  // 禖
+	{r: 0x7998, men: 1, ku: 89, ten: 34},  // 禘
+	{r: 0x799B, men: 2, ku: 82, ten: 74},  // 禛
+	{r: 0x79A1, men: 2, ku: 82, ten: 75},  // 禡
+	{r: 0x79A9, men: 2, ku: 82, ten: 76},  // 禩
+	{r: 0x79B1, men: 1, ku: 89, ten: 35},  // 禱
+	{r: 0x79B4, men: 2, ku: 82, ten: 77},  // 禴
+	{r: 0x79B8, men: 1, ku: 89, ten: 36},  // 禸
+	{r: 0x79BB, men: 2, ku: 82, ten: 78},  // 离
+	{r: 0x79C2, men: 2, ku: 82, ten: 79},  // 秂
+	{r: 0x79C7, men: 2, ku: 82, ten: 80},  // 秇
+	{r: 0x79C8, men: 1, ku: 89, ten: 37},  // 秈
+	{r: 0x79CA, men: 1, ku: 89, ten: 38},  // 秊
+	{r: 0x79CC, men: 2, ku: 82, ten: 81},  // 秌
+	{r: 0x79CD, men: 2, ku: 82, ten: 82},  // 种
+	{r: 0x79D4, men: 1, ku: 89, ten: 40},  // 秔
+	{r: 0x79D6, men: 2, ku: 82, ten: 83},  // 秖
+	{r: 0x79DE, men: 1, ku: 89, ten: 41},  // 秞
+	{r: 0x79EB, men: 1, ku: 89, ten: 42},  // 秫
+	{r: 0x79ED, men: 1, ku: 89, ten: 43},  // 秭
+	{r: 0x7A03, men: 1, ku: 89, ten: 44},  // 稃
+	{r: 0x7A0A, men: 2, ku: 82, ten: 88},  // 稊
+	{r: 0x7A11, men: 2, ku: 82, ten: 89},  // 稑
+	{r: 0x7A15, men: 2, ku: 82, ten: 90},  // 稕
+	{r: 0x7A1B, men: 2, ku: 82, ten: 91},  // 稛
+	{r: 0x7A1E, men: 2, ku: 82, ten: 92},  // 稞
+	{r: 0x7A2D, men: 2, ku: 82, ten: 94},  // 稭
+	{r: 0x7A38, men: 2, ku: 83, ten: 1},   // 稸
+	{r: 0x7A39, men: 1, ku: 89, ten: 46},  // 稹
+	{r: 0x7A47, men: 2, ku: 83, ten: 2},   // 穇
+	{r: 0x7A4C, men: 2, ku: 83, ten: 3},   // 穌
+	{r: 0x7A56, men: 2, ku: 83, ten: 4},   // 穖
+	{r: 0x7A59, men: 2, ku: 83, ten: 5},   // 穙
+	{r: 0x7A5C, men: 2, ku: 83, ten: 6},   // 穜
+	{r: 0x7A5D, men: 1, ku: 89, ten: 47},  // 穝
+	{r: 0x7A5F, men: 2, ku: 83, ten: 7},   // 穟
+	{r: 0x7A60, men: 2, ku: 83, ten: 8},   // 穠
+	{r: 0x7A67, men: 2, ku: 83, ten: 9},   // 穧
+	{r: 0x7A6A, men: 2, ku: 83, ten: 10},  // 穪
+	{r: 0x7A6D, men: 1, ku: 89, ten: 48},  // 穭
+	{r: 0x7A75, men: 2, ku: 83, ten: 11},  // 穵
+	{r: 0x7A78, men: 2, ku: 83, ten: 12},  // 穸
+	{r: 0x7A82, men: 2, ku: 83, ten: 13},  // 窂
+	{r: 0x7A85, men: 1, ku: 89, ten: 50},  // 窅
+	{r: 0x7A8A, men: 2, ku: 83, ten: 14},  // 窊
+	{r: 0x7A90, men: 2, ku: 83, ten: 15},  // 窐
+	{r: 0x7AA0, men: 1, ku: 89, ten: 51},  // 窠
+	{r: 0x7AA3, men: 2, ku: 83, ten: 16},  // 窣
+	{r: 0x7AAC, men: 2, ku: 83, ten: 17},  // 窬
+	{r: 0x7AB3, men: 1, ku: 89, ten: 53},  // 窳
+	{r: 0x7AB9, men: 2, ku: 83, ten: 20},  // 窹
+	{r: 0x7ABB, men: 1, ku: 89, ten: 54},  // 窻
+	{r: 0x7ABC, men: 2, ku: 83, ten: 21},  // 窼
+	{r: 0x7ABE, men: 2, ku: 83, ten: 22},  // 窾
+	{r: 0x7ACC, men: 2, ku: 83, ten: 24},  // 竌
+	{r: 0x7ACE, men: 1, ku: 89, ten: 55},  // 竎
+	{r: 0x7AD1, men: 2, ku: 83, ten: 25},  // 竑
+	{r: 0x7AE7, men: 2, ku: 83, ten: 26},  // 竧
+	{r: 0x7AE8, men: 2, ku: 83, ten: 27},  // 竨
+	{r: 0x7AEB, men: 1, ku: 89, ten: 56},  // 竫
+	{r: 0x7AF4, men: 2, ku: 83, ten: 28},  // 竴
+	{r: 0x7AFD, men: 1, ku: 89, ten: 57},  // 竽
+	{r: 0x7B07, men: 2, ku: 83, ten: 31},  // 笇
+	{r: 0x7B12, men: 1, ku: 89, ten: 58},  // 笒
+	{r: 0x7B27, men: 2, ku: 83, ten: 34},  // 笧
+	{r: 0x7B2A, men: 2, ku: 83, ten: 35},  // 笪
+	{r: 0x7B2D, men: 1, ku: 89, ten: 59},  // 笭
+	{r: 0x7B2E, men: 2, ku: 83, ten: 36},  // 笮
+	{r: 0x7B2F, men: 2, ku: 83, ten: 37},  // 笯
+	{r: 0x7B31, men: 2, ku: 83, ten: 38},  // 笱
+	{r: 0x7B3B, men: 1, ku: 89, ten: 60},  // 笻
+	{r: 0x7B3D, men: 2, ku: 83, ten: 33},  // 笽
+	{r: 0x7B41, men: 2, ku: 83, ten: 42},  // 筁
+	{r: 0x7B47, men: 1, ku: 89, ten: 61},  // 筇
+	{r: 0x7B4E, men: 1, ku: 89, ten: 62},  // 筎
+	{r: 0x7B55, men: 2, ku: 83, ten: 44},  // 筕
+	{r: 0x7B60, men: 1, ku: 89, ten: 63},  // 筠
+	{r: 0x7B64, men: 2, ku: 83, ten: 46},  // 筤
+	{r: 0x7B66, men: 2, ku: 83, ten: 47},  // 筦
+	{r: 0x7B69, men: 2, ku: 83, ten: 48},  // 筩
+	{r: 0x7B6D, men: 1, ku: 89, ten: 64},  // 筭
+	{r: 0x7B6F, men: 1, ku: 89, ten: 65},  // 筯
+	{r: 0x7B72, men: 1, ku: 89, ten: 66},  // 筲
+	{r: 0x7B73, men: 2, ku: 83, ten: 49},  // 筳
+	{r: 0x7B79, men: 2, ku: 83, ten: 45},  // 筹
+	{r: 0x7B7F, men: 2, ku: 83, ten: 41},  // 筿
+	{r: 0x7B90, men: 2, ku: 83, ten: 52},  // 箐
+	{r: 0x7B91, men: 2, ku: 83, ten: 53},  // 箑
+	{r: 0x7B9B, men: 2, ku: 83, ten: 54},  // 箛
+	{r: 0x7B9E, men: 1, ku: 89, ten: 67},  // 箞
+	{r: 0x7BAF, men: 2, ku: 83, ten: 56},  // 箯
+	{r: 0x7BB5, men: 2, ku: 83, ten: 57},  // 箵
+	{r: 0x7BBC, men: 2, ku: 83, ten: 58},  // 箼
+	{r: 0x7BC5, men: 2, ku: 83, ten: 59},  // 篅
+	{r: 0x7BCA, men: 2, ku: 83, ten: 60},  // 篊
+	{r: 0x7BD4, men: 2, ku: 83, ten: 63},  // 篔
+	{r: 0x7BD6, men: 2, ku: 83, ten: 64},  // 篖
+	{r: 0x7BD7, men: 1, ku: 89, ten: 69},  // 篗
+	{r: 0x7BD9, men: 1, ku: 89, ten: 70},  // 篙
+	{r: 0x7BDA, men: 2, ku: 83, ten: 65},  // 篚
+	{r: 0x7BEA, men: 2, ku: 83, ten: 66},  // 篪
+	{r: 0x7BF0, men: 2, ku: 83, ten: 67},  // 篰
+	{r: 0x7C01, men: 1, ku: 89, ten: 71},  // 簁
+	{r: 0x7C03, men: 2, ku: 83, ten: 68},  // 簃
+	{r: 0x7C0B, men: 2, ku: 83, ten: 69},  // 簋
+	{r: 0x7C0E, men: 2, ku: 83, ten: 70},  // 簎
+	{r: 0x7C0F, men: 2, ku: 83, ten: 71},  // 簏
+	{r: 0x7C1E, men: 1, ku: 89, ten: 73},  // 簞
+	{r: 0x7C20, men: 1, ku: 89, ten: 74},  // 簠
+	{r: 0x7C26, men: 2, ku: 83, ten: 72},  // 簦
+	{r: 0x7C31, men: 1, ku: 89, ten: 72},  // 簱
+	{r: 0x7C33, men: 1, ku: 89, ten: 75},  // 簳
+	{r: 0x7C36, men: 1, ku: 89, ten: 76},  // 簶
+	{r: 0x7C45, men: 2, ku: 83, ten: 73},  // 籅
+	{r: 0x7C4A, men: 2, ku: 83, ten: 74},  // 籊
+	{r: 0x7C51, men: 2, ku: 83, ten: 75},  // 籑
+	{r: 0x7C57, men: 2, ku: 83, ten: 76},  // 籗
+	{r: 0x7C59, men: 1, ku: 89, ten: 79},  // 籙
+	{r: 0x7C5E, men: 2, ku: 83, ten: 77},  // 籞
+	{r: 0x7C61, men: 2, ku: 83, ten: 78},  // 籡
+	{r: 0x7C69, men: 2, ku: 83, ten: 79},  // 籩
+	{r: 0x7C6D, men: 1, ku: 89, ten: 80},  // 籭
+	{r: 0x7C6E, men: 2, ku: 83, ten: 80},  // 籮
+	{r: 0x7C6F, men: 2, ku: 83, ten: 81},  // 籯
+	{r: 0x7C70, men: 2, ku: 83, ten: 82},  // 籰
+	{r: 0x7C79, men: 1, ku: 89, ten: 81},  // 籹
+	{r: 0x7C8F, men: 1, ku: 89, ten: 82},  // 粏
+	{r: 0x7C94, men: 1, ku: 89, ten: 83},  // 粔
+	{r: 0x7CA0, men: 1, ku: 89, ten: 84},  // 粠
+	{r: 0x7CA6, men: 2, ku: 83, ten: 86},  // 粦
+	{r: 0x7CB6, men: 2, ku: 83, ten: 88},  // 粶
+	{r: 0x7CB7, men: 2, ku: 83, ten: 89},  // 粷
+	{r: 0x7CBC, men: 1, ku: 89, ten: 85},  // 粼
+	{r: 0x7CBF, men: 2, ku: 83, ten: 90},  // 粿
+	{r: 0x7CC4, men: 2, ku: 83, ten: 92},  // 糄
+	{r: 0x7CC8, men: 2, ku: 83, ten: 94},  // 糈
+	{r: 0x7CCD, men: 2, ku: 84, ten: 1},   // 糍
+	{r: 0x7CD5, men: 1, ku: 89, ten: 86},  // 糕
+	{r: 0x7CD7, men: 2, ku: 84, ten: 3},   // 糗
+	{r: 0x7CD9, men: 1, ku: 89, ten: 87},  // 糙
+	{r: 0x7CDD, men: 1, ku: 89, ten: 88},  // 糝
+	{r: 0x7CE6, men: 2, ku: 84, ten: 5},   // 糦
+	{r: 0x7CEB, men: 2, ku: 84, ten: 6},   // 糫
+	{r: 0x7CF5, men: 2, ku: 84, ten: 8},   // 糵
+	{r: 0x7D03, men: 2, ku: 84, ten: 9},   // 紃
+	{r: 0x7D07, men: 1, ku: 89, ten: 89},  // 紇
+	{r: 0x7D08, men: 1, ku: 89, ten: 90},  // 紈
+	{r: 0x7D09, men: 2, ku: 84, ten: 10},  // 紉
+	{r: 0x7D12, men: 2, ku: 84, ten: 12},  // 紒
+	{r: 0x7D13, men: 1, ku: 89, ten: 91},  // 紓
+	{r: 0x7D1D, men: 1, ku: 89, ten: 92},  // 紝
+	{r: 0x7D1E, men: 2, ku: 84, ten: 13},  // 紞
+	{r: 0x7D23, men: 1, ku: 89, ten: 93},  // 紣
+	{r: 0x7D31, men: 1, ku: 89, ten: 94},  // 紱
+	{r: 0x7D3D, men: 2, ku: 84, ten: 16},  // 紽
+	{r: 0x7D3E, men: 2, ku: 84, ten: 17},  // 紾
+	{r: 0x7D40, men: 2, ku: 84, ten: 18},  // 絀
+	{r: 0x7D41, men: 1, ku: 90, ten: 1},   // 絁
+	{r: 0x7D47, men: 2, ku: 84, ten: 19},  // 絇
+	{r: 0x7D48, men: 1, ku: 90, ten: 2},   // 絈
+	{r: 0x7D53, men: 1, ku: 90, ten: 3},   // 絓
+	{r: 0x7D59, men: 2, ku: 84, ten: 23},  // 絙
+	{r: 0x7D5A, men: 2, ku: 84, ten: 24},  // 絚
+	{r: 0x7D5C, men: 1, ku: 90, ten: 4},   // 絜
+	{r: 0x7D6A, men: 2, ku: 84, ten: 25},  // 絪
+	{r: 0x7D70, men: 2, ku: 84, ten: 26},  // 絰
+	{r: 0x7D7A, men: 1, ku: 90, ten: 5},   // 絺
+	{r: 0x7D7F, men: 2, ku: 84, ten: 28},  // 絿
+	{r: 0x7D83, men: 1, ku: 90, ten: 6},   // 綃
+	{r: 0x7D86, men: 2, ku: 84, ten: 30},  // 綆
+	{r: 0x7D88, men: 2, ku: 84, ten: 31},  // 綈
+	{r: 0x7D8B, men: 1, ku: 90, ten: 7},   // 綋
+	{r: 0x7D8C, men: 2, ku: 84, ten: 32},  // 綌
+	{r: 0x7D97, men: 2, ku: 84, ten: 33},  // 綗
+	{r: 0x7D9D, men: 2, ku: 84, ten: 35},  // 綝
+	{r: 0x7DA0, men: 1, ku: 90, ten: 8},   // 綠
+	{r: 0x7DA6, men: 1, ku: 90, ten: 9},   // 綦
+	{r: 0x7DA7, men: 2, ku: 84, ten: 36},  // 綧
+	{r: 0x7DAA, men: 2, ku: 84, ten: 37},  // 綪
+	{r: 0x7DB6, men: 2, ku: 84, ten: 38},  // 綶
+	{r: 0x7DB7, men: 2, ku: 84, ten: 39},  // 綷
+	{r: 0x7DC0, men: 2, ku: 84, ten: 40},  // 緀
+	{r: 0x7DC2, men: 1, ku: 90, ten: 10},  // 緂
+	{r: 0x7DCC, men: 1, ku: 90, ten: 11},  // 緌
+	{r: 0x7DD6, men: 1, ku: 90, ten: 12},  // 緖
+	{r: 0x7DD7, men: 2, ku: 84, ten: 41},  // 緗
+	{r: 0x7DD9, men: 2, ku: 84, ten: 42},  // 緙
+	{r: 0x7DE3, men: 1, ku: 90, ten: 13},  // 緣
+	{r: 0x7DE6, men: 2, ku: 84, ten: 43},  // 緦
+	{r: 0x7DF1, men: 2, ku: 84, ten: 44},  // 緱
+	{r: 0x7DF9, men: 2, ku: 84, ten: 45},  // 緹
+	{r: 0x7E08, men: 1, ku: 90, ten: 16},  // 縈
+	{r: 0x7E10, men: 2, ku: 84, ten: 49},  // 縐
+	{r: 0x7E11, men: 1, ku: 90, ten: 17},  // 縑
+	{r: 0x7E15, men: 1, ku: 90, ten: 18},  // 縕
+	{r: 0x7E17, men: 2, ku: 84, ten: 50},  // 縗
+	{r: 0x7E1D, men: 2, ku: 84, ten: 51},  // 縝
+	{r: 0x7E20, men: 2, ku: 84, ten: 52},  // 縠
+	{r: 0x7E27, men: 2, ku: 84, ten: 53},  // 縧
+	{r: 0x7E28, men: 1, ku: 90, ten: 15},  // 縨
+	{r: 0x7E2C, men: 2, ku: 84, ten: 54},  // 縬
+	{r: 0x7E45, men: 2, ku: 84, ten: 55},  // 繅
+	{r: 0x7E47, men: 1, ku: 90, ten: 20},  // 繇
+	{r: 0x7E52, men: 1, ku: 90, ten: 21},  // 繒
+	{r: 0x7E61, men: 1, ku: 90, ten: 22},  // 繡
+	{r: 0x7E6B, men: 1, ku: 94, ten: 94},  // 繫
+	{r: 0x7E73, men: 2, ku: 84, ten: 56},  // 繳
+	{r: 0x7E75, men: 2, ku: 84, ten: 57},  // 繵
+	{r: 0x7E7E, men: 2, ku: 84, ten: 58},  // 繾
+	{r: 0x7E86, men: 2, ku: 84, ten: 59},  // 纆
+	{r: 0x7E87, men: 2, ku: 84, ten: 60},  // 纇
+	{r: 0x7E8A, men: 1, ku: 90, ten: 23},  // 纊
+	{r: 0x7E8D, men: 1, ku: 90, ten: 24},  // 纍
+	{r: 0x7E91, men: 2, ku: 84, ten: 62},  // 纑
+	{r: 0x7E98, men: 2, ku: 84, ten: 63},  // 纘
+	{r: 0x7E9A, men: 2, ku: 84, ten: 64},  // 纚
+	{r: 0x7F3B, men: 2, ku: 84, ten: 67},  // 缻
+	{r: 0x7F3C, men: 2, ku: 84, ten: 66},  // 缼
+	{r: 0x7F3E, men: 2, ku: 84, ten: 68},  // 缾
+	{r: 0x7F43, men: 2, ku: 84, ten: 69},  // 罃
+	{r: 0x7F44, men: 2, ku: 84, ten: 70},  // 罄
+	{r: 0x7F47, men: 1, ku: 90, ten: 25},  // 罇
+	{r: 0x7F4F, men: 2, ku: 84, ten: 71},  // 罏
+	{r: 0x7F52, men: 2, ku: 84, ten: 74},  // 罒
+	{r: 0x7F61, men: 2, ku: 84, ten: 76},  // 罡
+	{r: 0x7F63, men: 2, ku: 84, ten: 77},  // 罣
+	{r: 0x7F64, men: 2, ku: 84, ten: 78},  // 罤
+	{r: 0x7F6D, men: 2, ku: 84, ten: 79},  // 罭
+	{r: 0x7F7D, men: 2, ku: 84, ten: 80},  // 罽
+	{r: 0x7F7E, men: 2, ku: 84, ten: 81},  // 罾
+	{r: 0x7F90, men: 2, ku: 84, ten: 83},  // 羐
+	{r: 0x7F91, men: 1, ku: 90, ten: 27},  // 羑
+	{r: 0x7F96, men: 2, ku: 84, ten: 86},  // 羖
+	{r: 0x7F97, men: 1, ku: 90, ten: 28},  // 羗
+	{r: 0x7F9C, men: 2, ku: 84, ten: 87},  // 羜
+	{r: 0x7FAD, men: 2, ku: 84, ten: 88},  // 羭
+	{r: 0x7FBF, men: 1, ku: 90, ten: 29},  // 羿
+	{r: 0x7FC3, men: 2, ku: 84, ten: 90},  // 翃
+	{r: 0x7FCE, men: 1, ku: 90, ten: 30},  // 翎
+	{r: 0x7FCF, men: 2, ku: 84, ten: 91},  // 翏
+	{r: 0x7FDB, men: 1, ku: 90, ten: 31},  // 翛
+	{r: 0x7FDF, men: 1, ku: 90, ten: 32},  // 翟
+	{r: 0x7FE3, men: 2, ku: 84, ten: 92},  // 翣
+	{r: 0x7FE5, men: 2, ku: 84, ten: 93},  // 翥
+	{r: 0x7FEC, men: 1, ku: 90, ten: 33},  // 翬
+	{r: 0x7FEE, men: 1, ku: 90, ten: 34},  // 翮
+	{r: 0x7FEF, men: 2, ku: 84, ten: 94},  // 翯
+	{r: 0x7FF2, men: 2, ku: 85, ten: 1},   // 翲
+	{r: 0x7FFA, men: 1, ku: 90, ten: 35},  // 翺
+	{r: 0x8002, men: 2, ku: 85, ten: 2},   // 耂
+	{r: 0x8008, men: 2, ku: 85, ten: 4},   // 耈
+	{r: 0x800A, men: 2, ku: 85, ten: 3},   // 耊
+	{r: 0x800E, men: 2, ku: 85, ten: 5},   // 耎
+	{r: 0x8011, men: 2, ku: 85, ten: 6},   // 耑
+	{r: 0x8014, men: 1, ku: 90, ten: 37},  // 耔
+	{r: 0x8016, men: 2, ku: 85, ten: 7},   // 耖
+	{r: 0x8024, men: 2, ku: 85, ten: 8},   // 耤
+	{r: 0x8026, men: 1, ku: 90, ten: 38},  // 耦
+	{r: 0x802C, men: 2, ku: 85, ten: 9},   // 耬
+	{r: 0x8030, men: 2, ku: 85, ten: 10},  // 耰
+	{r: 0x8035, men: 1, ku: 90, ten: 39},  // 耵
+	{r: 0x8037, men: 1, ku: 90, ten: 40},  // 耷
+	{r: 0x803C, men: 1, ku: 90, ten: 41},  // 耼
+	{r: 0x8043, men: 2, ku: 85, ten: 11},  // 聃
+	{r: 0x8066, men: 2, ku: 85, ten: 12},  // 聦
+	{r: 0x8071, men: 2, ku: 85, ten: 13},  // 聱
+	{r: 0x8075, men: 2, ku: 85, ten: 14},  // 聵
+	{r: 0x807B, men: 2, ku: 85, ten: 15},  // 聻
+	{r: 0x8099, men: 2, ku: 85, ten: 16},  // 肙
+	{r: 0x809C, men: 2, ku: 85, ten: 17},  // 肜
+	{r: 0x80A4, men: 2, ku: 85, ten: 18},  // 肤
+	{r: 0x80A7, men: 2, ku: 85, ten: 19},  // 肧
+	{r: 0x80B8, men: 2, ku: 85, ten: 20},  // 肸
+	{r: 0x80C5, men: 2, ku: 85, ten: 22},  // 胅
+	{r: 0x80CA, men: 1, ku: 90, ten: 42},  // 胊
+	{r: 0x80D5, men: 2, ku: 85, ten: 23},  // 胕
+	{r: 0x80D7, men: 1, ku: 90, ten: 43},  // 胗
+	{r: 0x80D8, men: 2, ku: 85, ten: 24},  // 胘
+	{r: 0x80E0, men: 1, ku: 90, ten: 44},  // 胠
+	{r: 0x80E6, men: 2, ku: 85, ten: 25},  // 胦
+	{r: 0x80F3, men: 1, ku: 90, ten: 45},  // 胳
+	{r: 0x80F5, men: 2, ku: 85, ten: 28},  // 胵
+	{r: 0x80FB, men: 2, ku: 85, ten: 29},  // 胻
+	{r: 0x810D, men: 2, ku: 85, ten: 27},  // 脍
+	{r: 0x8116, men: 2, ku: 85, ten: 32},  // 脖
+	{r: 0x8118, men: 1, ku: 90, ten: 46},  // 脘
+	{r: 0x811E, men: 2, ku: 85, ten: 33},  // 脞
+	{r: 0x8124, men: 2, ku: 85, ten: 35},  // 脤
+	{r: 0x8127, men: 2, ku: 85, ten: 36},  // 脧
+	{r: 0x812C, men: 2, ku: 85, ten: 37},  // 脬
+	{r: 0x8135, men: 2, ku: 85, ten: 31},  // 脵
+	{r: 0x813D, men: 2, ku: 85, ten: 39},  // 脽
+	{r: 0x814A, men: 1, ku: 90, ten: 47},  // 腊
+	{r: 0x8160, men: 1, ku: 90, ten: 48},  // 腠
+	{r: 0x8167, men: 1, ku: 90, ten: 49},  // 腧
+	{r: 0x8168, men: 1, ku: 90, ten: 50},  // 腨
+	{r: 0x8169, men: 2, ku: 85, ten: 41},  // 腩
+	{r: 0x816D, men: 1, ku: 90, ten: 51},  // 腭
+	{r: 0x8181, men: 2, ku: 85, ten: 43},  // 膁
+	{r: 0x8184, men: 2, ku: 85, ten: 45},  // 膄
+	{r: 0x8185, men: 2, ku: 85, ten: 46},  // 膅
+	{r: 0x8198, men: 2, ku: 85, ten: 48},  // 膘
+	{r: 0x81B2, men: 2, ku: 85, ten: 49},  // 膲
+	{r: 0x81BB, men: 1, ku: 90, ten: 52},  // 膻
+	{r: 0x81C1, men: 2, ku: 85, ten: 50},  // 臁
+	{r: 0x81C3, men: 2, ku: 85, ten: 51},  // 臃
+	{r: 0x81CA, men: 1, ku: 90, ten: 53},  // 臊
+	{r: 0x81CF, men: 1, ku: 90, ten: 54},  // 臏
+	{r: 0x81D6, men: 2, ku: 85, ten: 52},  // 臖
+	{r: 0x81D7, men: 1, ku: 90, ten: 55},  // 臗
+	{r: 0x81DB, men: 2, ku: 85, ten: 53},  // 臛
+	{r: 0x81E4, men: 2, ku: 85, ten: 55},  // 臤
+	{r: 0x81EC, men: 2, ku: 85, ten: 57},  // 臬
+	{r: 0x81FD, men: 2, ku: 85, ten: 59},  // 臽
+	{r: 0x81FF, men: 2, ku: 85, ten: 60},  // 臿
+	{r: 0x8204, men: 2, ku: 85, ten: 62},  // 舄
+	{r: 0x8219, men: 2, ku: 85, ten: 64},  // 舙
+	{r: 0x8221, men: 2, ku: 85, ten: 65},  // 舡
+	{r: 0x8222, men: 2, ku: 85, ten: 66},  // 舢
+	{r: 0x8232, men: 2, ku: 85, ten: 68},  // 舲
+	{r: 0x8234, men: 2, ku: 85, ten: 69},  // 舴
+	{r: 0x823C, men: 2, ku: 85, ten: 70},  // 舼
+	{r: 0x8245, men: 2, ku: 85, ten: 73},  // 艅
+	{r: 0x8246, men: 2, ku: 85, ten: 71},  // 艆
+	{r: 0x8249, men: 2, ku: 85, ten: 72},  // 艉
+	{r: 0x824B, men: 2, ku: 85, ten: 75},  // 艋
+	{r: 0x824F, men: 2, ku: 85, ten: 77},  // 艏
+	{r: 0x8257, men: 2, ku: 85, ten: 79},  // 艗
+	{r: 0x825C, men: 2, ku: 85, ten: 81},  // 艜
+	{r: 0x8260, men: 1, ku: 90, ten: 59},  // 艠
+	{r: 0x8263, men: 2, ku: 85, ten: 82},  // 艣
+	{r: 0x8274, men: 1, ku: 90, ten: 60},  // 艴
+	{r: 0x8279, men: 2, ku: 85, ten: 86},  // 艹
+	{r: 0x827D, men: 2, ku: 85, ten: 88},  // 艽
+	{r: 0x827F, men: 2, ku: 85, ten: 89},  // 艿
+	{r: 0x8283, men: 2, ku: 85, ten: 90},  // 芃
+	{r: 0x828A, men: 2, ku: 85, ten: 91},  // 芊
+	{r: 0x828E, men: 1, ku: 90, ten: 62},  // 芎
+	{r: 0x8293, men: 2, ku: 85, ten: 92},  // 芓
+	{r: 0x82A1, men: 1, ku: 90, ten: 63},  // 芡
+	{r: 0x82A3, men: 1, ku: 90, ten: 64},  // 芣
+	{r: 0x82A4, men: 1, ku: 90, ten: 65},  // 芤
+	{r: 0x82A7, men: 2, ku: 85, ten: 93},  // 芧
+	{r: 0x82A8, men: 2, ku: 85, ten: 94},  // 芨
+	{r: 0x82A9, men: 1, ku: 90, ten: 66},  // 芩
+	{r: 0x82AE, men: 1, ku: 90, ten: 67},  // 芮
+	{r: 0x82B2, men: 2, ku: 86, ten: 1},   // 芲
+	{r: 0x82B4, men: 2, ku: 86, ten: 2},   // 芴
+	{r: 0x82B7, men: 1, ku: 90, ten: 68},  // 芷
+	{r: 0x82BA, men: 2, ku: 86, ten: 3},   // 芺
+	{r: 0x82BC, men: 2, ku: 86, ten: 4},   // 芼
+	{r: 0x82BE, men: 1, ku: 90, ten: 69},  // 芾
+	{r: 0x82BF, men: 1, ku: 90, ten: 70},  // 芿
+	{r: 0x82C6, men: 1, ku: 90, ten: 71},  // 苆
+	{r: 0x82D5, men: 1, ku: 90, ten: 72},  // 苕
+	{r: 0x82E2, men: 2, ku: 86, ten: 5},   // 苢
+	{r: 0x82E8, men: 2, ku: 86, ten: 6},   // 苨
+	{r: 0x82F7, men: 2, ku: 86, ten: 7},   // 苷
+	{r: 0x82FD, men: 1, ku: 90, ten: 73},  // 苽
+	{r: 0x82FE, men: 1, ku: 90, ten: 74},  // 苾
+	{r: 0x8300, men: 1, ku: 90, ten: 75},  // 茀
+	{r: 0x8301, men: 1, ku: 90, ten: 76},  // 茁
+	{r: 0x8307, men: 2, ku: 86, ten: 8},   // 茇
+	{r: 0x8308, men: 2, ku: 86, ten: 9},   // 茈
+	{r: 0x830C, men: 2, ku: 86, ten: 10},  // 茌
+	{r: 0x831B, men: 2, ku: 86, ten: 12},  // 茛
+	{r: 0x831D, men: 2, ku: 86, ten: 13},  // 茝
+	{r: 0x8322, men: 1, ku: 90, ten: 78},  // 茢
+	{r: 0x832D, men: 1, ku: 90, ten: 79},  // 茭
+	{r: 0x8330, men: 2, ku: 86, ten: 14},  // 茰
+	{r: 0x833A, men: 1, ku: 90, ten: 80},  // 茺
+	{r: 0x833C, men: 2, ku: 86, ten: 15},  // 茼
+	{r: 0x8343, men: 1, ku: 90, ten: 81},  // 荃
+	{r: 0x8344, men: 2, ku: 86, ten: 16},  // 荄
+	{r: 0x8347, men: 1, ku: 90, ten: 82},  // 荇
+	{r: 0x8351, men: 1, ku: 90, ten: 83},  // 荑
+	{r: 0x8354, men: 2, ku: 86, ten: 11},  // 荔
+	{r: 0x8355, men: 1, ku: 90, ten: 84},  // 荕
+	{r: 0x8357, men: 2, ku: 86, ten: 17},  // 荗
+	{r: 0x8362, men: 1, ku: 90, ten: 77},  // 荢
+	{r: 0x8363, men: 2, ku: 14, ten: 42},  // 荣
+	{r: 0x837D, men: 1, ku: 90, ten: 85},  // 荽
+	{r: 0x837F, men: 2, ku: 86, ten: 19},  // 荿
+	{r: 0x8386, men: 1, ku: 90, ten: 86},  // 莆
+	{r: 0x838D, men: 2, ku: 86, ten: 22},  // 莍
+	{r: 0x8392, men: 1, ku: 90, ten: 87},  // 莒
+	{r: 0x8394, men: 2, ku: 86, ten: 23},  // 莔
+	{r: 0x8395, men: 2, ku: 86, ten: 24},  // 莕
+	{r: 0x8398, men: 1, ku: 90, ten: 88},  // 莘
+	{r: 0x839B, men: 2, ku: 86, ten: 25},  // 莛
+	{r: 0x839D, men: 2, ku: 86, ten: 26},  // 莝
+	{r: 0x83A7, men: 1, ku: 90, ten: 89},  // 莧
+	{r: 0x83A9, men: 1, ku: 90, ten: 90},  // 莩
+	{r: 0x83BF, men: 1, ku: 90, ten: 91},  // 莿
+	{r: 0x83C0, men: 1, ku: 90, ten: 92},  // 菀
+	{r: 0x83C7, men: 1, ku: 90, ten: 93},  // 菇
+	{r: 0x83C9, men: 2, ku: 86, ten: 27},  // 菉
+	{r: 0x83CF, men: 1, ku: 90, ten: 94},  // 菏
+	{r: 0x83D0, men: 2, ku: 86, ten: 28},  // 菐
+	{r: 0x83D1, men: 1, ku: 91, ten: 1},   // 菑
+	{r: 0x83D4, men: 2, ku: 86, ten: 29},  // 菔
+	{r: 0x83DD, men: 2, ku: 86, ten: 30},  // 菝
+	{r: 0x83E1, men: 1, ku: 91, ten: 2},   // 菡
+	{r: 0x83E5, men: 2, ku: 86, ten: 31},  // 菥
+	{r: 0x83EA, men: 1, ku: 91, ten: 3},   // 菪
+	{r: 0x83F9, men: 2, ku: 86, ten: 32},  // 菹
+	{r: 0x8401, men: 1, ku: 91, ten: 4},   // 萁
+	{r: 0x8406, men: 1, ku: 91, ten: 5},   // 萆
+	{r: 0x840A, men: 1, ku: 91, ten: 6},   // 萊
+	{r: 0x840F, men: 2, ku: 86, ten: 33},  // 萏
+	{r: 0x8411, men: 2, ku: 86, ten: 34},  // 萑
+	{r: 0x8415, men: 2, ku: 86, ten: 35},  // 萕
+	{r: 0x8417, men: 2, ku: 86, ten: 37},  // 萗
+	{r: 0x8439, men: 2, ku: 86, ten: 38},  // 萹
+	{r: 0x8448, men: 1, ku: 91, ten: 8},   // 葈
+	{r: 0x844A, men: 2, ku: 86, ten: 39},  // 葊
+	{r: 0x844F, men: 2, ku: 86, ten: 40},  // 葏
+	{r: 0x8451, men: 2, ku: 86, ten: 41},  // 葑
+	{r: 0x8452, men: 2, ku: 86, ten: 42},  // 葒
+	{r: 0x8459, men: 2, ku: 86, ten: 43},  // 葙
+	{r: 0x845A, men: 2, ku: 86, ten: 44},  // 葚
+	{r: 0x845C, men: 2, ku: 86, ten: 45},  // 葜
+	{r: 0x845F, men: 1, ku: 91, ten: 9},   // 葟
+	{r: 0x8465, men: 2, ku: 86, ten: 47},  // 葥
+	{r: 0x8470, men: 1, ku: 91, ten: 10},  // 葰
+	{r: 0x8473, men: 1, ku: 91, ten: 11},  // 葳
+	{r: 0x8476, men: 2, ku: 86, ten: 48},  // 葶
+	{r: 0x8478, men: 2, ku: 86, ten: 49},  // 葸
+	{r: 0x847C, men: 2, ku: 86, ten: 50},  // 葼
+	{r: 0x8481, men: 2, ku: 86, ten: 51},  // 蒁
+	{r: 0x8485, men: 1, ku: 91, ten: 12},  // 蒅
+	{r: 0x8497, men: 2, ku: 86, ten: 54},  // 蒗
+	{r: 0x849E, men: 1, ku: 91, ten: 13},  // 蒞
+	{r: 0x84A6, men: 2, ku: 86, ten: 55},  // 蒦
+	{r: 0x84AF, men: 1, ku: 91, ten: 14},  // 蒯
+	{r: 0x84B4, men: 1, ku: 91, ten: 15},  // 蒴
+	{r: 0x84BA, men: 1, ku: 91, ten: 16},  // 蒺
+	{r: 0x84BE, men: 2, ku: 86, ten: 56},  // 蒾
+	{r: 0x84C0, men: 1, ku: 91, ten: 17},  // 蓀
+	{r: 0x84C2, men: 1, ku: 91, ten: 18},  // 蓂
+	{r: 0x84CE, men: 2, ku: 86, ten: 58},  // 蓎
+	{r: 0x84CF, men: 2, ku: 86, ten: 59},  // 蓏
+	{r: 0x84D3, men: 2, ku: 86, ten: 60},  // 蓓
+	{r: 0x84DC, men: 2, ku: 86, ten: 53},  // 蓜
+	{r: 0x84E7, men: 2, ku: 86, ten: 62},  // 蓧
+	{r: 0x84EA, men: 2, ku: 86, ten: 63},  // 蓪
+	{r: 0x84EF, men: 2, ku: 86, ten: 64},  // 蓯
+	{r: 0x84F0, men: 2, ku: 86, ten: 65},  // 蓰
+	{r: 0x84F1, men: 2, ku: 86, ten: 66},  // 蓱
+	{r: 0x84FA, men: 2, ku: 86, ten: 67},  // 蓺
+	{r: 0x84FD, men: 2, ku: 86, ten: 68},  // 蓽
+	{r: 0x850C, men: 2, ku: 86, ten: 69},  // 蔌
+	{r: 0x851B, men: 2, ku: 86, ten: 70},  // 蔛
+	{r: 0x851E, men: 1, ku: 91, ten: 21},  // 蔞
+	{r: 0x8523, men: 1, ku: 91, ten: 22},  // 蔣
+	{r: 0x8524, men: 2, ku: 86, ten: 71},  // 蔤
+	{r: 0x8525, men: 2, ku: 86, ten: 72},  // 蔥
+	{r: 0x852B, men: 2, ku: 86, ten: 73},  // 蔫
+	{r: 0x852F, men: 1, ku: 91, ten: 23},  // 蔯
+	{r: 0x8532, men: 1, ku: 91, ten: 20},  // 蔲
+	{r: 0x8534, men: 2, ku: 86, ten: 74},  // 蔴
+	{r: 0x853E, men: 2, ku: 86, ten: 79},  // 蔾
+	{r: 0x854F, men: 2, ku: 86, ten: 75},  // 蕏
+	{r: 0x8551, men: 2, ku: 86, ten: 80},  // 蕑
+	{r: 0x8553, men: 2, ku: 86, ten: 81},  // 蕓
+	{r: 0x8559, men: 1, ku: 91, ten: 24},  // 蕙
+	{r: 0x855E, men: 2, ku: 86, ten: 82},  // 蕞
+	{r: 0x8561, men: 2, ku: 86, ten: 83},  // 蕡
+	{r: 0x8562, men: 2, ku: 86, ten: 84},  // 蕢
+	{r: 0x8564, men: 1, ku: 91, ten: 25},  // 蕤
+	{r: 0x856F, men: 2, ku: 86, ten: 76},  // 蕯
+	{r: 0x857A, men: 1, ku: 91, ten: 28},  // 蕺
+	{r: 0x857B, men: 2, ku: 86, ten: 86},  // 蕻
+	{r: 0x857D, men: 2, ku: 86, ten: 87},  // 蕽
+	{r: 0x857F, men: 2, ku: 86, ten: 88},  // 蕿
+	{r: 0x8581, men: 2, ku: 86, ten: 89},  // 薁
+	{r: 0x8586, men: 2, ku: 86, ten: 90},  // 薆
+	{r: 0x858C, men: 1, ku: 91, ten: 29},  // 薌
+	{r: 0x858F, men: 1, ku: 91, ten: 30},  // 薏
+	{r: 0x8593, men: 2, ku: 86, ten: 91},  // 薓
+	{r: 0x859D, men: 2, ku: 86, ten: 92},  // 薝
+	{r: 0x859F, men: 2, ku: 86, ten: 93},  // 薟
+	{r: 0x85A2, men: 1, ku: 91, ten: 31},  // 薢
+	{r: 0x85AD, men: 1, ku: 91, ten: 27},  // 薭
+	{r: 0x85B0, men: 1, ku: 91, ten: 32},  // 薰
+	{r: 0x85B7, men: 2, ku: 87, ten: 3},   // 薷
+	{r: 0x85BC, men: 2, ku: 87, ten: 4},   // 薼
+	{r: 0x85C7, men: 2, ku: 87, ten: 5},   // 藇
+	{r: 0x85CA, men: 2, ku: 87, ten: 6},   // 藊
+	{r: 0x85CB, men: 1, ku: 91, ten: 33},  // 藋
+	{r: 0x85CE, men: 1, ku: 91, ten: 34},  // 藎
+	{r: 0x85D8, men: 2, ku: 87, ten: 7},   // 藘
+	{r: 0x85D9, men: 2, ku: 87, ten: 8},   // 藙
+	{r: 0x85DF, men: 2, ku: 87, ten: 9},   // 藟
+	{r: 0x85E1, men: 2, ku: 87, ten: 10},  // 藡
+	{r: 0x85E6, men: 2, ku: 87, ten: 11},  // 藦
+	{r: 0x85ED, men: 1, ku: 91, ten: 35},  // 藭
+	{r: 0x85F6, men: 2, ku: 87, ten: 12},  // 藶
+	{r: 0x85FF, men: 1, ku: 91, ten: 37},  // 藿
+	{r: 0x8600, men: 2, ku: 87, ten: 13},  // 蘀
+	{r: 0x8604, men: 1, ku: 91, ten: 38},  // 蘄
+	{r: 0x8605, men: 1, ku: 91, ten: 39},  // 蘅
+	{r: 0x8610, men: 1, ku: 91, ten: 40},  // 蘐
+	{r: 0x8611, men: 2, ku: 87, ten: 14},  // 蘑
+	{r: 0x8612, men: 1, ku: 91, ten: 36},  // 蘒
+	{r: 0x8618, men: 1, ku: 91, ten: 42},  // 蘘
+	{r: 0x861E, men: 2, ku: 87, ten: 15},  // 蘞
+	{r: 0x8621, men: 2, ku: 87, ten: 16},  // 蘡
+	{r: 0x8624, men: 2, ku: 87, ten: 17},  // 蘤
+	{r: 0x8627, men: 2, ku: 87, ten: 18},  // 蘧
+	{r: 0x8629, men: 1, ku: 91, ten: 43},  // 蘩
+	{r: 0x8638, men: 1, ku: 91, ten: 44},  // 蘸
+	{r: 0x8639, men: 2, ku: 87, ten: 20},  // 蘹
+	{r: 0x863C, men: 2, ku: 87, ten: 21},  // 蘼
+	{r: 0x8640, men: 2, ku: 87, ten: 23},  // 虀
+	{r: 0x8641, men: 1, ku: 15, ten: 72},  // 虁
+	{r: 0x8653, men: 2, ku: 87, ten: 25},  // 虓
+	{r: 0x8656, men: 2, ku: 87, ten: 26},  // 虖
+	{r: 0x8657, men: 1, ku: 91, ten: 45},  // 虗
+	{r: 0x865B, men: 1, ku: 91, ten: 46},  // 虛
+	{r: 0x8662, men: 1, ku: 91, ten: 48},  // 虢
+	{r: 0x866C, men: 1, ku: 91, ten: 50},  // 虬
+	{r: 0x866F, men: 2, ku: 87, ten: 27},  // 虯
+	{r: 0x8675, men: 1, ku: 91, ten: 51},  // 虵
+	{r: 0x8677, men: 2, ku: 87, ten: 28},  // 虷
+	{r: 0x867A, men: 2, ku: 87, ten: 29},  // 虺
+	{r: 0x8687, men: 2, ku: 87, ten: 30},  // 蚇
+	{r: 0x8689, men: 2, ku: 87, ten: 31},  // 蚉
+	{r: 0x868D, men: 2, ku: 87, ten: 32},  // 蚍
+	{r: 0x8691, men: 2, ku: 87, ten: 33},  // 蚑
+	{r: 0x8698, men: 1, ku: 91, ten: 52},  // 蚘
+	{r: 0x869C, men: 2, ku: 87, ten: 34},  // 蚜
+	{r: 0x869D, men: 2, ku: 87, ten: 35},  // 蚝
+	{r: 0x86A8, men: 2, ku: 87, ten: 36},  // 蚨
+	{r: 0x86B1, men: 2, ku: 87, ten: 38},  // 蚱
+	{r: 0x86B3, men: 2, ku: 87, ten: 39},  // 蚳
+	{r: 0x86B8, men: 1, ku: 91, ten: 53},  // 蚸
+	{r: 0x86C1, men: 2, ku: 87, ten: 40},  // 蛁
+	{r: 0x86C3, men: 2, ku: 87, ten: 41},  // 蛃
+	{r: 0x86D1, men: 2, ku: 87, ten: 42},  // 蛑
+	{r: 0x86D5, men: 2, ku: 87, ten: 43},  // 蛕
+	{r: 0x86D7, men: 2, ku: 87, ten: 44},  // 蛗
+	{r: 0x86E3, men: 2, ku: 87, ten: 45},  // 蛣
+	{r: 0x86E6, men: 2, ku: 87, ten: 46},  // 蛦
+	{r: 0x86FA, men: 1, ku: 91, ten: 54},  // 蛺
+	{r: 0x86FC, men: 1, ku: 91, ten: 55},  // 蛼
+	{r: 0x86FD, men: 1, ku: 91, ten: 56},  // 蛽
+	{r: 0x8705, men: 2, ku: 87, ten: 48},  // 蜅
+	{r: 0x8707, men: 2, ku: 87, ten: 49},  // 蜇
+	{r: 0x870B, men: 1, ku: 91, ten: 57},  // 蜋
+	{r: 0x870E, men: 2, ku: 87, ten: 50},  // 蜎
+	{r: 0x8710, men: 2, ku: 87, ten: 51},  // 蜐
+	{r: 0x8713, men: 2, ku: 87, ten: 52},  // 蜓
+	{r: 0x8719, men: 2, ku: 87, ten: 53},  // 蜙
+	{r: 0x871F, men: 2, ku: 87, ten: 54},  // 蜟
+	{r: 0x8721, men: 2, ku: 87, ten: 55},  // 蜡
+	{r: 0x8723, men: 2, ku: 87, ten: 56},  // 蜣
+	{r: 0x8731, men: 2, ku: 87, ten: 57},  // 蜱
+	{r: 0x873A, men: 2, ku: 87, ten: 58},  // 蜺
+	{r: 0x873E, men: 2, ku: 87, ten: 59},  // 蜾
+	{r: 0x8740, men: 2, ku: 87, ten: 60},  // 蝀
+	{r: 0x8743, men: 2, ku: 87, ten: 61},  // 蝃
+	{r: 0x8751, men: 2, ku: 87, ten: 62},  // 蝑
+	{r: 0x8758, men: 2, ku: 87, ten: 63},  // 蝘
+	{r: 0x8764, men: 2, ku: 87, ten: 64},  // 蝤
+	{r: 0x8765, men: 2, ku: 87, ten: 65},  // 蝥
+	{r: 0x8771, men: 1, ku: 91, ten: 58},  // 蝱
+	{r: 0x8772, men: 2, ku: 87, ten: 66},  // 蝲
+	{r: 0x877C, men: 2, ku: 87, ten: 67},  // 蝼
+	{r: 0x8787, men: 1, ku: 91, ten: 59},  // 螇
+	{r: 0x8788, men: 1, ku: 91, ten: 60},  // 螈
+	{r: 0x8789, men: 2, ku: 87, ten: 71},  // 螉
+	{r: 0x878B, men: 2, ku: 87, ten: 72},  // 螋
+	{r: 0x8793, men: 2, ku: 87, ten: 73},  // 螓
+	{r: 0x87A0, men: 2, ku: 87, ten: 74},  // 螠
+	{r: 0x87A7, men: 2, ku: 87, ten: 70},  // 螧
+	{r: 0x87AC, men: 1, ku: 91, ten: 61},  // 螬
+	{r: 0x87AD, men: 1, ku: 91, ten: 62},  // 螭
+	{r: 0x87B5, men: 1, ku: 91, ten: 63},  // 螵
+	{r: 0x87BE, men: 2, ku: 87, ten: 77},  // 螾
+	{r: 0x87C1, men: 2, ku: 87, ten: 79},  // 蟁
+	{r: 0x87CE, men: 2, ku: 87, ten: 80},  // 蟎
+	{r: 0x87D6, men: 1, ku: 91, ten: 65},  // 蟖
+	{r: 0x87DF, men: 2, ku: 87, ten: 82},  // 蟟
+	{r: 0x87E3, men: 2, ku: 87, ten: 84},  // 蟣
+	{r: 0x87E5, men: 2, ku: 87, ten: 85},  // 蟥
+	{r: 0x87E6, men: 2, ku: 87, ten: 86},  // 蟦
+	{r: 0x87EA, men: 2, ku: 87, ten: 87},  // 蟪
+	{r: 0x87EB, men: 2, ku: 87, ten: 88},  // 蟫
+	{r: 0x87EC, men: 1, ku: 91, ten: 66},  // 蟬
+	{r: 0x87ED, men: 2, ku: 87, ten: 89},  // 蟭
+	{r: 0x87F5, men: 2, ku: 87, ten: 81},  // 蟵
+	{r: 0x8801, men: 2, ku: 87, ten: 90},  // 蠁
+	{r: 0x8803, men: 2, ku: 87, ten: 91},  // 蠃
+	{r: 0x8806, men: 1, ku: 91, ten: 67},  // 蠆
+	{r: 0x880A, men: 1, ku: 91, ten: 68},  // 蠊
+	{r: 0x880B, men: 2, ku: 87, ten: 92},  // 蠋
+	{r: 0x8810, men: 1, ku: 91, ten: 69},  // 蠐
+	{r: 0x8813, men: 2, ku: 87, ten: 93},  // 蠓
+	{r: 0x8814, men: 1, ku: 91, ten: 70},  // 蠔
+	{r: 0x881F, men: 1, ku: 91, ten: 71},  // 蠟
+	{r: 0x8828, men: 2, ku: 87, ten: 94},  // 蠨
+	{r: 0x882E, men: 2, ku: 88, ten: 1},   // 蠮
+	{r: 0x8832, men: 2, ku: 88, ten: 2},   // 蠲
+	{r: 0x883C, men: 2, ku: 88, ten: 3},   // 蠼
+	{r: 0x884A, men: 2, ku: 88, ten: 5},   // 衊
+	{r: 0x8858, men: 2, ku: 88, ten: 6},   // 衘
+	{r: 0x885F, men: 2, ku: 88, ten: 7},   // 衟
+	{r: 0x8864, men: 2, ku: 88, ten: 8},   // 衤
+	{r: 0x8869, men: 2, ku: 88, ten: 11},  // 衩
+	{r: 0x886F, men: 2, ku: 88, ten: 13},  // 衯
+	{r: 0x8898, men: 1, ku: 91, ten: 72},  // 袘
+	{r: 0x88A0, men: 2, ku: 88, ten: 14},  // 袠
+	{r: 0x88AA, men: 1, ku: 91, ten: 73},  // 袪
+	{r: 0x88BC, men: 2, ku: 88, ten: 15},  // 袼
+	{r: 0x88BD, men: 2, ku: 88, ten: 16},  // 袽
+	{r: 0x88BE, men: 2, ku: 88, ten: 17},  // 袾
+	{r: 0x88C0, men: 2, ku: 88, ten: 18},  // 裀
+	{r: 0x88CA, men: 1, ku: 91, ten: 74},  // 裊
+	{r: 0x88CE, men: 1, ku: 91, ten: 75},  // 裎
+	{r: 0x88D1, men: 2, ku: 88, ten: 21},  // 裑
+	{r: 0x88D2, men: 2, ku: 88, ten: 19},  // 裒
+	{r: 0x88D3, men: 2, ku: 88, ten: 22},  // 裓
+	{r: 0x88DB, men: 2, ku: 88, ten: 23},  // 裛
+	{r: 0x88F0, men: 2, ku: 88, ten: 24},  // 裰
+	{r: 0x88F1, men: 2, ku: 88, ten: 25},  // 裱
+	{r: 0x88F5, men: 1, ku: 91, ten: 77},  // 裵
+	{r: 0x8901, men: 2, ku: 88, ten: 27},  // 褁
+	{r: 0x8918, men: 1, ku: 91, ten: 80},  // 褘
+	{r: 0x8919, men: 1, ku: 91, ten: 81},  // 褙
+	{r: 0x891A, men: 1, ku: 91, ten: 82},  // 褚
+	{r: 0x891C, men: 1, ku: 91, ten: 78},  // 褜
+	{r: 0x8927, men: 1, ku: 91, ten: 83},  // 褧
+	{r: 0x8930, men: 1, ku: 91, ten: 84},  // 褰
+	{r: 0x8932, men: 1, ku: 91, ten: 85},  // 褲
+	{r: 0x8937, men: 2, ku: 88, ten: 29},  // 褷
+	{r: 0x8939, men: 1, ku: 91, ten: 86},  // 褹
+	{r: 0x8940, men: 1, ku: 91, ten: 87},  // 襀
+	{r: 0x8942, men: 2, ku: 88, ten: 31},  // 襂
+	{r: 0x8945, men: 2, ku: 88, ten: 32},  // 襅
+	{r: 0x8949, men: 2, ku: 88, ten: 33},  // 襉
+	{r: 0x8962, men: 2, ku: 88, ten: 36},  // 襢
+	{r: 0x8980, men: 2, ku: 88, ten: 37},  // 覀
+	{r: 0x8989, men: 2, ku: 88, ten: 38},  // 覉
+	{r: 0x8990, men: 2, ku: 88, ten: 39},  // 覐
+	{r: 0x8994, men: 1, ku: 91, ten: 88},  // 覔
+	{r: 0x899F, men: 2, ku: 88, ten: 40},  // 覟
+	{r: 0x89B0, men: 2, ku: 88, ten: 41},  // 覰
+	{r: 0x89B7, men: 2, ku: 88, ten: 42},  // 覷
+	{r: 0x89D4, men: 1, ku: 91, ten: 90},  // 觔
+	{r: 0x89D6, men: 2, ku: 88, ten: 43},  // 觖
+	{r: 0x89D8, men: 2, ku: 88, ten: 44},  // 觘
+	{r: 0x89E5, men: 1, ku: 91, ten: 91},  // 觥
+	{r: 0x89EB, men: 2, ku: 88, ten: 45},  // 觫
+	{r: 0x89F1, men: 2, ku: 88, ten: 47},  // 觱
+	{r: 0x89F3, men: 2, ku: 88, ten: 48},  // 觳
+	{r: 0x89F6, men: 1, ku: 91, ten: 92},  // 觶
+	{r: 0x89FD, men: 2, ku: 88, ten: 49},  // 觽
+	{r: 0x89FF, men: 2, ku: 88, ten: 50},  // 觿
+	{r: 0x8A11, men: 2, ku: 88, ten: 52},  // 訑
+	{r: 0x8A12, men: 1, ku: 91, ten: 93},  // 訒
+	{r: 0x8A14, men: 2, ku: 88, ten: 53},  // 訔
+	{r: 0x8A15, men: 1, ku: 91, ten: 94},  // 訕
+	{r: 0x8A21, men: 2, ku: 88, ten: 55},  // 訡
+	{r: 0x8A22, men: 1, ku: 92, ten: 1},   // 訢
+	{r: 0x8A35, men: 2, ku: 88, ten: 56},  // 訵
+	{r: 0x8A37, men: 1, ku: 92, ten: 2},   // 訷
+	{r: 0x8A3E, men: 2, ku: 88, ten: 57},  // 訾
+	{r: 0x8A45, men: 2, ku: 88, ten: 58},  // 詅
+	{r: 0x8A47, men: 1, ku: 92, ten: 3},   // 詇
+	{r: 0x8A4D, men: 2, ku: 88, ten: 59},  // 詍
+	{r: 0x8A4E, men: 1, ku: 92, ten: 4},   // 詎
+	{r: 0x8A58, men: 2, ku: 88, ten: 60},  // 詘
+	{r: 0x8A5D, men: 1, ku: 92, ten: 5},   // 詝
+	{r: 0x8A61, men: 1, ku: 92, ten: 6},   // 詡
+	{r: 0x8A75, men: 1, ku: 92, ten: 7},   // 詵
+	{r: 0x8A79, men: 1, ku: 92, ten: 8},   // 詹
+	{r: 0x8A90, men: 2, ku: 88, ten: 62},  // 誐
+	{r: 0x8AA7, men: 1, ku: 92, ten: 9},   // 誧
+	{r: 0x8AAE, men: 2, ku: 88, ten: 61},  // 誮
+	{r: 0x8AB7, men: 2, ku: 88, ten: 63},  // 誷
+	{r: 0x8ABE, men: 2, ku: 88, ten: 64},  // 誾
+	{r: 0x8AD0, men: 1, ku: 92, ten: 10},  // 諐
+	{r: 0x8AD7, men: 2, ku: 88, ten: 65},  // 諗
+	{r: 0x8ADF, men: 1, ku: 92, ten: 11},  // 諟
+	{r: 0x8AF4, men: 1, ku: 92, ten: 12},  // 諴
+	{r: 0x8AF6, men: 1, ku: 92, ten: 13},  // 諶
+	{r: 0x8AFC, men: 2, ku: 88, ten: 66},  // 諼
+	{r: 0x8B05, men: 2, ku: 88, ten: 69},  // 謅
+	{r: 0x8B0A, men: 2, ku: 88, ten: 68},  // 謊
+	{r: 0x8B0D, men: 2, ku: 88, ten: 70},  // 謍
+	{r: 0x8B1C, men: 2, ku: 88, ten: 71},  // 謜
+	{r: 0x8B1F, men: 2, ku: 88, ten: 72},  // 謟
+	{r: 0x8B2D, men: 2, ku: 88, ten: 73},  // 謭
+	{r: 0x8B43, men: 2, ku: 88, ten: 74},  // 譃
+	{r: 0x8B46, men: 1, ku: 92, ten: 17},  // 譆
+	{r: 0x8B51, men: 2, ku: 88, ten: 76},  // 譑
+	{r: 0x8B54, men: 1, ku: 92, ten: 18},  // 譔
+	{r: 0x8B59, men: 1, ku: 92, ten: 19},  // 譙
+	{r: 0x8B5E, men: 2, ku: 88, ten: 77},  // 譞
+	{r: 0x8B69, men: 1, ku: 92, ten: 20},  // 譩
+	{r: 0x8B76, men: 2, ku: 88, ten: 78},  // 譶
+	{r: 0x8B7F, men: 2, ku: 88, ten: 79},  // 譿
+	{r: 0x8B81, men: 2, ku: 88, ten: 80},  // 讁
+	{r: 0x8B8B, men: 2, ku: 88, ten: 81},  // 讋
+	{r: 0x8B94, men: 2, ku: 88, ten: 82},  // 讔
+	{r: 0x8B95, men: 2, ku: 88, ten: 83},  // 讕
+	{r: 0x8B9C, men: 2, ku: 88, ten: 84},  // 讜
+	{r: 0x8B9D, men: 1, ku: 92, ten: 21},  // 讝
+	{r: 0x8B9E, men: 2, ku: 88, ten: 85},  // 讞
+	{r: 0x8C39, men: 2, ku: 88, ten: 86},  // 谹
+	{r: 0x8C3D, men: 2, ku: 88, ten: 88},  // 谽
+	{r: 0x8C45, men: 2, ku: 88, ten: 91},  // 豅
+	{r: 0x8C47, men: 2, ku: 88, ten: 92},  // 豇
+	{r: 0x8C49, men: 1, ku: 92, ten: 22},  // 豉
+	{r: 0x8C4F, men: 2, ku: 88, ten: 93},  // 豏
+	{r: 0x8C54, men: 2, ku: 88, ten: 94},  // 豔
+	{r: 0x8C57, men: 2, ku: 89, ten: 1},   // 豗
+	{r: 0x8C68, men: 1, ku: 92, ten: 23},  // 豨
+	{r: 0x8C69, men: 2, ku: 89, ten: 2},   // 豩
+	{r: 0x8C6D, men: 2, ku: 89, ten: 3},   // 豭
+	{r: 0x8C73, men: 2, ku: 89, ten: 4},   // 豳
+	{r: 0x8C92, men: 2, ku: 89, ten: 7},   // 貒
+	{r: 0x8C93, men: 2, ku: 89, ten: 6},   // 貓
+	{r: 0x8C99, men: 2, ku: 89, ten: 8},   // 貙
+	{r: 0x8C9B, men: 2, ku: 89, ten: 10},  // 貛
+	{r: 0x8CA4, men: 2, ku: 89, ten: 11},  // 貤
+	{r: 0x8CD5, men: 2, ku: 89, ten: 13},  // 賕
+	{r: 0x8CD6, men: 2, ku: 89, ten: 12},  // 賖
+	{r: 0x8CD9, men: 2, ku: 89, ten: 14},  // 賙
+	{r: 0x8CE1, men: 1, ku: 92, ten: 25},  // 賡
+	{r: 0x8CF0, men: 2, ku: 89, ten: 16},  // 賰
+	{r: 0x8CF1, men: 2, ku: 89, ten: 17},  // 賱
+	{r: 0x8CF4, men: 1, ku: 92, ten: 26},  // 賴
+	{r: 0x8CF8, men: 1, ku: 92, ten: 27},  // 賸
+	{r: 0x8CFE, men: 1, ku: 92, ten: 28},  // 賾
+	{r: 0x8D09, men: 2, ku: 89, ten: 19},  // 贉
+	{r: 0x8D0E, men: 2, ku: 89, ten: 20},  // 贎
+	{r: 0x8D12, men: 1, ku: 92, ten: 30},  // 贒
+	{r: 0x8D1B, men: 1, ku: 92, ten: 31},  // 贛
+	{r: 0x8D6C, men: 2, ku: 89, ten: 21},  // 赬
+	{r: 0x8D84, men: 2, ku: 89, ten: 22},  // 趄
+	{r: 0x8D95, men: 2, ku: 89, ten: 23},  // 趕
+	{r: 0x8DA6, men: 2, ku: 89, ten: 24},  // 趦
+	{r: 0x8DAF, men: 1, ku: 92, ten: 32},  // 趯
+	{r: 0x8DC6, men: 2, ku: 89, ten: 26},  // 跆
+	{r: 0x8DC8, men: 2, ku: 89, ten: 27},  // 跈
+	{r: 0x8DCE, men: 1, ku: 92, ten: 33},  // 跎
+	{r: 0x8DD1, men: 1, ku: 92, ten: 34},  // 跑
+	{r: 0x8DD7, men: 1, ku: 92, ten: 35},  // 跗
+	{r: 0x8DD9, men: 2, ku: 89, ten: 28},  // 跙
+	{r: 0x8DEC, men: 2, ku: 89, ten: 29},  // 跬
+	{r: 0x8DFD, men: 2, ku: 89, ten: 32},  // 跽
+	{r: 0x8E06, men: 2, ku: 89, ten: 33},  // 踆
+	{r: 0x8E0C, men: 2, ku: 89, ten: 30},  // 踌
+	{r: 0x8E14, men: 2, ku: 89, ten: 35},  // 踔
+	{r: 0x8E16, men: 2, ku: 89, ten: 36},  // 踖
+	{r: 0x8E20, men: 1, ku: 92, ten: 36},  // 踠
+	{r: 0x8E21, men: 2, ku: 89, ten: 37},  // 踡
+	{r: 0x8E22, men: 2, ku: 89, ten: 38},  // 踢
+	{r: 0x8E23, men: 1, ku: 92, ten: 37},  // 踣
+	{r: 0x8E27, men: 2, ku: 89, ten: 39},  // 踧
+	{r: 0x8E36, men: 2, ku: 89, ten: 42},  // 踶
+	{r: 0x8E39, men: 2, ku: 89, ten: 43},  // 踹
+	{r: 0x8E3D, men: 1, ku: 92, ten: 38},  // 踽
+	{r: 0x8E4B, men: 2, ku: 89, ten: 44},  // 蹋
+	{r: 0x8E54, men: 2, ku: 89, ten: 45},  // 蹔
+	{r: 0x8E62, men: 2, ku: 89, ten: 46},  // 蹢
+	{r: 0x8E6C, men: 2, ku: 89, ten: 47},  // 蹬
+	{r: 0x8E6D, men: 2, ku: 89, ten: 48},  // 蹭
+	{r: 0x8E6F, men: 2, ku: 89, ten: 49},  // 蹯
+	{r: 0x8E70, men: 1, ku: 92, ten: 39},  // 蹰
+	{r: 0x8E7B, men: 1, ku: 92, ten: 40},  // 蹻
+	{r: 0x8E98, men: 2, ku: 89, ten: 50},  // 躘
+	{r: 0x8E9E, men: 2, ku: 89, ten: 51},  // 躞
+	{r: 0x8EAE, men: 2, ku: 89, ten: 52},  // 躮
+	{r: 0x8EB3, men: 2, ku: 89, ten: 53},  // 躳
+	{r: 0x8EB5, men: 2, ku: 89, ten: 54},  // 躵
+	{r: 0x8EB6, men: 2, ku: 89, ten: 55},  // 躶
+	{r: 0x8EBB, men: 2, ku: 89, ten: 56},  // 躻
+	{r: 0x8EC0, men: 1, ku: 92, ten: 42},  // 軀
+	{r: 0x8ED1, men: 2, ku: 89, ten: 58},  // 軑
+	{r: 0x8ED4, men: 2, ku: 89, ten: 59},  // 軔
+	{r: 0x8EF9, men: 2, ku: 89, ten: 61},  // 軹
+	{r: 0x8EFA, men: 1, ku: 92, ten: 44},  // 軺
+	{r: 0x8F00, men: 2, ku: 89, ten: 63},  // 輀
+	{r: 0x8F08, men: 2, ku: 89, ten: 64},  // 輈
+	{r: 0x8F17, men: 2, ku: 89, ten: 65},  // 輗
+	{r: 0x8F1E, men: 1, ku: 92, ten: 45},  // 輞
+	{r: 0x8F2B, men: 2, ku: 89, ten: 66},  // 輫
+	{r: 0x8F2D, men: 1, ku: 92, ten: 46},  // 輭
+	{r: 0x8F36, men: 1, ku: 92, ten: 47},  // 輶
+	{r: 0x8F40, men: 2, ku: 89, ten: 67},  // 轀
+	{r: 0x8F4A, men: 2, ku: 89, ten: 68},  // 轊
+	{r: 0x8F54, men: 1, ku: 92, ten: 48},  // 轔
+	{r: 0x8F58, men: 2, ku: 89, ten: 69},  // 轘
+	{r: 0x8FA4, men: 2, ku: 89, ten: 71},  // 辤
+	{r: 0x8FA6, men: 1, ku: 92, ten: 50},  // 辦
+	{r: 0x8FB4, men: 2, ku: 89, ten: 72},  // 辴
+	{r: 0x8FB5, men: 1, ku: 92, ten: 51},  // 辵
+	{r: 0x8FB6, men: 2, ku: 89, ten: 74},  // 辶
+	{r: 0x8FC1, men: 2, ku: 89, ten: 76},  // 迁
+	{r: 0x8FC6, men: 2, ku: 89, ten: 77},  // 迆
+	{r: 0x8FCA, men: 2, ku: 89, ten: 79},  // 迊
+	{r: 0x8FCD, men: 2, ku: 89, ten: 80},  // 迍
+	{r: 0x8FD3, men: 2, ku: 89, ten: 81},  // 迓
+	{r: 0x8FD5, men: 2, ku: 89, ten: 82},  // 迕
+	{r: 0x8FE0, men: 2, ku: 89, ten: 83},  // 迠
+	{r: 0x8FE4, men: 1, ku: 92, ten: 52},  // 迤
+	{r: 0x8FE8, men: 1, ku: 92, ten: 53},  // 迨
+	{r: 0x8FEE, men: 1, ku: 92, ten: 54},  // 迮
+	{r: 0x8FF1, men: 2, ku: 89, ten: 84},  // 迱
+	{r: 0x8FF5, men: 2, ku: 89, ten: 85},  // 迵
+	{r: 0x8FFB, men: 2, ku: 89, ten: 86},  // 迻
+	{r: 0x9002, men: 2, ku: 89, ten: 87},  // 适
+	{r: 0x9008, men: 1, ku: 92, ten: 55},  // 逈
+	{r: 0x900C, men: 2, ku: 89, ten: 88},  // 逌
+	{r: 0x902D, men: 1, ku: 92, ten: 56},  // 逭
+	{r: 0x9037, men: 2, ku: 89, ten: 89},  // 逷
+	{r: 0x9043, men: 2, ku: 89, ten: 91},  // 遃
+	{r: 0x9044, men: 2, ku: 89, ten: 92},  // 遄
+	{r: 0x905D, men: 2, ku: 89, ten: 93},  // 遝
+	{r: 0x9085, men: 2, ku: 90, ten: 2},   // 邅
+	{r: 0x9088, men: 1, ku: 92, ten: 58},  // 邈
+	{r: 0x908C, men: 2, ku: 90, ten: 3},   // 邌
+	{r: 0x9090, men: 2, ku: 90, ten: 4},   // 邐
+	{r: 0x9095, men: 1, ku: 92, ten: 59},  // 邕
+	{r: 0x9097, men: 1, ku: 92, ten: 60},  // 邗
+	{r: 0x9099, men: 1, ku: 92, ten: 61},  // 邙
+	{r: 0x909B, men: 1, ku: 92, ten: 62},  // 邛
+	{r: 0x90A1, men: 2, ku: 90, ten: 6},   // 邡
+	{r: 0x90A2, men: 1, ku: 92, ten: 63},  // 邢
+	{r: 0x90B0, men: 2, ku: 90, ten: 8},   // 邰
+	{r: 0x90B3, men: 1, ku: 92, ten: 64},  // 邳
+	{r: 0x90B6, men: 2, ku: 90, ten: 9},   // 邶
+	{r: 0x90BE, men: 1, ku: 92, ten: 65},  // 邾
+	{r: 0x90C3, men: 2, ku: 90, ten: 10},  // 郃
+	{r: 0x90C4, men: 1, ku: 92, ten: 66},  // 郄
+	{r: 0x90C5, men: 1, ku: 92, ten: 67},  // 郅
+	{r: 0x90C7, men: 1, ku: 92, ten: 68},  // 郇
+	{r: 0x90C8, men: 2, ku: 90, ten: 11},  // 郈
+	{r: 0x90D7, men: 1, ku: 92, ten: 69},  // 郗
+	{r: 0x90DC, men: 2, ku: 90, ten: 13},  // 郜
+	{r: 0x90DD, men: 1, ku: 92, ten: 70},  // 郝
+	{r: 0x90DE, men: 1, ku: 92, ten: 71},  // 郞
+	{r: 0x90DF, men: 2, ku: 90, ten: 14},  // 郟
+	{r: 0x90EB, men: 2, ku: 90, ten: 19},  // 郫
+	{r: 0x90EF, men: 1, ku: 92, ten: 72},  // 郯
+	{r: 0x90F2, men: 2, ku: 90, ten: 17},  // 郲
+	{r: 0x90F4, men: 1, ku: 92, ten: 73},  // 郴
+	{r: 0x90F6, men: 2, ku: 90, ten: 16},  // 郶
+	{r: 0x90FE, men: 2, ku: 90, ten: 20},  // 郾
+	{r: 0x90FF, men: 2, ku: 90, ten: 21},  // 郿
+	{r: 0x9100, men: 2, ku: 90, ten: 18},  // 鄀
+	{r: 0x9104, men: 2, ku: 90, ten: 22},  // 鄄
+	{r: 0x9106, men: 2, ku: 90, ten: 23},  // 鄆
+	{r: 0x9114, men: 1, ku: 92, ten: 75},  // 鄔
+	{r: 0x9115, men: 1, ku: 92, ten: 76},  // 鄕
+	{r: 0x9116, men: 1, ku: 92, ten: 77},  // 鄖
+	{r: 0x9118, men: 2, ku: 90, ten: 24},  // 鄘
+	{r: 0x911C, men: 2, ku: 90, ten: 25},  // 鄜
+	{r: 0x911E, men: 2, ku: 90, ten: 26},  // 鄞
+	{r: 0x9122, men: 1, ku: 92, ten: 78},  // 鄢
+	{r: 0x9123, men: 1, ku: 92, ten: 79},  // 鄣
+	{r: 0x9127, men: 1, ku: 92, ten: 80},  // 鄧
+	{r: 0x912F, men: 1, ku: 92, ten: 81},  // 鄯
+	{r: 0x9131, men: 1, ku: 92, ten: 82},  // 鄱
+	{r: 0x9134, men: 1, ku: 92, ten: 83},  // 鄴
+	{r: 0x9137, men: 2, ku: 90, ten: 27},  // 鄷
+	{r: 0x9139, men: 2, ku: 90, ten: 28},  // 鄹
+	{r: 0x913A, men: 2, ku: 90, ten: 29},  // 鄺
+	{r: 0x913D, men: 1, ku: 92, ten: 84},  // 鄽
+	{r: 0x9146, men: 2, ku: 90, ten: 30},  // 酆
+	{r: 0x9147, men: 2, ku: 90, ten: 31},  // 酇
+	{r: 0x9148, men: 1, ku: 92, ten: 85},  // 酈
+	{r: 0x9157, men: 2, ku: 90, ten: 32},  // 酗
+	{r: 0x9159, men: 2, ku: 90, ten: 33},  // 酙
+	{r: 0x915B, men: 1, ku: 92, ten: 86},  // 酛
+	{r: 0x9161, men: 2, ku: 90, ten: 34},  // 酡
+	{r: 0x9164, men: 2, ku: 90, ten: 35},  // 酤
+	{r: 0x9174, men: 2, ku: 90, ten: 36},  // 酴
+	{r: 0x9179, men: 2, ku: 90, ten: 37},  // 酹
+	{r: 0x9183, men: 1, ku: 92, ten: 87},  // 醃
+	{r: 0x9185, men: 2, ku: 90, ten: 38},  // 醅
+	{r: 0x918E, men: 2, ku: 90, ten: 39},  // 醎
+	{r: 0x919E, men: 1, ku: 92, ten: 88},  // 醞
+	{r: 0x91A8, men: 2, ku: 90, ten: 40},  // 醨
+	{r: 0x91AC, men: 1, ku: 92, ten: 89},  // 醬
+	{r: 0x91AE, men: 2, ku: 90, ten: 41},  // 醮
+	{r: 0x91B1, men: 1, ku: 92, ten: 90},  // 醱
+	{r: 0x91B3, men: 2, ku: 90, ten: 42},  // 醳
+	{r: 0x91B6, men: 2, ku: 90, ten: 43},  // 醶
+	{r: 0x91BC, men: 1, ku: 92, ten: 91},  // 醼
+	{r: 0x91C3, men: 2, ku: 90, ten: 44},  // 釃
+	{r: 0x91C4, men: 2, ku: 90, ten: 45},  // 釄
+	{r: 0x91D7, men: 1, ku: 92, ten: 92},  // 釗
+	{r: 0x91DA, men: 2, ku: 90, ten: 46},  // 釚
+	{r: 0x91E4, men: 1, ku: 92, ten: 94},  // 釤
+	{r: 0x91E5, men: 1, ku: 93, ten: 1},   // 釥
+	{r: 0x91EC, men: 2, ku: 90, ten: 49},  // 釬
+	{r: 0x91ED, men: 1, ku: 93, ten: 2},   // 釭
+	{r: 0x91EE, men: 2, ku: 90, ten: 50},  // 釮
+	{r: 0x91F1, men: 1, ku: 93, ten: 3},   // 釱
+	{r: 0x91FB, men: 1, ku: 92, ten: 93},  // 釻
+	{r: 0x9201, men: 2, ku: 90, ten: 51},  // 鈁
+	{r: 0x9207, men: 1, ku: 93, ten: 4},   // 鈇
+	{r: 0x920A, men: 2, ku: 90, ten: 52},  // 鈊
+	{r: 0x9210, men: 1, ku: 93, ten: 5},   // 鈐
+	{r: 0x9216, men: 2, ku: 90, ten: 53},  // 鈖
+	{r: 0x9217, men: 2, ku: 90, ten: 54},  // 鈗
+	{r: 0x9233, men: 2, ku: 90, ten: 56},  // 鈳
+	{r: 0x9238, men: 1, ku: 93, ten: 6},   // 鈸
+	{r: 0x9239, men: 1, ku: 93, ten: 7},   // 鈹
+	{r: 0x923A, men: 1, ku: 93, ten: 8},   // 鈺
+	{r: 0x923C, men: 1, ku: 93, ten: 9},   // 鈼
+	{r: 0x9240, men: 1, ku: 93, ten: 10},  // 鉀
+	{r: 0x9242, men: 2, ku: 90, ten: 57},  // 鉂
+	{r: 0x9243, men: 1, ku: 93, ten: 11},  // 鉃
+	{r: 0x9247, men: 2, ku: 90, ten: 58},  // 鉇
+	{r: 0x924A, men: 2, ku: 90, ten: 59},  // 鉊
+	{r: 0x924E, men: 2, ku: 90, ten: 60},  // 鉎
+	{r: 0x924F, men: 1, ku: 93, ten: 12},  // 鉏
+	{r: 0x9251, men: 2, ku: 90, ten: 61},  // 鉑
+	{r: 0x9256, men: 2, ku: 90, ten: 62},  // 鉖
+	{r: 0x9259, men: 2, ku: 90, ten: 63},  // 鉙
+	{r: 0x9260, men: 2, ku: 90, ten: 64},  // 鉠
+	{r: 0x9261, men: 2, ku: 90, ten: 65},  // 鉡
+	{r: 0x9265, men: 2, ku: 90, ten: 66},  // 鉥
+	{r: 0x9267, men: 2, ku: 90, ten: 67},  // 鉧
+	{r: 0x9268, men: 2, ku: 90, ten: 68},  // 鉨
+	{r: 0x9278, men: 1, ku: 93, ten: 13},  // 鉸
+	{r: 0x927C, men: 2, ku: 90, ten: 71},  // 鉼
+	{r: 0x927D, men: 2, ku: 90, ten: 72},  // 鉽
+	{r: 0x927F, men: 2, ku: 90, ten: 73},  // 鉿
+	{r: 0x9288, men: 1, ku: 93, ten: 14},  // 銈
+	{r: 0x9289, men: 2, ku: 90, ten: 74},  // 銉
+	{r: 0x928D, men: 2, ku: 90, ten: 75},  // 銍
+	{r: 0x9297, men: 2, ku: 90, ten: 76},  // 銗
+	{r: 0x9299, men: 2, ku: 90, ten: 77},  // 銙
+	{r: 0x929F, men: 2, ku: 90, ten: 78},  // 銟
+	{r: 0x92A7, men: 2, ku: 90, ten: 79},  // 銧
+	{r: 0x92AB, men: 2, ku: 90, ten: 80},  // 銫
+	{r: 0x92B2, men: 2, ku: 90, ten: 83},  // 銲
+	{r: 0x92BF, men: 2, ku: 90, ten: 84},  // 銿
+	{r: 0x92C0, men: 2, ku: 90, ten: 85},  // 鋀
+	{r: 0x92C2, men: 1, ku: 93, ten: 15},  // 鋂
+	{r: 0x92C6, men: 2, ku: 90, ten: 86},  // 鋆
+	{r: 0x92CB, men: 1, ku: 93, ten: 16},  // 鋋
+	{r: 0x92CC, men: 1, ku: 93, ten: 17},  // 鋌
+	{r: 0x92CE, men: 2, ku: 90, ten: 87},  // 鋎
+	{r: 0x92D0, men: 2, ku: 90, ten: 88},  // 鋐
+	{r: 0x92D3, men: 1, ku: 93, ten: 18},  // 鋓
+	{r: 0x92D7, men: 2, ku: 90, ten: 89},  // 鋗
+	{r: 0x92D9, men: 2, ku: 90, ten: 90},  // 鋙
+	{r: 0x92E0, men: 1, ku: 93, ten: 19},  // 鋠
+	{r: 0x92E5, men: 2, ku: 90, ten: 91},  // 鋥
+	{r: 0x92E7, men: 2, ku: 90, ten: 92},  // 鋧
+	{r: 0x92F7, men: 2, ku: 91, ten: 2},   // 鋷
+	{r: 0x92F9, men: 2, ku: 91, ten: 3},   // 鋹
+	{r: 0x92FB, men: 2, ku: 91, ten: 4},   // 鋻
+	{r: 0x92FF, men: 1, ku: 93, ten: 20},  // 鋿
+	{r: 0x9302, men: 2, ku: 91, ten: 5},   // 錂
+	{r: 0x9304, men: 1, ku: 93, ten: 21},  // 錄
+	{r: 0x930D, men: 2, ku: 91, ten: 6},   // 錍
+	{r: 0x9311, men: 2, ku: 90, ten: 93},  // 錑
+	{r: 0x9315, men: 2, ku: 91, ten: 7},   // 錕
+	{r: 0x931D, men: 2, ku: 91, ten: 8},   // 錝
+	{r: 0x931E, men: 2, ku: 91, ten: 9},   // 錞
+	{r: 0x931F, men: 1, ku: 93, ten: 22},  // 錟
+	{r: 0x9321, men: 1, ku: 93, ten: 23},  // 錡
+	{r: 0x9325, men: 1, ku: 93, ten: 24},  // 錥
+	{r: 0x9327, men: 2, ku: 91, ten: 10},  // 錧
+	{r: 0x9329, men: 2, ku: 91, ten: 11},  // 錩
+	{r: 0x9347, men: 2, ku: 91, ten: 14},  // 鍇
+	{r: 0x9348, men: 1, ku: 93, ten: 25},  // 鍈
+	{r: 0x9349, men: 1, ku: 93, ten: 26},  // 鍉
+	{r: 0x934A, men: 1, ku: 93, ten: 27},  // 鍊
+	{r: 0x9351, men: 2, ku: 91, ten: 15},  // 鍑
+	{r: 0x9357, men: 2, ku: 91, ten: 16},  // 鍗
+	{r: 0x935A, men: 2, ku: 91, ten: 17},  // 鍚
+	{r: 0x9364, men: 1, ku: 93, ten: 28},  // 鍤
+	{r: 0x9365, men: 1, ku: 93, ten: 29},  // 鍥
+	{r: 0x936A, men: 1, ku: 93, ten: 30},  // 鍪
+	{r: 0x936B, men: 2, ku: 91, ten: 18},  // 鍫
+	{r: 0x9370, men: 1, ku: 93, ten: 31},  // 鍰
+	{r: 0x9371, men: 2, ku: 91, ten: 19},  // 鍱
+	{r: 0x9373, men: 2, ku: 91, ten: 20},  // 鍳
+	{r: 0x9388, men: 2, ku: 91, ten: 24},  // 鎈
+	{r: 0x938B, men: 2, ku: 91, ten: 25},  // 鎋
+	{r: 0x938F, men: 2, ku: 91, ten: 26},  // 鎏
+	{r: 0x939B, men: 1, ku: 93, ten: 32},  // 鎛
+	{r: 0x939E, men: 2, ku: 91, ten: 27},  // 鎞
+	{r: 0x93A1, men: 2, ku: 91, ten: 21},  // 鎡
+	{r: 0x93A3, men: 1, ku: 93, ten: 33},  // 鎣
+	{r: 0x93BA, men: 1, ku: 93, ten: 34},  // 鎺
+	{r: 0x93C1, men: 2, ku: 91, ten: 32},  // 鏁
+	{r: 0x93C6, men: 1, ku: 93, ten: 35},  // 鏆
+	{r: 0x93C7, men: 2, ku: 91, ten: 33},  // 鏇
+	{r: 0x93DC, men: 2, ku: 91, ten: 34},  // 鏜
+	{r: 0x93DE, men: 1, ku: 93, ten: 36},  // 鏞
+	{r: 0x93DF, men: 1, ku: 93, ten: 37},  // 鏟
+	{r: 0x93E2, men: 2, ku: 91, ten: 35},  // 鏢
+	{r: 0x93E7, men: 2, ku: 91, ten: 36},  // 鏧
+	{r: 0x93F1, men: 2, ku: 91, ten: 31},  // 鏱
+	{r: 0x93F5, men: 2, ku: 91, ten: 28},  // 鏵
+	{r: 0x93FB, men: 2, ku: 91, ten: 41},  // 鏻
+	{r: 0x93FD, men: 1, ku: 93, ten: 39},  // 鏽
+	{r: 0x9404, men: 1, ku: 93, ten: 38},  // 鐄
+	{r: 0x9409, men: 2, ku: 91, ten: 37},  // 鐉
+	{r: 0x940F, men: 2, ku: 91, ten: 38},  // 鐏
+	{r: 0x9416, men: 2, ku: 91, ten: 39},  // 鐖
+	{r: 0x9417, men: 2, ku: 91, ten: 40},  // 鐗
+	{r: 0x9432, men: 2, ku: 91, ten: 42},  // 鐲
+	{r: 0x9433, men: 1, ku: 93, ten: 40},  // 鐳
+	{r: 0x9434, men: 2, ku: 91, ten: 43},  // 鐴
+	{r: 0x943B, men: 2, ku: 91, ten: 44},  // 鐻
+	{r: 0x9445, men: 2, ku: 91, ten: 45},  // 鑅
+	{r: 0x944A, men: 1, ku: 93, ten: 41},  // 鑊
+	{r: 0x9463, men: 1, ku: 93, ten: 42},  // 鑣
+	{r: 0x946B, men: 1, ku: 93, ten: 43},  // 鑫
+	{r: 0x946D, men: 2, ku: 91, ten: 48},  // 鑭
+	{r: 0x946F, men: 2, ku: 91, ten: 49},  // 鑯
+	{r: 0x9471, men: 1, ku: 93, ten: 44},  // 鑱
+	{r: 0x9472, men: 1, ku: 93, ten: 45},  // 鑲
+	{r: 0x9578, men: 2, ku: 91, ten: 50},  // 镸
+	{r: 0x9579, men: 2, ku: 91, ten: 51},  // 镹
+	{r: 0x9586, men: 2, ku: 91, ten: 52},  // 閆
+	{r: 0x958C, men: 2, ku: 91, ten: 53},  // 閌
+	{r: 0x958D, men: 2, ku: 91, ten: 54},  // 閍
+	{r: 0x958E, men: 1, ku: 93, ten: 46},  // 閎
+	{r: 0x959F, men: 1, ku: 93, ten: 47},  // 閟
+	{r: 0x95A6, men: 1, ku: 93, ten: 48},  // 閦
+	{r: 0x95A9, men: 1, ku: 93, ten: 49},  // 閩
+	{r: 0x95AB, men: 2, ku: 91, ten: 56},  // 閫
+	{r: 0x95AC, men: 1, ku: 93, ten: 50},  // 閬
+	{r: 0x95B4, men: 2, ku: 91, ten: 57},  // 閴
+	{r: 0x95B6, men: 1, ku: 93, ten: 51},  // 閶
+	{r: 0x95BD, men: 1, ku: 93, ten: 52},  // 閽
+	{r: 0x95C8, men: 2, ku: 91, ten: 59},  // 闈
+	{r: 0x95CB, men: 1, ku: 93, ten: 53},  // 闋
+	{r: 0x95D0, men: 1, ku: 93, ten: 54},  // 闐
+	{r: 0x95D3, men: 1, ku: 93, ten: 55},  // 闓
+	{r: 0x95DA, men: 1, ku: 93, ten: 57},  // 闚
+	{r: 0x95DE, men: 1, ku: 93, ten: 58},  // 闞
+	{r: 0x961D, men: 2, ku: 90, ten: 5},   // 阝
+	{r: 0x962C, men: 2, ku: 91, ten: 62},  // 阬
+	{r: 0x9633, men: 2, ku: 91, ten: 63},  // 阳
+	{r: 0x9634, men: 2, ku: 91, ten: 64},  // 阴
+	{r: 0x963C, men: 2, ku: 91, ten: 66},  // 阼
+	{r: 0x9641, men: 2, ku: 91, ten: 67},  // 陁
+	{r: 0x9658, men: 1, ku: 93, ten: 59},  // 陘
+	{r: 0x9661, men: 2, ku: 91, ten: 68},  // 陡
+	{r: 0x9682, men: 2, ku: 91, ten: 70},  // 隂
+	{r: 0x9684, men: 1, ku: 93, ten: 60},  // 隄
+	{r: 0x969A, men: 2, ku: 91, ten: 72},  // 隚
+	{r: 0x969D, men: 1, ku: 93, ten: 62},  // 隝
+	{r: 0x96A4, men: 1, ku: 93, ten: 63},  // 隤
+	{r: 0x96A5, men: 1, ku: 93, ten: 64},  // 隥
+	{r: 0x96A9, men: 2, ku: 91, ten: 75},  // 隩
+	{r: 0x96AF, men: 2, ku: 91, ten: 76},  // 隯
+	{r: 0x96B3, men: 2, ku: 91, ten: 77},  // 隳
+	{r: 0x96BA, men: 2, ku: 91, ten: 78},  // 隺
+	{r: 0x96BD, men: 2, ku: 91, ten: 79},  // 隽
+	{r: 0x96D2, men: 1, ku: 93, ten: 65},  // 雒
+	{r: 0x96D8, men: 2, ku: 91, ten: 82},  // 雘
+	{r: 0x96DA, men: 2, ku: 91, ten: 83},  // 雚
+	{r: 0x96DD, men: 2, ku: 91, ten: 84},  // 雝
+	{r: 0x96DE, men: 1, ku: 93, ten: 66},  // 雞
+	{r: 0x96E9, men: 1, ku: 93, ten: 68},  // 雩
+	{r: 0x96EF, men: 1, ku: 93, ten: 69},  // 雯
+	{r: 0x9714, men: 2, ku: 91, ten: 86},  // 霔
+	{r: 0x9723, men: 2, ku: 91, ten: 87},  // 霣
+	{r: 0x9733, men: 1, ku: 93, ten: 70},  // 霳
+	{r: 0x9736, men: 2, ku: 91, ten: 89},  // 霶
+	{r: 0x973B, men: 1, ku: 93, ten: 71},  // 霻
+	{r: 0x9741, men: 2, ku: 91, ten: 90},  // 靁
+	{r: 0x9747, men: 2, ku: 91, ten: 91},  // 靇
+	{r: 0x974D, men: 1, ku: 93, ten: 72},  // 靍
+	{r: 0x974E, men: 1, ku: 93, ten: 73},  // 靎
+	{r: 0x974F, men: 1, ku: 93, ten: 74},  // 靏
+	{r: 0x9755, men: 2, ku: 91, ten: 92},  // 靕
+	{r: 0x9757, men: 2, ku: 91, ten: 93},  // 靗
+	{r: 0x975A, men: 1, ku: 93, ten: 75},  // 靚
+	{r: 0x975B, men: 2, ku: 91, ten: 94},  // 靛
+	{r: 0x976A, men: 2, ku: 92, ten: 1},   // 靪
+	{r: 0x976E, men: 1, ku: 93, ten: 76},  // 靮
+	{r: 0x9773, men: 1, ku: 93, ten: 77},  // 靳
+	{r: 0x9795, men: 1, ku: 93, ten: 78},  // 鞕
+	{r: 0x9796, men: 2, ku: 92, ten: 4},   // 鞖
+	{r: 0x979A, men: 2, ku: 92, ten: 5},   // 鞚
+	{r: 0x979E, men: 2, ku: 92, ten: 6},   // 鞞
+	{r: 0x97A2, men: 2, ku: 92, ten: 7},   // 鞢
+	{r: 0x97AE, men: 1, ku: 93, ten: 79},  // 鞮
+	{r: 0x97B1, men: 2, ku: 92, ten: 8},   // 鞱
+	{r: 0x97B2, men: 2, ku: 92, ten: 9},   // 鞲
+	{r: 0x97BA, men: 1, ku: 93, ten: 80},  // 鞺
+	{r: 0x97BE, men: 2, ku: 92, ten: 10},  // 鞾
+	{r: 0x97C1, men: 1, ku: 93, ten: 81},  // 韁
+	{r: 0x97C9, men: 1, ku: 93, ten: 82},  // 韉
+	{r: 0x97CC, men: 2, ku: 92, ten: 11},  // 韌
+	{r: 0x97D1, men: 2, ku: 92, ten: 12},  // 韑
+	{r: 0x97D4, men: 2, ku: 92, ten: 13},  // 韔
+	{r: 0x97D8, men: 2, ku: 92, ten: 14},  // 韘
+	{r: 0x97D9, men: 2, ku: 92, ten: 15},  // 韙
+	{r: 0x97DB, men: 1, ku: 93, ten: 84},  // 韛
+	{r: 0x97DE, men: 1, ku: 93, ten: 83},  // 韞
+	{r: 0x97E1, men: 2, ku: 92, ten: 16},  // 韡
+	{r: 0x97F1, men: 2, ku: 92, ten: 17},  // 韱
+	{r: 0x97F4, men: 1, ku: 93, ten: 85},  // 韴
+	{r: 0x9804, men: 2, ku: 92, ten: 18},  // 頄
+	{r: 0x980A, men: 1, ku: 93, ten: 87},  // 頊
+	{r: 0x980D, men: 2, ku: 92, ten: 19},  // 頍
+	{r: 0x980E, men: 2, ku: 92, ten: 20},  // 頎
+	{r: 0x9814, men: 2, ku: 92, ten: 21},  // 頔
+	{r: 0x9816, men: 2, ku: 92, ten: 22},  // 頖
+	{r: 0x981E, men: 1, ku: 93, ten: 88},  // 頞
+	{r: 0x9823, men: 2, ku: 92, ten: 25},  // 頣
+	{r: 0x9825, men: 2, ku: 92, ten: 28},  // 頥
+	{r: 0x982B, men: 1, ku: 93, ten: 89},  // 頫
+	{r: 0x9830, men: 1, ku: 93, ten: 90},  // 頰
+	{r: 0x9832, men: 2, ku: 92, ten: 26},  // 頲
+	{r: 0x9833, men: 2, ku: 92, ten: 27},  // 頳
+	{r: 0x9847, men: 2, ku: 92, ten: 29},  // 顇
+	{r: 0x9852, men: 1, ku: 93, ten: 92},  // 顒
+	{r: 0x9853, men: 1, ku: 93, ten: 93},  // 顓
+	{r: 0x9856, men: 1, ku: 93, ten: 94},  // 顖
+	{r: 0x9857, men: 1, ku: 94, ten: 1},   // 顗
+	{r: 0x9859, men: 1, ku: 94, ten: 2},   // 顙
+	{r: 0x985A, men: 1, ku: 94, ten: 3},   // 顚
+	{r: 0x9865, men: 1, ku: 94, ten: 5},   // 顥
+	{r: 0x9866, men: 2, ku: 92, ten: 30},  // 顦
+	{r: 0x986C, men: 1, ku: 94, ten: 6},   // 顬
+	{r: 0x98AB, men: 2, ku: 92, ten: 31},  // 颫
+	{r: 0x98AD, men: 2, ku: 92, ten: 32},  // 颭
+	{r: 0x98B0, men: 2, ku: 92, ten: 33},  // 颰
+	{r: 0x98B7, men: 2, ku: 92, ten: 35},  // 颷
+	{r: 0x98B8, men: 2, ku: 92, ten: 36},  // 颸
+	{r: 0x98BA, men: 1, ku: 94, ten: 7},   // 颺
+	{r: 0x98BB, men: 2, ku: 92, ten: 37},  // 颻
+	{r: 0x98BC, men: 2, ku: 92, ten: 38},  // 颼
+	{r: 0x98BF, men: 2, ku: 92, ten: 39},  // 颿
+	{r: 0x98C2, men: 2, ku: 92, ten: 40},  // 飂
+	{r: 0x98C7, men: 2, ku: 92, ten: 41},  // 飇
+	{r: 0x98C8, men: 1, ku: 94, ten: 8},   // 飈
+	{r: 0x98CB, men: 2, ku: 92, ten: 42},  // 飋
+	{r: 0x98E0, men: 2, ku: 92, ten: 43},  // 飠
+	{r: 0x98E1, men: 2, ku: 92, ten: 45},  // 飡
+	{r: 0x98E3, men: 2, ku: 92, ten: 46},  // 飣
+	{r: 0x98E5, men: 2, ku: 92, ten: 47},  // 飥
+	{r: 0x98E7, men: 1, ku: 94, ten: 9},   // 飧
+	{r: 0x98EA, men: 2, ku: 92, ten: 48},  // 飪
+	{r: 0x98F0, men: 2, ku: 92, ten: 49},  // 飰
+	{r: 0x98F1, men: 2, ku: 92, ten: 50},  // 飱
+	{r: 0x98F3, men: 2, ku: 92, ten: 51},  // 飳
+	{r: 0x9908, men: 2, ku: 92, ten: 52},  // 餈
+	{r: 0x9916, men: 2, ku: 92, ten: 55},  // 餖
+	{r: 0x9917, men: 2, ku: 92, ten: 56},  // 餗
+	{r: 0x991A, men: 2, ku: 92, ten: 58},  // 餚
+	{r: 0x991B, men: 2, ku: 92, ten: 59},  // 餛
+	{r: 0x991C, men: 2, ku: 92, ten: 60},  // 餜
+	{r: 0x9931, men: 2, ku: 92, ten: 62},  // 餱
+	{r: 0x9932, men: 2, ku: 92, ten: 63},  // 餲
+	{r: 0x9933, men: 2, ku: 92, ten: 64},  // 餳
+	{r: 0x993A, men: 2, ku: 92, ten: 65},  // 餺
+	{r: 0x993B, men: 2, ku: 92, ten: 66},  // 餻
+	{r: 0x993C, men: 2, ku: 92, ten: 67},  // 餼
+	{r: 0x9940, men: 2, ku: 92, ten: 68},  // 饀
+	{r: 0x9941, men: 2, ku: 92, ten: 69},  // 饁
+	{r: 0x9946, men: 2, ku: 92, ten: 70},  // 饆
+	{r: 0x994D, men: 2, ku: 92, ten: 71},  // 饍
+	{r: 0x994E, men: 2, ku: 92, ten: 72},  // 饎
+	{r: 0x9958, men: 1, ku: 94, ten: 10},  // 饘
+	{r: 0x995C, men: 2, ku: 92, ten: 73},  // 饜
+	{r: 0x995F, men: 2, ku: 92, ten: 74},  // 饟
+	{r: 0x9960, men: 2, ku: 92, ten: 75},  // 饠
+	{r: 0x999E, men: 1, ku: 94, ten: 11},  // 馞
+	{r: 0x99A3, men: 2, ku: 92, ten: 76},  // 馣
+	{r: 0x99A6, men: 2, ku: 92, ten: 77},  // 馦
+	{r: 0x99B9, men: 2, ku: 92, ten: 78},  // 馹
+	{r: 0x99BD, men: 2, ku: 92, ten: 79},  // 馽
+	{r: 0x99BF, men: 2, ku: 92, ten: 80},  // 馿
+	{r: 0x99C3, men: 2, ku: 92, ten: 81},  // 駃
+	{r: 0x99C9, men: 2, ku: 92, ten: 82},  // 駉
+	{r: 0x99D4, men: 2, ku: 92, ten: 83},  // 駔
+	{r: 0x99D9, men: 2, ku: 92, ten: 84},  // 駙
+	{r: 0x99DE, men: 2, ku: 92, ten: 85},  // 駞
+	{r: 0x99F0, men: 2, ku: 92, ten: 87},  // 駰
+	{r: 0x99F9, men: 2, ku: 92, ten: 88},  // 駹
+	{r: 0x99FC, men: 2, ku: 92, ten: 89},  // 駼
+	{r: 0x9A02, men: 1, ku: 94, ten: 12},  // 騂
+	{r: 0x9A03, men: 1, ku: 94, ten: 13},  // 騃
+	{r: 0x9A0A, men: 2, ku: 92, ten: 90},  // 騊
+	{r: 0x9A11, men: 2, ku: 92, ten: 91},  // 騑
+	{r: 0x9A16, men: 2, ku: 92, ten: 92},  // 騖
+	{r: 0x9A1A, men: 2, ku: 92, ten: 93},  // 騚
+	{r: 0x9A20, men: 2, ku: 92, ten: 94},  // 騠
+	{r: 0x9A24, men: 1, ku: 94, ten: 14},  // 騤
+	{r: 0x9A2D, men: 1, ku: 94, ten: 15},  // 騭
+	{r: 0x9A2E, men: 1, ku: 94, ten: 16},  // 騮
+	{r: 0x9A31, men: 2, ku: 93, ten: 1},   // 騱
+	{r: 0x9A36, men: 2, ku: 93, ten: 2},   // 騶
+	{r: 0x9A38, men: 1, ku: 94, ten: 17},  // 騸
+	{r: 0x9A44, men: 2, ku: 93, ten: 3},   // 驄
+	{r: 0x9A4A, men: 1, ku: 94, ten: 18},  // 驊
+	{r: 0x9A4C, men: 2, ku: 93, ten: 4},   // 驌
+	{r: 0x9A4E, men: 1, ku: 94, ten: 19},  // 驎
+	{r: 0x9A52, men: 1, ku: 94, ten: 20},  // 驒
+	{r: 0x9A58, men: 2, ku: 93, ten: 5},   // 驘
+	{r: 0x9AAF, men: 2, ku: 93, ten: 7},   // 骯
+	{r: 0x9AB6, men: 1, ku: 94, ten: 21},  // 骶
+	{r: 0x9AB7, men: 2, ku: 93, ten: 9},   // 骷
+	{r: 0x9AB9, men: 2, ku: 93, ten: 11},  // 骹
+	{r: 0x9AC1, men: 1, ku: 94, ten: 22},  // 髁
+	{r: 0x9AC3, men: 1, ku: 94, ten: 23},  // 髃
+	{r: 0x9AC6, men: 2, ku: 93, ten: 13},  // 髆
+	{r: 0x9ACE, men: 1, ku: 94, ten: 24},  // 髎
+	{r: 0x9AD0, men: 2, ku: 93, ten: 14},  // 髐
+	{r: 0x9AD2, men: 2, ku: 93, ten: 15},  // 髒
+	{r: 0x9AD5, men: 2, ku: 93, ten: 16},  // 髕
+	{r: 0x9AD6, men: 1, ku: 94, ten: 25},  // 髖
+	{r: 0x9ADC, men: 2, ku: 93, ten: 18},  // 髜
+	{r: 0x9AE0, men: 2, ku: 93, ten: 19},  // 髠
+	{r: 0x9AE5, men: 2, ku: 93, ten: 20},  // 髥
+	{r: 0x9AE9, men: 2, ku: 93, ten: 21},  // 髩
+	{r: 0x9AF9, men: 1, ku: 94, ten: 26},  // 髹
+	{r: 0x9B02, men: 1, ku: 94, ten: 27},  // 鬂
+	{r: 0x9B03, men: 2, ku: 93, ten: 22},  // 鬃
+	{r: 0x9B08, men: 1, ku: 94, ten: 28},  // 鬈
+	{r: 0x9B0C, men: 2, ku: 93, ten: 23},  // 鬌
+	{r: 0x9B10, men: 2, ku: 93, ten: 24},  // 鬐
+	{r: 0x9B12, men: 2, ku: 93, ten: 25},  // 鬒
+	{r: 0x9B16, men: 2, ku: 93, ten: 26},  // 鬖
+	{r: 0x9B1C, men: 2, ku: 93, ten: 27},  // 鬜
+	{r: 0x9B20, men: 1, ku: 94, ten: 29},  // 鬠
+	{r: 0x9B2B, men: 2, ku: 93, ten: 28},  // 鬫
+	{r: 0x9B2D, men: 1, ku: 94, ten: 31},  // 鬭
+	{r: 0x9B33, men: 2, ku: 93, ten: 29},  // 鬳
+	{r: 0x9B3D, men: 2, ku: 93, ten: 30},  // 鬽
+	{r: 0x9B4B, men: 2, ku: 93, ten: 32},  // 魋
+	{r: 0x9B5E, men: 1, ku: 94, ten: 32},  // 魞
+	{r: 0x9B63, men: 2, ku: 93, ten: 33},  // 魣
+	{r: 0x9B65, men: 2, ku: 93, ten: 34},  // 魥
+	{r: 0x9B66, men: 1, ku: 94, ten: 34},  // 魦
+	{r: 0x9B6B, men: 2, ku: 93, ten: 35},  // 魫
+	{r: 0x9B6C, men: 2, ku: 93, ten: 36},  // 魬
+	{r: 0x9B72, men: 1, ku: 94, ten: 35},  // 魲
+	{r: 0x9B73, men: 2, ku: 93, ten: 37},  // 魳
+	{r: 0x9B75, men: 1, ku: 94, ten: 36},  // 魵
+	{r: 0x9B76, men: 2, ku: 93, ten: 38},  // 魶
+	{r: 0x9B77, men: 2, ku: 93, ten: 39},  // 魷
+	{r: 0x9B79, men: 1, ku: 94, ten: 33},  // 魹
+	{r: 0x9B84, men: 1, ku: 94, ten: 37},  // 鮄
+	{r: 0x9B8A, men: 1, ku: 94, ten: 38},  // 鮊
+	{r: 0x9B8F, men: 1, ku: 94, ten: 39},  // 鮏
+	{r: 0x9B9E, men: 1, ku: 94, ten: 40},  // 鮞
+	{r: 0x9BA6, men: 2, ku: 93, ten: 40},  // 鮦
+	{r: 0x9BA7, men: 1, ku: 94, ten: 41},  // 鮧
+	{r: 0x9BAC, men: 2, ku: 93, ten: 41},  // 鮬
+	{r: 0x9BB1, men: 2, ku: 93, ten: 42},  // 鮱
+	{r: 0x9BB2, men: 2, ku: 93, ten: 45},  // 鮲
+	{r: 0x9BB8, men: 2, ku: 93, ten: 46},  // 鮸
+	{r: 0x9BBE, men: 2, ku: 93, ten: 47},  // 鮾
+	{r: 0x9BC1, men: 1, ku: 94, ten: 42},  // 鯁
+	{r: 0x9BC7, men: 2, ku: 93, ten: 48},  // 鯇
+	{r: 0x9BCE, men: 1, ku: 94, ten: 43},  // 鯎
+	{r: 0x9BD8, men: 2, ku: 93, ten: 50},  // 鯘
+	{r: 0x9BDD, men: 2, ku: 93, ten: 51},  // 鯝
+	{r: 0x9BE5, men: 1, ku: 94, ten: 44},  // 鯥
+	{r: 0x9BE7, men: 2, ku: 93, ten: 52},  // 鯧
+	{r: 0x9BEA, men: 2, ku: 93, ten: 53},  // 鯪
+	{r: 0x9BEB, men: 2, ku: 93, ten: 54},  // 鯫
+	{r: 0x9BEE, men: 2, ku: 93, ten: 56},  // 鯮
+	{r: 0x9BEF, men: 2, ku: 93, ten: 55},  // 鯯
+	{r: 0x9BF3, men: 2, ku: 93, ten: 49},  // 鯳
+	{r: 0x9BF7, men: 2, ku: 93, ten: 60},  // 鯷
+	{r: 0x9BF8, men: 1, ku: 94, ten: 45},  // 鯸
+	{r: 0x9BFA, men: 2, ku: 93, ten: 58},  // 鯺
+	{r: 0x9BFD, men: 1, ku: 94, ten: 46},  // 鯽
+	{r: 0x9C00, men: 1, ku: 94, ten: 47},  // 鰀
+	{r: 0x9C16, men: 2, ku: 93, ten: 62},  // 鰖
+	{r: 0x9C18, men: 2, ku: 93, ten: 63},  // 鰘
+	{r: 0x9C19, men: 2, ku: 93, ten: 64},  // 鰙
+	{r: 0x9C1A, men: 2, ku: 93, ten: 65},  // 鰚
+	{r: 0x9C1D, men: 2, ku: 93, ten: 66},  // 鰝
+	{r: 0x9C22, men: 2, ku: 93, ten: 67},  // 鰢
+	{r: 0x9C23, men: 1, ku: 94, ten: 48},  // 鰣
+	{r: 0x9C27, men: 2, ku: 93, ten: 68},  // 鰧
+	{r: 0x9C29, men: 2, ku: 93, ten: 69},  // 鰩
+	{r: 0x9C2A, men: 2, ku: 93, ten: 70},  // 鰪
+	{r: 0x9C31, men: 2, ku: 93, ten: 72},  // 鰱
+	{r: 0x9C36, men: 2, ku: 93, ten: 73},  // 鰶
+	{r: 0x9C37, men: 2, ku: 93, ten: 74},  // 鰷
+	{r: 0x9C41, men: 1, ku: 94, ten: 49},  // 鱁
+	{r: 0x9C45, men: 2, ku: 93, ten: 75},  // 鱅
+	{r: 0x9C49, men: 2, ku: 93, ten: 78},  // 鱉
+	{r: 0x9C4A, men: 2, ku: 93, ten: 79},  // 鱊
+	{r: 0x9C4F, men: 1, ku: 94, ten: 50},  // 鱏
+	{r: 0x9C50, men: 1, ku: 94, ten: 51},  // 鱐
+	{r: 0x9C53, men: 1, ku: 94, ten: 52},  // 鱓
+	{r: 0x9C54, men: 2, ku: 93, ten: 81},  // 鱔
+	{r: 0x9C58, men: 2, ku: 93, ten: 82},  // 鱘
+	{r: 0x9C5B, men: 2, ku: 93, ten: 83},  // 鱛
+	{r: 0x9C5C, men: 2, ku: 93, ten: 76},  // 鱜
+	{r: 0x9C5D, men: 2, ku: 93, ten: 84},  // 鱝
+	{r: 0x9C5F, men: 2, ku: 93, ten: 85},  // 鱟
+	{r: 0x9C63, men: 1, ku: 94, ten: 53},  // 鱣
+	{r: 0x9C65, men: 1, ku: 94, ten: 54},  // 鱥
+	{r: 0x9C69, men: 2, ku: 93, ten: 86},  // 鱩
+	{r: 0x9C6A, men: 2, ku: 93, ten: 87},  // 鱪
+	{r: 0x9C6B, men: 2, ku: 93, ten: 88},  // 鱫
+	{r: 0x9C6D, men: 2, ku: 93, ten: 89},  // 鱭
+	{r: 0x9C6E, men: 2, ku: 93, ten: 90},  // 鱮
+	{r: 0x9C70, men: 2, ku: 93, ten: 91},  // 鱰
+	{r: 0x9C72, men: 2, ku: 93, ten: 92},  // 鱲
+	{r: 0x9C75, men: 2, ku: 93, ten: 93},  // 鱵
+	{r: 0x9C77, men: 1, ku: 94, ten: 55},  // 鱷
+	{r: 0x9C7A, men: 2, ku: 93, ten: 94},  // 鱺
+	{r: 0x9CE6, men: 2, ku: 94, ten: 1},   // 鳦
+	{r: 0x9CF2, men: 2, ku: 94, ten: 2},   // 鳲
+	{r: 0x9D02, men: 2, ku: 94, ten: 4},   // 鴂
+	{r: 0x9D0B, men: 2, ku: 94, ten: 3},   // 鴋
+	{r: 0x9D11, men: 2, ku: 94, ten: 6},   // 鴑
+	{r: 0x9D17, men: 2, ku: 94, ten: 7},   // 鴗
+	{r: 0x9D18, men: 2, ku: 94, ten: 8},   // 鴘
+	{r: 0x9D1D, men: 1, ku: 94, ten: 56},  // 鴝
+	{r: 0x9D1E, men: 1, ku: 94, ten: 57},  // 鴞
+	{r: 0x9D32, men: 2, ku: 94, ten: 12},  // 鴲
+	{r: 0x9D42, men: 2, ku: 94, ten: 14},  // 鵂
+	{r: 0x9D43, men: 1, ku: 94, ten: 58},  // 鵃
+	{r: 0x9D47, men: 1, ku: 94, ten: 59},  // 鵇
+	{r: 0x9D4A, men: 2, ku: 94, ten: 15},  // 鵊
+	{r: 0x9D52, men: 1, ku: 94, ten: 60},  // 鵒
+	{r: 0x9D5F, men: 2, ku: 94, ten: 16},  // 鵟
+	{r: 0x9D62, men: 2, ku: 94, ten: 17},  // 鵢
+	{r: 0x9D63, men: 1, ku: 94, ten: 61},  // 鵣
+	{r: 0x9D69, men: 2, ku: 94, ten: 19},  // 鵩
+	{r: 0x9D6B, men: 2, ku: 94, ten: 20},  // 鵫
+	{r: 0x9D70, men: 1, ku: 94, ten: 62},  // 鵰
+	{r: 0x9D73, men: 2, ku: 94, ten: 22},  // 鵳
+	{r: 0x9D76, men: 2, ku: 94, ten: 23},  // 鵶
+	{r: 0x9D77, men: 2, ku: 94, ten: 24},  // 鵷
+	{r: 0x9D7C, men: 1, ku: 94, ten: 63},  // 鵼
+	{r: 0x9D7E, men: 2, ku: 94, ten: 25},  // 鵾
+	{r: 0x9D84, men: 2, ku: 94, ten: 26},  // 鶄
+	{r: 0x9D8A, men: 1, ku: 94, ten: 64},  // 鶊
+	{r: 0x9D8D, men: 2, ku: 94, ten: 27},  // 鶍
+	{r: 0x9D96, men: 1, ku: 94, ten: 65},  // 鶖
+	{r: 0x9D99, men: 2, ku: 94, ten: 28},  // 鶙
+	{r: 0x9DA1, men: 2, ku: 94, ten: 29},  // 鶡
+	{r: 0x9DAC, men: 1, ku: 94, ten: 67},  // 鶬
+	{r: 0x9DB5, men: 2, ku: 94, ten: 31},  // 鶵
+	{r: 0x9DB9, men: 2, ku: 94, ten: 32},  // 鶹
+	{r: 0x9DBC, men: 1, ku: 94, ten: 68},  // 鶼
+	{r: 0x9DBD, men: 2, ku: 94, ten: 33},  // 鶽
+	{r: 0x9DBF, men: 2, ku: 94, ten: 30},  // 鶿
+	{r: 0x9DC0, men: 1, ku: 94, ten: 66},  // 鷀
+	{r: 0x9DC3, men: 2, ku: 94, ten: 34},  // 鷃
+	{r: 0x9DC7, men: 2, ku: 94, ten: 35},  // 鷇
+	{r: 0x9DC9, men: 2, ku: 94, ten: 36},  // 鷉
+	{r: 0x9DD6, men: 2, ku: 94, ten: 37},  // 鷖
+	{r: 0x9DD7, men: 1, ku: 94, ten: 69},  // 鷗
+	{r: 0x9DDA, men: 2, ku: 94, ten: 38},  // 鷚
+	{r: 0x9DDF, men: 2, ku: 94, ten: 39},  // 鷟
+	{r: 0x9DE0, men: 2, ku: 94, ten: 40},  // 鷠
+	{r: 0x9DE3, men: 2, ku: 94, ten: 41},  // 鷣
+	{r: 0x9DE7, men: 1, ku: 94, ten: 71},  // 鷧
+	{r: 0x9DF4, men: 2, ku: 94, ten: 42},  // 鷴
+	{r: 0x9E02, men: 2, ku: 94, ten: 45},  // 鸂
+	{r: 0x9E07, men: 1, ku: 94, ten: 72},  // 鸇
+	{r: 0x9E0A, men: 2, ku: 94, ten: 44},  // 鸊
+	{r: 0x9E0D, men: 2, ku: 94, ten: 46},  // 鸍
+	{r: 0x9E15, men: 1, ku: 94, ten: 73},  // 鸕
+	{r: 0x9E19, men: 2, ku: 94, ten: 47},  // 鸙
+	{r: 0x9E1C, men: 2, ku: 94, ten: 48},  // 鸜
+	{r: 0x9E1D, men: 2, ku: 94, ten: 49},  // 鸝
+	{r: 0x9E7B, men: 2, ku: 94, ten: 50},  // 鹻
+	{r: 0x9E7C, men: 1, ku: 94, ten: 74},  // 鹼
+	{r: 0x9E80, men: 2, ku: 94, ten: 52},  // 麀
+	{r: 0x9E85, men: 2, ku: 94, ten: 53},  // 麅
+	{r: 0x9E9B, men: 2, ku: 94, ten: 54},  // 麛
+	{r: 0x9E9E, men: 1, ku: 94, ten: 75},  // 麞
+	{r: 0x9EA4, men: 1, ku: 94, ten: 76},  // 麤
+	{r: 0x9EA8, men: 2, ku: 94, ten: 55},  // 麨
+	{r: 0x9EAC, men: 1, ku: 94, ten: 77},  // 麬
+	{r: 0x9EAF, men: 1, ku: 94, ten: 78},  // 麯
+	{r: 0x9EB4, men: 1, ku: 94, ten: 79},  // 麴
+	{r: 0x9EB5, men: 1, ku: 94, ten: 80},  // 麵
+	{r: 0x9EBD, men: 2, ku: 94, ten: 57},  // 麽
+	{r: 0x9EC3, men: 1, ku: 94, ten: 81},  // 黃
+	{r: 0x9ED1, men: 1, ku: 94, ten: 82},  // 黑
+	{r: 0x9EDF, men: 2, ku: 94, ten: 59},  // 黟
+	{r: 0x9EE7, men: 2, ku: 94, ten: 60},  // 黧
+	{r: 0x9EEE, men: 2, ku: 94, ten: 61},  // 黮
+	{r: 0x9EFF, men: 2, ku: 94, ten: 62},  // 黿
+	{r: 0x9F02, men: 2, ku: 94, ten: 63},  // 鼂
+	{r: 0x9F03, men: 2, ku: 94, ten: 65},  // 鼃
+	{r: 0x9F10, men: 1, ku: 94, ten: 83},  // 鼐
+	{r: 0x9F17, men: 2, ku: 94, ten: 66},  // 鼗
+	{r: 0x9F19, men: 2, ku: 94, ten: 67},  // 鼙
+	{r: 0x9F2F, men: 2, ku: 94, ten: 68},  // 鼯
+	{r: 0x9F37, men: 2, ku: 94, ten: 69},  // 鼷
+	{r: 0x9F39, men: 1, ku: 94, ten: 84},  // 鼹
+	{r: 0x9F3A, men: 2, ku: 94, ten: 70},  // 鼺
+	{r: 0x9F3D, men: 2, ku: 94, ten: 71},  // 鼽
+	{r: 0x9F41, men: 2, ku: 94, ten: 72},  // 齁
+	{r: 0x9F45, men: 2, ku: 94, ten: 73},  // 齅
+	{r: 0x9F46, men: 2, ku: 94, ten: 74},  // 齆
+	{r: 0x9F53, men: 2, ku: 94, ten: 75},  // 齓
+	{r: 0x9F55, men: 2, ku: 94, ten: 76},  // 齕
+	{r: 0x9F57, men: 1, ku: 94, ten: 85},  // 齗
+	{r: 0x9F58, men: 2, ku: 94, ten: 77},  // 齘
+	{r: 0x9F5D, men: 2, ku: 94, ten: 79},  // 齝
+	{r: 0x9F69, men: 2, ku: 94, ten: 81},  // 齩
+	{r: 0x9F6D, men: 2, ku: 94, ten: 83},  // 齭
+	{r: 0x9F70, men: 2, ku: 94, ten: 84},  // 齰
+	{r: 0x9F75, men: 2, ku: 94, ten: 85},  // 齵
+	{r: 0x9F90, men: 1, ku: 94, ten: 86},  // 龐
+	{r: 0x9F94, men: 1, ku: 94, ten: 87},  // 龔
+	{r: 0x9F97, men: 1, ku: 94, ten: 88},  // 龗
+	{r: 0x9FA2, men: 1, ku: 94, ten: 89},  // 龢
+	{r: 0xF91D, men: 1, ku: 86, ten: 27},  // 欄
+	{r: 0xF928, men: 1, ku: 84, ten: 14},  // 廊
+	{r: 0xF929, men: 1, ku: 85, ten: 46},  // 朗
+	{r: 0xF936, men: 1, ku: 91, ten: 47},  // 虜
+	{r: 0xF970, men: 1, ku: 86, ten: 41},  // 殺
+	{r: 0xF9D0, men: 1, ku: 94, ten: 4},   // 類
+	{r: 0xF9DC, men: 1, ku: 93, ten: 61},  // 隆
+	{r: 0xFA0F, men: 1, ku: 15, ten: 43},  // 﨏
+	{r: 0xFA10, men: 1, ku: 15, ten: 55},  // 塚
+	{r: 0xFA11, men: 1, ku: 47, ten: 82},  // 﨑
+	{r: 0xFA13, men: 2, ku: 14, ten: 89},  // 﨓
+	{r: 0xFA14, men: 1, ku: 85, ten: 90},  // 﨔
+	{r: 0xFA15, men: 1, ku: 87, ten: 58},  // 凞
+	{r: 0xFA16, men: 1, ku: 87, ten: 79},  // 猪
+	{r: 0xFA19, men: 1, ku: 89, ten: 28},  // 神
+	{r: 0xFA1A, men: 1, ku: 89, ten: 29},  // 祥
+	{r: 0xFA1B, men: 1, ku: 89, ten: 33},  // 福
+	{r: 0xFA1F, men: 1, ku: 91, ten: 26},  // 﨟
+	{r: 0xFA20, men: 2, ku: 87, ten: 24},  // 蘒
+	{r: 0xFA21, men: 2, ku: 87, ten: 37},  // 﨡
+	{r: 0xFA22, men: 1, ku: 92, ten: 14},  // 諸
+	{r: 0xFA24, men: 2, ku: 89, ten: 78},  // 﨤
+	{r: 0xFA26, men: 1, ku: 92, ten: 74},  // 都
+	{r: 0xFA30, men: 1, ku: 14, ten: 24},  // 侮
+	{r: 0xFA31, men: 1, ku: 14, ten: 41},  // 僧
+	{r: 0xFA32, men: 1, ku: 14, ten: 48},  // 免
+	{r: 0xFA33, men: 1, ku: 14, ten: 67},  // 勉
+	{r: 0xFA34, men: 1, ku: 14, ten: 72},  // 勤
+	{r: 0xFA35, men: 1, ku: 14, ten: 78},  // 卑
+	{r: 0xFA36, men: 1, ku: 15, ten: 12},  // 喝
+	{r: 0xFA37, men: 1, ku: 15, ten: 15},  // 嘆
+	{r: 0xFA38, men: 1, ku: 15, ten: 22},  // 器
+	{r: 0xFA39, men: 1, ku: 15, ten: 58},  // 塀
+	{r: 0xFA3A, men: 1, ku: 15, ten: 62},  // 墨
+	{r: 0xFA3B, men: 1, ku: 47, ten: 65},  // 層
+	{r: 0xFA3C, men: 1, ku: 47, ten: 66},  // 屮
+	{r: 0xFA3D, men: 1, ku: 84, ten: 48},  // 悔
+	{r: 0xFA3E, men: 1, ku: 84, ten: 60},  // 慨
+	{r: 0xFA3F, men: 1, ku: 84, ten: 62},  // 憎
+	{r: 0xFA40, men: 1, ku: 84, ten: 65},  // 懲
+	{r: 0xFA41, men: 1, ku: 85, ten: 8},   // 敏
+	{r: 0xFA42, men: 1, ku: 85, ten: 11},  // 既
+	{r: 0xFA43, men: 1, ku: 85, ten: 35},  // 暑
+	{r: 0xFA44, men: 1, ku: 85, ten: 69},  // 梅
+	{r: 0xFA45, men: 1, ku: 86, ten: 73},  // 海
+	{r: 0xFA46, men: 1, ku: 86, ten: 87},  // 渚
+	{r: 0xFA47, men: 1, ku: 87, ten: 5},   // 漢
+	{r: 0xFA48, men: 1, ku: 87, ten: 53},  // 煮
+	{r: 0xFA49, men: 2, ku: 80, ten: 9},   // 爫
+	{r: 0xFA4A, men: 1, ku: 88, ten: 5},   // 琢
+	{r: 0xFA4B, men: 1, ku: 89, ten: 7},   // 碑
+	{r: 0xFA4C, men: 1, ku: 89, ten: 19},  // 社
+	{r: 0xFA4D, men: 1, ku: 89, ten: 20},  // 祉
+	{r: 0xFA4E, men: 1, ku: 89, ten: 23},  // 祈
+	{r: 0xFA4F, men: 1, ku: 89, ten: 24},  // 祐
+	{r: 0xFA50, men: 1, ku: 89, ten: 25},  // 祖
+	{r: 0xFA51, men: 1, ku: 89, ten: 27},  // 祝
+	{r: 0xFA52, men: 1, ku: 89, ten: 31},  // 禍
+	{r: 0xFA53, men: 1, ku: 89, ten: 32},  // 禎
+	{r: 0xFA54, men: 1, ku: 89, ten: 45},  // 穀
+	{r: 0xFA55, men: 1, ku: 89, ten: 49},  // 突
+	{r: 0xFA56, men: 1, ku: 89, ten: 68},  // 節
+	{r: 0xFA57, men: 1, ku: 90, ten: 14},  // 練
+	{r: 0xFA58, men: 2, ku: 84, ten: 48},  // 縉
+	{r: 0xFA59, men: 1, ku: 90, ten: 19},  // 繁
+	{r: 0xFA5A, men: 1, ku: 90, ten: 26},  // 署
+	{r: 0xFA5B, men: 1, ku: 90, ten: 36},  // 者
+	{r: 0xFA5C, men: 1, ku: 90, ten: 56},  // 臭
+	{r: 0xFA5D, men: 2, ku: 85, ten: 84},  // 艹
+	{r: 0xFA5E, men: 2, ku: 85, ten: 85},  // 艹
+	{r: 0xFA5F, men: 1, ku: 91, ten: 7},   // 著
+	{r: 0xFA60, men: 1, ku: 91, ten: 79},  // 褐
+	{r: 0xFA61, men: 1, ku: 91, ten: 89},  // 視
+	{r: 0xFA62, men: 1, ku: 92, ten: 15},  // 謁
+	{r: 0xFA63, men: 1, ku: 92, ten: 16},  // 謹
+	{r: 0xFA64, men: 1, ku: 92, ten: 24},  // 賓
+	{r: 0xFA65, men: 1, ku: 92, ten: 29},  // 贈
+	{r: 0xFA66, men: 2, ku: 89, ten: 73},  // 辶
+	{r: 0xFA67, men: 1, ku: 92, ten: 57},  // 逸
+	{r: 0xFA68, men: 1, ku: 93, ten: 67},  // 難
+	{r: 0xFA69, men: 1, ku: 93, ten: 86},  // 響
+	{r: 0xFA6A, men: 1, ku: 93, ten: 91},  // 頻
+	{r: 0xFE45, men: 1, ku: 3, ten: 30},   // ﹅
+	{r: 0xFE46, men: 1, ku: 3, ten: 29},   // ﹆
+	{r: 0xFF02, men: 1, ku: 2, ten: 16},   // ＂
+	{r: 0xFF07, men: 1, ku: 2, ten: 15},   // ＇
+	{r: 0x2000B, men: 1, ku: 14, ten: 2},  // 𠀋
+	{r: 0x20089, men: 2, ku: 1, ten: 1},   // 𠂉
+	{r: 0x200A2, men: 2, ku: 1, ten: 11},  // 𠂢
+	{r: 0x200A4, men: 2, ku: 1, ten: 14},  // 𠂤
+	{r: 0x201A2, men: 2, ku: 1, ten: 22},  // 𠆢
+	{r: 0x20213, men: 2, ku: 1, ten: 38},  // 𠈓
+	{r: 0x2032B, men: 2, ku: 1, ten: 80},  // 𠌫
+	{r: 0x20371, men: 2, ku: 1, ten: 89},  // 𠍱
+	{r: 0x20381, men: 2, ku: 1, ten: 87},  // 𠎁
+	{r: 0x203F9, men: 2, ku: 3, ten: 2},   // 𠏹
+	{r: 0x2044A, men: 2, ku: 3, ten: 5},   // 𠑊
+	{r: 0x20509, men: 2, ku: 3, ten: 7},   // 𠔉
+	{r: 0x205D6, men: 2, ku: 3, ten: 17},  // 𠗖
+	{r: 0x20628, men: 2, ku: 3, ten: 18},  // 𠘨
+	{r: 0x2074F, men: 2, ku: 3, ten: 24},  // 𠝏
+	{r: 0x20807, men: 2, ku: 3, ten: 31},  // 𠠇
+	{r: 0x2083A, men: 2, ku: 3, ten: 33},  // 𠠺
+	{r: 0x208B9, men: 2, ku: 3, ten: 42},  // 𠢹
+	{r: 0x2097C, men: 2, ku: 3, ten: 50},  // 𠥼
+	{r: 0x2099D, men: 2, ku: 3, ten: 51},  // 𠦝
+	{r: 0x20AD3, men: 2, ku: 3, ten: 57},  // 𠫓
+	{r: 0x20B1D, men: 2, ku: 3, ten: 60},  // 𠬝
+	{r: 0x20B9F, men: 1, ku: 47, ten: 52}, // 𠮟
+	{r: 0x20D45, men: 2, ku: 3, ten: 87},  // 𠵅
+	{r: 0x20DE1, men: 2, ku: 4, ten: 10},  // 𠷡
+	{r: 0x20E64, men: 2, ku: 4, ten: 26},  // 𠹤
+	{r: 0x20E6D, men: 2, ku: 4, ten: 18},  // 𠹭
+	{r: 0x20E95, men: 2, ku: 4, ten: 17},  // 𠺕
+	{r: 0x20F5F, men: 2, ku: 4, ten: 29},  // 𠽟
+	{r: 0x21201, men: 2, ku: 4, ten: 57},  // 𡈁
+	{r: 0x2123D, men: 1, ku: 15, ten: 34}, // 𡈽
+	{r: 0x21255, men: 2, ku: 4, ten: 60},  // 𡉕
+	{r: 0x21274, men: 2, ku: 4, ten: 67},  // 𡉴
+	{r: 0x2127B, men: 2, ku: 4, ten: 62},  // 𡉻
+	{r: 0x212D7, men: 2, ku: 4, ten: 75},  // 𡋗
+	{r: 0x212E4, men: 2, ku: 4, ten: 74},  // 𡋤
+	{r: 0x212FD, men: 2, ku: 4, ten: 82},  // 𡋽
+	{r: 0x2131B, men: 1, ku: 15, ten: 44}, // 𡌛
+	{r: 0x21336, men: 2, ku: 4, ten: 84},  // 𡌶
+	{r: 0x21344, men: 2, ku: 4, ten: 85},  // 𡍄
+	{r: 0x213C4, men: 2, ku: 5, ten: 5},   // 𡏄
+	{r: 0x2146D, men: 2, ku: 5, ten: 18},  // 𡑭
+	{r: 0x2146E, men: 1, ku: 15, ten: 64}, // 𡑮
+	{r: 0x215D7, men: 2, ku: 5, ten: 30},  // 𡗗
+	{r: 0x21647, men: 2, ku: 5, ten: 39},  // 𡙇
+	{r: 0x216B4, men: 1, ku: 47, ten: 67}, // 𡚴
+	{r: 0x21706, men: 2, ku: 5, ten: 53},  // 𡜆
+	{r: 0x21742, men: 2, ku: 5, ten: 54},  // 𡝂
+	{r: 0x218BD, men: 1, ku: 15, ten: 91}, // 𡢽
+	{r: 0x219C3, men: 2, ku: 5, ten: 94},  // 𡧃
+	{r: 0x21C56, men: 2, ku: 8, ten: 16},  // 𡱖
+	{r: 0x21D2D, men: 2, ku: 8, ten: 23},  // 𡴭
+	{r: 0x21D45, men: 2, ku: 8, ten: 24},  // 𡵅
+	{r: 0x21D62, men: 2, ku: 8, ten: 27},  // 𡵢
+	{r: 0x21D78, men: 2, ku: 8, ten: 26},  // 𡵸
+	{r: 0x21D92, men: 2, ku: 8, ten: 37},  // 𡶒
+	{r: 0x21D9C, men: 2, ku: 8, ten: 32},  // 𡶜
+	{r: 0x21DA1, men: 2, ku: 8, ten: 31},  // 𡶡
+	{r: 0x21DB7, men: 2, ku: 8, ten: 40},  // 𡶷
+	{r: 0x21DE0, men: 2, ku: 8, ten: 42},  // 𡷠
+	{r: 0x21E33, men: 2, ku: 8, ten: 43},  // 𡸳
+	{r: 0x21E34, men: 1, ku: 47, ten: 78}, // 𡸴
+	{r: 0x21F1E, men: 2, ku: 8, ten: 59},  // 𡼞
+	{r: 0x21F76, men: 2, ku: 8, ten: 70},  // 𡽶
+	{r: 0x21FFA, men: 2, ku: 8, ten: 76},  // 𡿺
+	{r: 0x2217B, men: 2, ku: 12, ten: 2},  // 𢅻
+	{r: 0x22218, men: 2, ku: 94, ten: 51}, // 𢈘
+	{r: 0x2231E, men: 2, ku: 12, ten: 11}, // 𢌞
+	{r: 0x223AD, men: 2, ku: 12, ten: 16}, // 𢎭
+	{r: 0x226F3, men: 2, ku: 12, ten: 48}, // 𢛳
+	{r: 0x2285B, men: 2, ku: 12, ten: 69}, // 𢡛
+	{r: 0x228AB, men: 2, ku: 12, ten: 77}, // 𢢫
+	{r: 0x2298F, men: 2, ku: 12, ten: 82}, // 𢦏
+	{r: 0x22AB8, men: 2, ku: 13, ten: 4},  // 𢪸
+	{r: 0x22B46, men: 2, ku: 13, ten: 18}, // 𢭆
+	{r: 0x22B4F, men: 2, ku: 13, ten: 9},  // 𢭏
+	{r: 0x22B50, men: 2, ku: 13, ten: 10}, // 𢭐
+	{r: 0x22BA6, men: 2, ku: 13, ten: 21}, // 𢮦
+	{r: 0x22C1D, men: 2, ku: 13, ten: 20}, // 𢰝
+	{r: 0x22C24, men: 2, ku: 13, ten: 25}, // 𢰤
+	{r: 0x22DE1, men: 2, ku: 13, ten: 54}, // 𢷡
+	{r: 0x231B6, men: 2, ku: 14, ten: 4},  // 𣆶
+	{r: 0x231C3, men: 2, ku: 13, ten: 93}, // 𣇃
+	{r: 0x231C4, men: 1, ku: 85, ten: 26}, // 𣇄
+	{r: 0x231F5, men: 2, ku: 14, ten: 3},  // 𣇵
+	{r: 0x23372, men: 2, ku: 14, ten: 26}, // 𣍲
+	{r: 0x233D0, men: 2, ku: 14, ten: 34}, // 𣏐
+	{r: 0x233D2, men: 2, ku: 14, ten: 29}, // 𣏒
+	{r: 0x233D3, men: 2, ku: 14, ten: 28}, // 𣏓
+	{r: 0x233D5, men: 2, ku: 14, ten: 36}, // 𣏕
+	{r: 0x233DA, men: 2, ku: 14, ten: 39}, // 𣏚
+	{r: 0x233DF, men: 2, ku: 14, ten: 41}, // 𣏟
+	{r: 0x233E4, men: 2, ku: 14, ten: 35}, // 𣏤
+	{r: 0x2344A, men: 2, ku: 14, ten: 53}, // 𣑊
+	{r: 0x2344B, men: 2, ku: 14, ten: 55}, // 𣑋
+	{r: 0x23451, men: 2, ku: 14, ten: 54}, // 𣑑
+	{r: 0x23465, men: 2, ku: 14, ten: 59}, // 𣑥
+	{r: 0x234E4, men: 2, ku: 14, ten: 87}, // 𣓤
+	{r: 0x2355A, men: 2, ku: 14, ten: 88}, // 𣕚
+	{r: 0x23594, men: 2, ku: 15, ten: 10}, // 𣖔
+	{r: 0x235C4, men: 1, ku: 85, ten: 82}, // 𣗄
+	{r: 0x23638, men: 2, ku: 15, ten: 34}, // 𣘸
+	{r: 0x23639, men: 2, ku: 15, ten: 31}, // 𣘹
+	{r: 0x2363A, men: 2, ku: 15, ten: 35}, // 𣘺
+	{r: 0x23647, men: 2, ku: 15, ten: 32}, // 𣙇
+	{r: 0x2370C, men: 2, ku: 15, ten: 57}, // 𣜌
+	{r: 0x2371C, men: 2, ku: 15, ten: 46}, // 𣜜
+	{r: 0x2373F, men: 1, ku: 86, ten: 9},  // 𣜿
+	{r: 0x23763, men: 1, ku: 86, ten: 18}, // 𣝣
+	{r: 0x23764, men: 2, ku: 15, ten: 65}, // 𣝤
+	{r: 0x237E7, men: 2, ku: 15, ten: 74}, // 𣟧
+	{r: 0x237FF, men: 2, ku: 15, ten: 73}, // 𣟿
+	{r: 0x23824, men: 2, ku: 15, ten: 80}, // 𣠤
+	{r: 0x2383D, men: 2, ku: 15, ten: 85}, // 𣠽
+	{r: 0x23A98, men: 2, ku: 78, ten: 3},  // 𣪘
+	{r: 0x23C7F, men: 2, ku: 78, ten: 20}, // 𣱿
+	{r: 0x23CFE, men: 1, ku: 86, ten: 64}, // 𣳾
+	{r: 0x23D00, men: 2, ku: 78, ten: 41}, // 𣴀
+	{r: 0x23D0E, men: 2, ku: 84, ten: 85}, // 𣴎
+	{r: 0x23D40, men: 2, ku: 78, ten: 60}, // 𣵀
+	{r: 0x23DD3, men: 2, ku: 78, ten: 64}, // 𣷓
+	{r: 0x23DF9, men: 2, ku: 78, ten: 63}, // 𣷹
+	{r: 0x23DFA, men: 2, ku: 78, ten: 62}, // 𣷺
+	{r: 0x23F7E, men: 2, ku: 79, ten: 18}, // 𣽾
+	{r: 0x24096, men: 2, ku: 79, ten: 39}, // 𤂖
+	{r: 0x24103, men: 2, ku: 79, ten: 45}, // 𤄃
+	{r: 0x241C6, men: 2, ku: 79, ten: 65}, // 𤇆
+	{r: 0x241FE, men: 2, ku: 79, ten: 68}, // 𤇾
+	{r: 0x243BC, men: 2, ku: 80, ten: 2},  // 𤎼
+	{r: 0x24629, men: 2, ku: 80, ten: 19}, // 𤘩
+	{r: 0x246A5, men: 2, ku: 80, ten: 25}, // 𤚥
+	{r: 0x247F1, men: 1, ku: 87, ten: 76}, // 𤟱
+	{r: 0x24896, men: 2, ku: 80, ten: 51}, // 𤢖
+	{r: 0x24A4D, men: 2, ku: 80, ten: 91}, // 𤩍
+	{r: 0x24B56, men: 2, ku: 81, ten: 14}, // 𤭖
+	{r: 0x24B6F, men: 2, ku: 81, ten: 16}, // 𤭯
+	{r: 0x24C16, men: 2, ku: 81, ten: 21}, // 𤰖
+	{r: 0x24D14, men: 2, ku: 81, ten: 36}, // 𤴔
+	{r: 0x24E0E, men: 2, ku: 81, ten: 61}, // 𤸎
+	{r: 0x24E37, men: 2, ku: 81, ten: 65}, // 𤸷
+	{r: 0x24E6A, men: 2, ku: 81, ten: 70}, // 𤹪
+	{r: 0x24E8B, men: 2, ku: 81, ten: 73}, // 𤺋
+	{r: 0x2504A, men: 2, ku: 81, ten: 85}, // 𥁊
+	{r: 0x25055, men: 2, ku: 81, ten: 87}, // 𥁕
+	{r: 0x25122, men: 2, ku: 81, ten: 90}, // 𥄢
+	{r: 0x251A9, men: 2, ku: 82, ten: 1},  // 𥆩
+	{r: 0x251CD, men: 2, ku: 82, ten: 4},  // 𥇍
+	{r: 0x251E5, men: 2, ku: 82, ten: 3},  // 𥇥
+	{r: 0x2521E, men: 2, ku: 82, ten: 8},  // 𥈞
+	{r: 0x2524C, men: 2, ku: 82, ten: 12}, // 𥉌
+	{r: 0x2542E, men: 2, ku: 82, ten: 29}, // 𥐮
+	{r: 0x2548E, men: 1, ku: 88, ten: 94}, // 𥒎
+	{r: 0x254D9, men: 2, ku: 82, ten: 40}, // 𥓙
+	{r: 0x2550E, men: 1, ku: 89, ten: 9},  // 𥔎
+	{r: 0x255A7, men: 2, ku: 82, ten: 59}, // 𥖧
+	{r: 0x25771, men: 1, ku: 89, ten: 39}, // 𥝱
+	{r: 0x257A9, men: 2, ku: 82, ten: 85}, // 𥞩
+	{r: 0x257B4, men: 2, ku: 82, ten: 86}, // 𥞴
+	{r: 0x259C4, men: 1, ku: 89, ten: 52}, // 𥧄
+	{r: 0x259D4, men: 2, ku: 83, ten: 18}, // 𥧔
+	{r: 0x25AE3, men: 2, ku: 83, ten: 30}, // 𥫣
+	{r: 0x25AE4, men: 2, ku: 83, ten: 29}, // 𥫤
+	{r: 0x25AF1, men: 2, ku: 83, ten: 32}, // 𥫱
+	{r: 0x25BB2, men: 2, ku: 83, ten: 50}, // 𥮲
+	{r: 0x25C4B, men: 2, ku: 83, ten: 61}, // 𥱋
+	{r: 0x25C64, men: 2, ku: 83, ten: 62}, // 𥱤
+	{r: 0x25DA1, men: 1, ku: 89, ten: 78}, // 𥶡
+	{r: 0x25E2E, men: 2, ku: 83, ten: 83}, // 𥸮
+	{r: 0x25E56, men: 2, ku: 83, ten: 84}, // 𥹖
+	{r: 0x25E62, men: 2, ku: 83, ten: 87}, // 𥹢
+	{r: 0x25E65, men: 2, ku: 83, ten: 85}, // 𥹥
+	{r: 0x25EC2, men: 2, ku: 83, ten: 93}, // 𥻂
+	{r: 0x25ED8, men: 2, ku: 83, ten: 91}, // 𥻘
+	{r: 0x25EE8, men: 2, ku: 84, ten: 2},  // 𥻨
+	{r: 0x25F23, men: 2, ku: 84, ten: 4},  // 𥼣
+	{r: 0x25F5C, men: 2, ku: 84, ten: 7},  // 𥽜
+	{r: 0x25FD4, men: 2, ku: 84, ten: 15}, // 𥿔
+	{r: 0x25FE0, men: 2, ku: 84, ten: 14}, // 𥿠
+	{r: 0x25FFB, men: 2, ku: 84, ten: 21}, // 𥿻
+	{r: 0x2600C, men: 2, ku: 84, ten: 20}, // 𦀌
+	{r: 0x26017, men: 2, ku: 84, ten: 29}, // 𦀗
+	{r: 0x26060, men: 2, ku: 84, ten: 34}, // 𦁠
+	{r: 0x260ED, men: 2, ku: 84, ten: 47}, // 𦃭
+	{r: 0x26270, men: 2, ku: 84, ten: 73}, // 𦉰
+	{r: 0x26286, men: 2, ku: 84, ten: 75}, // 𦊆
+	{r: 0x2634C, men: 2, ku: 84, ten: 82}, // 𦍌
+	{r: 0x26402, men: 2, ku: 84, ten: 89}, // 𦐂
+	{r: 0x2667E, men: 2, ku: 85, ten: 21}, // 𦙾
+	{r: 0x266B0, men: 2, ku: 85, ten: 26}, // 𦚰
+	{r: 0x2671D, men: 2, ku: 85, ten: 38}, // 𦜝
+	{r: 0x268DD, men: 2, ku: 85, ten: 54}, // 𦣝
+	{r: 0x268EA, men: 2, ku: 85, ten: 56}, // 𦣪
+	{r: 0x26951, men: 2, ku: 85, ten: 58}, // 𦥑
+	{r: 0x2696F, men: 2, ku: 85, ten: 61}, // 𦥯
+	{r: 0x269DD, men: 2, ku: 85, ten: 63}, // 𦧝
+	{r: 0x26A1E, men: 2, ku: 85, ten: 67}, // 𦨞
+	{r: 0x26A58, men: 2, ku: 85, ten: 74}, // 𦩘
+	{r: 0x26A8C, men: 2, ku: 85, ten: 80}, // 𦪌
+	{r: 0x26AB7, men: 2, ku: 85, ten: 83}, // 𦪷
+	{r: 0x26AFF, men: 1, ku: 90, ten: 61}, // 𦫿
+	{r: 0x26C29, men: 2, ku: 5, ten: 36},  // 𦰩
+	{r: 0x26C73, men: 2, ku: 86, ten: 36}, // 𦱳
+	{r: 0x26CDD, men: 2, ku: 86, ten: 46}, // 𦳝
+	{r: 0x26E40, men: 1, ku: 91, ten: 19}, // 𦹀
+	{r: 0x26E65, men: 2, ku: 86, ten: 61}, // 𦹥
+	{r: 0x26F94, men: 2, ku: 86, ten: 85}, // 𦾔
+	{r: 0x26FF6, men: 2, ku: 87, ten: 1},  // 𦿶
+	{r: 0x26FF7, men: 2, ku: 87, ten: 2},  // 𦿷
+	{r: 0x26FF8, men: 2, ku: 86, ten: 94}, // 𦿸
+	{r: 0x270F4, men: 1, ku: 91, ten: 41}, // 𧃴
+	{r: 0x2710D, men: 2, ku: 87, ten: 19}, // 𧄍
+	{r: 0x27139, men: 2, ku: 87, ten: 22}, // 𧄹
+	{r: 0x273DA, men: 2, ku: 87, ten: 69}, // 𧏚
+	{r: 0x273DB, men: 2, ku: 87, ten: 68}, // 𧏛
+	{r: 0x273FE, men: 2, ku: 87, ten: 75}, // 𧏾
+	{r: 0x27410, men: 2, ku: 87, ten: 78}, // 𧐐
+	{r: 0x27449, men: 2, ku: 87, ten: 83}, // 𧑉
+	{r: 0x27614, men: 2, ku: 88, ten: 10}, // 𧘔
+	{r: 0x27615, men: 2, ku: 88, ten: 9},  // 𧘕
+	{r: 0x27631, men: 2, ku: 88, ten: 12}, // 𧘱
+	{r: 0x27684, men: 1, ku: 91, ten: 76}, // 𧚄
+	{r: 0x27693, men: 2, ku: 88, ten: 20}, // 𧚓
+	{r: 0x2770E, men: 2, ku: 88, ten: 28}, // 𧜎
+	{r: 0x27723, men: 2, ku: 88, ten: 30}, // 𧜣
+	{r: 0x27752, men: 2, ku: 88, ten: 34}, // 𧝒
+	{r: 0x27985, men: 2, ku: 88, ten: 54}, // 𧦅
+	{r: 0x27A84, men: 2, ku: 88, ten: 67}, // 𧪄
+	{r: 0x27BB3, men: 2, ku: 88, ten: 87}, // 𧮳
+	{r: 0x27BBE, men: 2, ku: 88, ten: 89}, // 𧮾
+	{r: 0x27BC7, men: 2, ku: 88, ten: 90}, // 𧯇
+	{r: 0x27CB8, men: 2, ku: 89, ten: 5},  // 𧲸
+	{r: 0x27DA0, men: 2, ku: 89, ten: 15}, // 𧶠
+	{r: 0x27E10, men: 2, ku: 89, ten: 18}, // 𧸐
+	{r: 0x27FB7, men: 2, ku: 89, ten: 25}, // 𧾷
+	{r: 0x2808A, men: 2, ku: 89, ten: 34}, // 𨂊
+	{r: 0x280BB, men: 2, ku: 89, ten: 40}, // 𨂻
+	{r: 0x28277, men: 1, ku: 92, ten: 41}, // 𨉷
+	{r: 0x28282, men: 2, ku: 89, ten: 57}, // 𨊂
+	{r: 0x282F3, men: 2, ku: 89, ten: 62}, // 𨋳
+	{r: 0x283CD, men: 1, ku: 92, ten: 49}, // 𨏍
+	{r: 0x2840C, men: 2, ku: 89, ten: 70}, // 𨐌
+	{r: 0x28455, men: 2, ku: 89, ten: 75}, // 𨑕
+	{r: 0x2856B, men: 2, ku: 89, ten: 90}, // 𨕫
+	{r: 0x285C8, men: 2, ku: 89, ten: 94}, // 𨗈
+	{r: 0x285C9, men: 2, ku: 90, ten: 1},  // 𨗉
+	{r: 0x286D7, men: 2, ku: 90, ten: 12}, // 𨛗
+	{r: 0x286FA, men: 2, ku: 90, ten: 15}, // 𨛺
+	{r: 0x28946, men: 2, ku: 90, ten: 48}, // 𨥆
+	{r: 0x28949, men: 2, ku: 90, ten: 47}, // 𨥉
+	{r: 0x2896B, men: 2, ku: 90, ten: 55}, // 𨥫
+	{r: 0x28987, men: 2, ku: 90, ten: 69}, // 𨦇
+	{r: 0x28988, men: 2, ku: 90, ten: 70}, // 𨦈
+	{r: 0x289BA, men: 2, ku: 90, ten: 81}, // 𨦺
+	{r: 0x289BB, men: 2, ku: 90, ten: 82}, // 𨦻
+	{r: 0x28A1E, men: 2, ku: 90, ten: 94}, // 𨨞
+	{r: 0x28A29, men: 2, ku: 91, ten: 1},  // 𨨩
+	{r: 0x28A43, men: 2, ku: 91, ten: 13}, // 𨩃
+	{r: 0x28A71, men: 2, ku: 91, ten: 12}, // 𨩱
+	{r: 0x28A99, men: 2, ku: 91, ten: 22}, // 𨪙
+	{r: 0x28ACD, men: 2, ku: 91, ten: 23}, // 𨫍
+	{r: 0x28ADD, men: 2, ku: 91, ten: 30}, // 𨫝
+	{r: 0x28AE4, men: 2, ku: 91, ten: 29}, // 𨫤
+	{r: 0x28BC1, men: 2, ku: 91, ten: 46}, // 𨯁
+	{r: 0x28BEF, men: 2, ku: 91, ten: 47}, // 𨯯
+	{r: 0x28D10, men: 2, ku: 91, ten: 55}, // 𨴐
+	{r: 0x28D71, men: 2, ku: 91, ten: 58}, // 𨵱
+	{r: 0x28DFB, men: 2, ku: 91, ten: 60}, // 𨷻
+	{r: 0x28E1F, men: 2, ku: 91, ten: 61}, // 𨸟
+	{r: 0x28E36, men: 2, ku: 91, ten: 65}, // 𨸶
+	{r: 0x28E89, men: 2, ku: 91, ten: 69}, // 𨺉
+	{r: 0x28EEB, men: 2, ku: 91, ten: 71}, // 𨻫
+	{r: 0x28F32, men: 2, ku: 91, ten: 73}, // 𨼲
+	{r: 0x28FF8, men: 2, ku: 91, ten: 81}, // 𨿸
+	{r: 0x292A0, men: 2, ku: 92, ten: 2},  // 𩊠
+	{r: 0x292B1, men: 2, ku: 92, ten: 3},  // 𩊱
+	{r: 0x29490, men: 2, ku: 92, ten: 24}, // 𩒐
+	{r: 0x295CF, men: 2, ku: 92, ten: 34}, // 𩗏
+	{r: 0x2967F, men: 2, ku: 92, ten: 44}, // 𩙿
+	{r: 0x296F0, men: 2, ku: 92, ten: 54}, // 𩛰
+	{r: 0x29719, men: 2, ku: 92, ten: 57}, // 𩜙
+	{r: 0x29750, men: 2, ku: 92, ten: 61}, // 𩝐
+	{r: 0x298C6, men: 2, ku: 92, ten: 86}, // 𩣆
+	{r: 0x29A72, men: 2, ku: 93, ten: 12}, // 𩩲
+	{r: 0x29DDB, men: 2, ku: 93, ten: 43}, // 𩷛
+	{r: 0x29E15, men: 2, ku: 93, ten: 57}, // 𩸕
+	{r: 0x29E3D, men: 2, ku: 93, ten: 44}, // 𩸽
+	{r: 0x29E49, men: 2, ku: 93, ten: 61}, // 𩹉
+	{r: 0x29E8A, men: 2, ku: 93, ten: 59}, // 𩺊
+	{r: 0x29EC4, men: 2, ku: 93, ten: 71}, // 𩻄
+	{r: 0x29EDB, men: 2, ku: 93, ten: 80}, // 𩻛
+	{r: 0x29EE9, men: 2, ku: 93, ten: 77}, // 𩻩
+	{r: 0x29FCE, men: 2, ku: 94, ten: 5},  // 𩿎
+	{r: 0x2A01A, men: 2, ku: 94, ten: 11}, // 𪀚
+	{r: 0x2A02F, men: 2, ku: 94, ten: 9},  // 𪀯
+	{r: 0x2A082, men: 2, ku: 94, ten: 21}, // 𪂂
+	{r: 0x2A0F9, men: 2, ku: 94, ten: 18}, // 𪃹
+	{r: 0x2A190, men: 1, ku: 94, ten: 70}, // 𪆐
+	{r: 0x2A38C, men: 2, ku: 94, ten: 56}, // 𪎌
+	{r: 0x2A437, men: 2, ku: 94, ten: 58}, // 𪐷
+	{r: 0x2A5F1, men: 2, ku: 94, ten: 78}, // 𪗱
+	{r: 0x2A602, men: 2, ku: 94, ten: 80}, // 𪘂
+	{r: 0x2A61A, men: 2, ku: 94, ten: 82}, // 𪘚
+	{r: 0x2A6B2, men: 2, ku: 94, ten: 86}, // 𪚲
+}
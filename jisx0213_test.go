@@ -0,0 +1,46 @@
+package jisx0208
+
+import (
+	"testing"
+)
+
+func TestJISX0213Table(t *testing.T) {
+	for i, v := range jisx0213Table {
+		if i != 0 && jisx0213Table[i-1].r >= v.r {
+			t.Errorf("table is not sorted, %U, %U", jisx0213Table[i-1].r, v.r)
+		}
+		if Is(v.r) {
+			t.Errorf("%U is in JIS X 0208", v.r)
+		}
+		if v.men < 1 || v.men > 2 || v.ku < 1 || v.ku > 94 || v.ten < 1 || v.ten > 94 {
+			t.Errorf("%U, invalid code point %v", v.r, v.menKuTen())
+		}
+	}
+}
+
+func TestJISX0213(t *testing.T) {
+	tests := []struct {
+		rune rune
+		want MenKuTen
+		ok   bool
+	}{
+		{rune: '𠮟', want: MenKuTen{Men: 1, Ku: 47, Ten: 52}, ok: true},
+		{rune: '鷗', want: MenKuTen{Men: 1, Ku: 94, Ten: 69}, ok: true},
+		{rune: '剝', want: MenKuTen{Men: 1, Ku: 15, Ten: 94}, ok: true},
+		{rune: '胅', want: MenKuTen{Men: 2, Ku: 85, Ten: 22}, ok: true},
+		{rune: '髙', ok: false},
+		{rune: '高', ok: false},
+	}
+	for _, v := range tests {
+		got, ok := JISX0213(v.rune)
+		if got != v.want || ok != v.ok {
+			t.Errorf("JISX0213(%c) = %v, %v, want %v, %v", v.rune, got, ok, v.want, v.ok)
+		}
+		if !ok {
+			continue
+		}
+		if r, ok := got.Rune(); r != v.rune || !ok {
+			t.Errorf("%v.Rune() = %c, %v, want %c, true", got, r, ok, v.rune)
+		}
+	}
+}
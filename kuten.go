@@ -0,0 +1,122 @@
+package jisx0208
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Kuten represents a JIS X 0208 code point by row (区) and cell (点), both from 1 to 94.
+type Kuten struct {
+	Ku  int
+	Ten int
+}
+
+// String returns the kuten notation of the code point, e.g. 25-66.
+func (k Kuten) String() string {
+	return fmt.Sprintf("%d-%d", k.Ku, k.Ten)
+}
+
+// Valid reports whether the row and the cell are in range.
+func (k Kuten) Valid() bool {
+	return k.Ku >= 1 && k.Ku <= 94 && k.Ten >= 1 && k.Ten <= 94
+}
+
+// Rune returns the rune at the code point k if it is assigned.
+func (k Kuten) Rune() (rune, bool) {
+	if !k.Valid() {
+		return 0, false
+	}
+	r := kutenTable[k.Ku-1][k.Ten-1]
+	return r, r != 0
+}
+
+// JIS returns the JIS (ISO-2022-JP) code of the code point, e.g. 0x3B33.
+func (k Kuten) JIS() uint16 {
+	return uint16(k.Ku+0x20)<<8 | uint16(k.Ten+0x20)
+}
+
+// EUCJP returns the EUC-JP code of the code point, e.g. 0xBBB3.
+func (k Kuten) EUCJP() uint16 {
+	return k.JIS() | 0x8080
+}
+
+// ShiftJIS returns the Shift_JIS code of the code point, e.g. 0x8DB2.
+func (k Kuten) ShiftJIS() uint16 {
+	var hi, lo int
+	if k.Ku <= 62 {
+		hi = (k.Ku+1)/2 + 0x80
+	} else {
+		hi = (k.Ku+1)/2 + 0xC0
+	}
+	if k.Ku%2 == 1 {
+		lo = k.Ten + 0x3F
+		if k.Ten >= 64 {
+			lo++
+		}
+	} else {
+		lo = k.Ten + 0x9E
+	}
+	return uint16(hi<<8 | lo)
+}
+
+// KutenOf returns the code point of the rune r if r is a JIS X 0208 double-byte character.
+// ASCII characters are not in the code table.
+func KutenOf(r rune) (Kuten, bool) {
+	kutenIndexOnce.Do(func() {
+		kutenIndex = make(map[rune]Kuten, 6879)
+		for i, row := range kutenTable {
+			for j, r := range row {
+				if r != 0 {
+					kutenIndex[r] = Kuten{Ku: i + 1, Ten: j + 1}
+				}
+			}
+		}
+	})
+	k, ok := kutenIndex[r]
+	return k, ok
+}
+
+// KutenFromJIS returns the code point of the JIS code c. It does not check whether the code point is assigned.
+func KutenFromJIS(c uint16) (Kuten, bool) {
+	k := Kuten{Ku: int(c>>8) - 0x20, Ten: int(c&0xFF) - 0x20}
+	return k, k.Valid()
+}
+
+// KutenFromEUCJP returns the code point of the EUC-JP code c. It does not check whether the code point is assigned.
+func KutenFromEUCJP(c uint16) (Kuten, bool) {
+	if c&0x8080 != 0x8080 {
+		return Kuten{}, false
+	}
+	return KutenFromJIS(c &^ 0x8080)
+}
+
+// KutenFromShiftJIS returns the code point of the Shift_JIS code c. It does not check whether the code point is assigned.
+func KutenFromShiftJIS(c uint16) (Kuten, bool) {
+	hi, lo := int(c>>8), int(c&0xFF)
+	var ku, ten int
+	switch {
+	case hi >= 0x81 && hi <= 0x9F:
+		ku = (hi - 0x81) * 2
+	case hi >= 0xE0 && hi <= 0xEF:
+		ku = (hi - 0xC1) * 2
+	default:
+		return Kuten{}, false
+	}
+	switch {
+	case lo >= 0x40 && lo <= 0x7E:
+		ku, ten = ku+1, lo-0x3F
+	case lo >= 0x80 && lo <= 0x9E:
+		ku, ten = ku+1, lo-0x40
+	case lo >= 0x9F && lo <= 0xFC:
+		ku, ten = ku+2, lo-0x9E
+	default:
+		return Kuten{}, false
+	}
+	k := Kuten{Ku: ku, Ten: ten}
+	return k, k.Valid()
+}
+
+var (
+	kutenIndexOnce sync.Once
+	kutenIndex     map[rune]Kuten
+)
@@ -0,0 +1,97 @@
+package jisx0208
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestKutenTable(t *testing.T) {
+	var n int
+	for i, row := range kutenTable {
+		for j, r := range row {
+			if r == 0 {
+				continue
+			}
+			n++
+			if !Is(r) {
+				t.Errorf("%d-%d: %c is not in JIS X 0208", i+1, j+1, r)
+			}
+			if got := IsLevel1(r); got != (i+1 >= 16 && i+1 <= 47) {
+				t.Errorf("%d-%d: IsLevel1(%c) = %v", i+1, j+1, r, got)
+			}
+			if got := IsLevel2(r); got != (i+1 >= 48 && i+1 <= 84) {
+				t.Errorf("%d-%d: IsLevel2(%c) = %v", i+1, j+1, r, got)
+			}
+		}
+	}
+	if want := 6879; n != want {
+		t.Errorf("number of characters = %d, want %d", n, want)
+	}
+	for r := rune(0x80); r <= unicode.MaxRune; r++ {
+		if _, ok := KutenOf(r); ok != Is(r) {
+			t.Errorf("KutenOf(%U) = %v, Is() = %v", r, ok, Is(r))
+		}
+	}
+}
+
+func TestKuten(t *testing.T) {
+	tests := []struct {
+		rune  rune
+		kuten Kuten
+		jis   uint16
+		sjis  uint16
+		euc   uint16
+	}{
+		{rune: '　', kuten: Kuten{Ku: 1, Ten: 1}, jis: 0x2121, sjis: 0x8140, euc: 0xA1A1},
+		{rune: '＼', kuten: Kuten{Ku: 1, Ten: 32}, jis: 0x2140, sjis: 0x815F, euc: 0xA1C0},
+		{rune: '◇', kuten: Kuten{Ku: 1, Ten: 94}, jis: 0x217E, sjis: 0x819E, euc: 0xA1FE},
+		{rune: '亜', kuten: Kuten{Ku: 16, Ten: 1}, jis: 0x3021, sjis: 0x889F, euc: 0xB0A1},
+		{rune: '高', kuten: Kuten{Ku: 25, Ten: 66}, jis: 0x3962, sjis: 0x8D82, euc: 0xB9E2},
+		{rune: '弌', kuten: Kuten{Ku: 48, Ten: 1}, jis: 0x5021, sjis: 0x989F, euc: 0xD0A1},
+		{rune: '熙', kuten: Kuten{Ku: 84, Ten: 6}, jis: 0x7426, sjis: 0xEAA4, euc: 0xF4A6},
+	}
+	for _, v := range tests {
+		k, ok := KutenOf(v.rune)
+		if !ok || k != v.kuten {
+			t.Errorf("KutenOf(%c) = %v, %v, want %v", v.rune, k, ok, v.kuten)
+			continue
+		}
+		if r, ok := k.Rune(); !ok || r != v.rune {
+			t.Errorf("%v.Rune() = %c, %v, want %c", k, r, ok, v.rune)
+		}
+		if got := k.JIS(); got != v.jis {
+			t.Errorf("%v.JIS() = %04X, want %04X", k, got, v.jis)
+		}
+		if got := k.ShiftJIS(); got != v.sjis {
+			t.Errorf("%v.ShiftJIS() = %04X, want %04X", k, got, v.sjis)
+		}
+		if got := k.EUCJP(); got != v.euc {
+			t.Errorf("%v.EUCJP() = %04X, want %04X", k, got, v.euc)
+		}
+		if got, ok := KutenFromJIS(v.jis); !ok || got != k {
+			t.Errorf("KutenFromJIS(%04X) = %v, %v, want %v", v.jis, got, ok, k)
+		}
+		if got, ok := KutenFromShiftJIS(v.sjis); !ok || got != k {
+			t.Errorf("KutenFromShiftJIS(%04X) = %v, %v, want %v", v.sjis, got, ok, k)
+		}
+		if got, ok := KutenFromEUCJP(v.euc); !ok || got != k {
+			t.Errorf("KutenFromEUCJP(%04X) = %v, %v, want %v", v.euc, got, ok, k)
+		}
+	}
+}
+
+func TestKutenFromShiftJIS(t *testing.T) {
+	for ku := 1; ku <= 94; ku++ {
+		for ten := 1; ten <= 94; ten++ {
+			k := Kuten{Ku: ku, Ten: ten}
+			if got, ok := KutenFromShiftJIS(k.ShiftJIS()); !ok || got != k {
+				t.Errorf("KutenFromShiftJIS(%04X) = %v, %v, want %v", k.ShiftJIS(), got, ok, k)
+			}
+		}
+	}
+	for _, c := range []uint16{0x8000, 0x813F, 0x817F, 0x81FD, 0xA0A0, 0xF040, 0xFA40} {
+		if got, ok := KutenFromShiftJIS(c); ok {
+			t.Errorf("KutenFromShiftJIS(%04X) = %v, want invalid", c, got)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+// Package mail checks that the mail messages of RFC 5322 can be represented in ISO-2022-JP,
+// that is, in ASCII and JIS X 0208, and rewrites them into ISO-2022-JP.
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	stdmime "mime"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+	"github.com/ikawaha/jisx0208/mime"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// Violation is a character of a message that cannot be represented in ISO-2022-JP.
+type Violation struct {
+	// Part is the number of the body part, e.g. "1.2", or empty for the message itself.
+	Part string
+	// Header is the name of the header field, or empty for the body.
+	Header string
+	// Line and Column are the 1-based position of the character in the decoded header field value,
+	// which is a single line, or in the decoded body. Column counts characters.
+	Line, Column int
+	// Rune is the character; utf8.RuneError for invalid bytes.
+	Rune rune
+}
+
+// String returns the location and the character of the violation,
+// e.g. `part 1 body: line 3, column 5: U+9AD9 '髙'`.
+func (v Violation) String() string {
+	var b strings.Builder
+	if v.Part != "" {
+		b.WriteString("part " + v.Part + " ")
+	}
+	if v.Header != "" {
+		fmt.Fprintf(&b, "header %s: column %d", v.Header, v.Column)
+	} else {
+		fmt.Fprintf(&b, "body: line %d, column %d", v.Line, v.Column)
+	}
+	fmt.Fprintf(&b, ": %U %q", v.Rune, v.Rune)
+	return b.String()
+}
+
+// Option represents an option for the checker.
+type Option func(c *Checker)
+
+// Discriminator is a checker option to set the discriminator of valid characters.
+func Discriminator(d *jisx0208.Discriminator) Option {
+	return func(c *Checker) {
+		c.discriminator = d
+	}
+}
+
+// Replacement is a checker option to set the replacement string, which may be empty,
+// of invalid characters on rewriting. The default is jisx0208.AozoraGeta.
+func Replacement(s string) Option {
+	return func(c *Checker) {
+		c.replacement = s
+	}
+}
+
+// Checker checks mail messages.
+type Checker struct {
+	discriminator *jisx0208.Discriminator
+	replacement   string
+}
+
+// NewChecker returns a checker of mail messages.
+func NewChecker(options ...Option) *Checker {
+	ret := Checker{replacement: jisx0208.AozoraGeta}
+	for _, option := range options {
+		option(&ret)
+	}
+	return &ret
+}
+
+// Check reads a message and returns the violations in the header fields and the text bodies.
+// See Checker.Check for details.
+func Check(r io.Reader) ([]Violation, error) {
+	return NewChecker().Check(r)
+}
+
+// Rewrite reads a message and writes it rewritten into ISO-2022-JP.
+// See Checker.Rewrite for details.
+func Rewrite(w io.Writer, r io.Reader) ([]Violation, error) {
+	return NewChecker().Rewrite(w, r)
+}
+
+// Check reads a message and returns the violations in the header fields and the text bodies.
+// Header fields are checked after decoding the encoded words, and text/plain bodies that are not
+// attachments are checked after decoding the quoted-printable or base64 transfer encoding and the charset.
+func (c *Checker) Check(r io.Reader) ([]Violation, error) {
+	e, err := readEntity(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.walk(e, "", false)
+}
+
+// Rewrite reads a message and writes it rewritten into ISO-2022-JP, replacing the violations
+// with the replacement, and returns the violations. Header fields that contain non-ASCII
+// characters are encoded in ISO-2022-JP encoded words; for address fields, the display names are encoded.
+// Text bodies that contain non-ASCII characters are converted into ISO-2022-JP in 7bit.
+// The other parts of the message are written as is.
+func (c *Checker) Rewrite(w io.Writer, r io.Reader) ([]Violation, error) {
+	e, err := readEntity(r)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := c.walk(e, "", true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(e.bytes()); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func readEntity(r io.Reader) (*entity, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseEntity(b)
+}
+
+// walk checks the entity and its body parts, and rewrites them if rewrite is true.
+func (c *Checker) walk(e *entity, part string, rewrite bool) ([]Violation, error) {
+	var ret []Violation
+	for _, f := range e.header {
+		v, err := c.field(e, f, part, rewrite)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v...)
+	}
+	for i, p := range e.parts {
+		n := strconv.Itoa(i + 1)
+		if part != "" {
+			n = part + "." + n
+		}
+		v, err := c.walk(p, n, rewrite)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v...)
+	}
+	t, params := e.mediaType()
+	if len(e.delimiters) > 0 || t != "text/plain" || e.isAttachment() {
+		return ret, nil
+	}
+	text, err := e.text(params["charset"])
+	if err != nil {
+		return nil, err
+	}
+	v := c.check(text, part, "")
+	ret = append(ret, v...)
+	if !rewrite || isASCII(text) || (len(v) == 0 && strings.EqualFold(params["charset"], mime.Charset)) {
+		return ret, nil
+	}
+	b, err := jisx0208.ISO2022JP.WithReplacement(c.discriminator, c.replacement).NewEncoder().String(text)
+	if err != nil {
+		return nil, err
+	}
+	params["charset"] = mime.Charset
+	e.body = []byte(b)
+	e.set("Content-Type", stdmime.FormatMediaType(t, params))
+	e.set("Content-Transfer-Encoding", "7bit")
+	if part == "" && e.get("MIME-Version") == "" {
+		e.set("MIME-Version", "1.0")
+	}
+	return ret, nil
+}
+
+// field checks the header field, and rewrites it if rewrite is true.
+func (c *Checker) field(e *entity, f *field, part string, rewrite bool) ([]Violation, error) {
+	raw := f.value()
+	s, err := wordDecoder.DecodeHeader(raw)
+	if err != nil {
+		return nil, err
+	}
+	ret := c.check(s, part, f.name)
+	if !rewrite || !needsRewrite(raw, s, len(ret) > 0) {
+		return ret, nil
+	}
+	var (
+		key = textproto.CanonicalMIMEHeaderKey(f.name)
+		enc = mime.NewEncoder(mime.Discriminator(c.discriminator), mime.Replacement(c.replacement))
+		v   string
+	)
+	switch {
+	case addressFields[key]:
+		list, err := addressParser.ParseList(raw)
+		if err != nil {
+			// leave the field that is not an address list as is
+			return ret, nil
+		}
+		v, err = encodeAddressList(enc, f.name, list)
+		if err != nil {
+			return nil, err
+		}
+	case strings.HasPrefix(key, "Content-"):
+		// structured fields of MIME are left as is
+		return ret, nil
+	default:
+		v, err = enc.EncodeHeader(f.name, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	f.raw = []byte(strings.ReplaceAll(v, "\r\n", e.eol) + e.eol)
+	return ret, nil
+}
+
+// check returns the violations in the text s.
+func (c *Checker) check(s, part, header string) []Violation {
+	var ret []Violation
+	line, column := 1, 1
+	for _, r := range s {
+		if r == '\n' {
+			line, column = line+1, 1
+			continue
+		}
+		if !c.is(r) {
+			ret = append(ret, Violation{Part: part, Header: header, Line: line, Column: column, Rune: r})
+		}
+		column++
+	}
+	return ret
+}
+
+func (c *Checker) is(r rune) bool {
+	if r < utf8.RuneSelf {
+		return true
+	}
+	if c.discriminator != nil {
+		return c.discriminator.Is(r)
+	}
+	return jisx0208.Is(r)
+}
+
+// text returns the body decoded from the transfer encoding and the charset.
+func (e *entity) text(charset string) (string, error) {
+	var (
+		r   io.Reader = bytes.NewReader(e.body)
+		cte           = strings.ToLower(e.get("Content-Transfer-Encoding"))
+	)
+	switch cte {
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("mail: %s: %w", cte, err)
+	}
+	return decodeCharset(b, charset)
+}
+
+// decodeCharset decodes b in the charset into UTF-8. Unlike the decoders of the jisx0208 package,
+// vendor extensions are decoded to be reported. Text in US-ASCII or UTF-8 is returned as is,
+// including invalid bytes.
+func decodeCharset(b []byte, charset string) (string, error) {
+	switch strings.ToLower(charset) {
+	case "", "us-ascii", "utf-8", "utf8":
+		return string(b), nil
+	}
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return "", &mime.UnsupportedCharsetError{Charset: charset}
+	}
+	if enc == japanese.ISO2022JP {
+		// the decoder does not know the announcer of JIS X 0208-1990, which jisx0208.ISO2022JP
+		// writes before ESC $ B of 凜 and 熙
+		b = bytes.ReplaceAll(b, []byte("\x1b&@"), nil)
+	}
+	ret, err := enc.NewDecoder().Bytes(b)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+var (
+	wordDecoder = &stdmime.WordDecoder{
+		CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+			b, err := io.ReadAll(input)
+			if err != nil {
+				return nil, err
+			}
+			s, err := decodeCharset(b, charset)
+			if err != nil {
+				return nil, err
+			}
+			return strings.NewReader(s), nil
+		},
+	}
+	addressParser = &netmail.AddressParser{WordDecoder: wordDecoder}
+
+	// addressFields are the header fields of address lists, whose display names are encoded.
+	addressFields = map[string]bool{
+		"From":          true,
+		"Sender":        true,
+		"Reply-To":      true,
+		"To":            true,
+		"Cc":            true,
+		"Bcc":           true,
+		"Resent-From":   true,
+		"Resent-Sender": true,
+		"Resent-To":     true,
+		"Resent-Cc":     true,
+		"Resent-Bcc":    true,
+	}
+
+	encodedWord = regexp.MustCompile(`=\?([^?]+)\?[BbQq]\?`)
+)
+
+// needsRewrite reports whether the header field of the raw value, whose decoded value is s,
+// needs rewriting: it has non-ASCII characters that are not in ISO-2022-JP encoded words.
+func needsRewrite(raw, s string, violated bool) bool {
+	if isASCII(s) {
+		return false
+	}
+	if violated || !isASCII(raw) {
+		return true
+	}
+	for _, m := range encodedWord.FindAllStringSubmatch(raw, -1) {
+		// RFC 2231 allows the language after the charset, e.g. ISO-2022-JP*ja
+		charset, _, _ := strings.Cut(m[1], "*")
+		if !strings.EqualFold(charset, mime.Charset) {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeAddressList returns the header field of the address list, with one address per line.
+func encodeAddressList(enc *mime.Encoder, name string, list []*netmail.Address) (string, error) {
+	var b strings.Builder
+	for i, a := range list {
+		if isASCII(a.Name) {
+			if i == 0 {
+				b.WriteString(name + ": ")
+			}
+			b.WriteString(a.String())
+		} else {
+			var (
+				v   string
+				err error
+			)
+			if i == 0 {
+				v, err = enc.EncodeHeader(name, a.Name)
+			} else {
+				v, err = enc.Encode(a.Name)
+			}
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(v)
+			addr := "<" + a.Address + ">"
+			if last := v[strings.LastIndex(v, "\n")+1:]; len(last)+1+len(addr) > 76 {
+				b.WriteString("\r\n")
+			}
+			b.WriteString(" " + addr)
+		}
+		if i < len(list)-1 {
+			b.WriteString(",\r\n ")
+		}
+	}
+	return b.String(), nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
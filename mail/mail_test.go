@@ -0,0 +1,259 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	stdmime "mime"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+	"github.com/ikawaha/jisx0208/mime"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestCheck(t *testing.T) {
+	f, err := os.Open("testdata/multipart.eml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	got, err := Check(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Violation{
+		{Header: "From", Line: 1, Column: 1, Rune: '髙'},
+		{Header: "Subject", Line: 1, Column: 6, Rune: '㈱'},
+		{Part: "1", Line: 1, Column: 1, Rune: '髙'},
+		{Part: "1", Line: 2, Column: 5, Rune: '①'},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %v, want %v", got, want)
+	}
+}
+
+func TestChecker_Discriminator(t *testing.T) {
+	const msg = "Subject: =?UTF-8?B?6auZ5qmL?=\r\n\r\n髙橋\r\n"
+	c := NewChecker(Discriminator(jisx0208.NewDiscriminator(jisx0208.Allow('髙'), jisx0208.Disallow('橋'))))
+	got, err := c.Check(strings.NewReader(msg))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []Violation{
+		{Header: "Subject", Line: 1, Column: 2, Rune: '橋'},
+		{Line: 1, Column: 2, Rune: '橋'},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %v, want %v", got, want)
+	}
+}
+
+func TestCheck_Charset(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want []Violation
+	}{
+		{
+			name: "shift_jis with NEC special character",
+			msg:  "Content-Type: text/plain; charset=Shift_JIS\r\n\r\n\x82\xa0\r\n\x87\x40\r\n",
+			want: []Violation{{Line: 2, Column: 1, Rune: '①'}},
+		},
+		{
+			name: "iso-2022-jp",
+			msg:  "Content-Type: text/plain; charset=ISO-2022-JP\r\n\r\n\x1b$B$\"\x1b(B\r\n",
+		},
+		{
+			name: "iso-2022-jp of JIS X 0208-1990",
+			msg:  "Content-Type: text/plain; charset=ISO-2022-JP\r\n\r\n\x1b&@\x1b$Bt%\x1b(B\r\n",
+		},
+		{
+			name: "invalid utf-8",
+			msg:  "Subject: test\r\n\r\nabc\xff\r\n",
+			want: []Violation{{Line: 1, Column: 4, Rune: '\uFFFD'}},
+		},
+		{
+			name: "not text",
+			msg:  "Content-Type: application/octet-stream\r\n\r\n\xff\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Check(strings.NewReader(tt.msg))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck_UnsupportedCharset(t *testing.T) {
+	_, err := Check(strings.NewReader("Content-Type: text/plain; charset=x-unknown\r\n\r\nabc\r\n"))
+	if _, ok := err.(*mime.UnsupportedCharsetError); !ok {
+		t.Errorf("want UnsupportedCharsetError, got %v", err)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	b, err := os.ReadFile("testdata/multipart.eml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var w bytes.Buffer
+	v, err := Rewrite(&w, bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(v) != 4 {
+		t.Errorf("Rewrite() returns %d violations, want 4", len(v))
+	}
+	for i, line := range strings.Split(w.String(), "\r\n") {
+		for j := 0; j < len(line); j++ {
+			if line[j] >= 0x80 {
+				t.Errorf("line %d is not 7bit: %q", i+1, line)
+				break
+			}
+		}
+	}
+	v, err = Check(bytes.NewReader(w.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(v) != 0 {
+		t.Errorf("rewritten message has violations: %v", v)
+	}
+	for _, want := range []string{
+		"From: =?ISO-2022-JP?B?",
+		"To: <hanako@example.com>,\r\n =?ISO-2022-JP?B?",
+		"Content-Type: text/plain; charset=ISO-2022-JP\r\nContent-Transfer-Encoding: 7bit\r\n",
+		"\r\n\r\n6auZ\r\n--b1--\r\n", // attachment is left as is
+	} {
+		if !strings.Contains(w.String(), want) {
+			t.Errorf("rewritten message does not contain %q:\n%s", want, w.String())
+		}
+	}
+	e, err := parseEntity(w.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, err := mime.Decode(e.get("Subject"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "会議の件（〓）"; s != want {
+		t.Errorf("Subject = %q, want %q", s, want)
+	}
+	text, err := e.parts[0].text("ISO-2022-JP")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "〓橋です。\r\nよろしく〓お願いします。"; text != want {
+		t.Errorf("body = %q, want %q", text, want)
+	}
+}
+
+func TestRewrite_RoundTrip(t *testing.T) {
+	// the rewritten message is readable by a plain RFC 1468 decoder
+	const (
+		subject = "公開のお知らせ"
+		body    = "偉い人の分かりやすい説明です。\r\n会議の議事録をお送りします。"
+	)
+	msg := "Subject: " + subject + "\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n" + body + "\r\n"
+	var w bytes.Buffer
+	if _, err := Rewrite(&w, strings.NewReader(msg)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e, err := parseEntity(w.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dec := &stdmime.WordDecoder{
+		CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+			return japanese.ISO2022JP.NewDecoder().Reader(input), nil
+		},
+	}
+	s, err := dec.DecodeHeader(e.get("Subject"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s != subject {
+		t.Errorf("Subject = %q, want %q", s, subject)
+	}
+	b, err := japanese.ISO2022JP.NewDecoder().Bytes(e.body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.TrimSuffix(string(b), "\r\n"); got != body {
+		t.Errorf("body = %q, want %q", got, body)
+	}
+}
+
+func TestRewrite_Check(t *testing.T) {
+	// the characters of JIS X 0208-1990 are written with the announcer ESC & @
+	const msg = "Subject: 凜とした\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n熙です\r\n"
+	var w bytes.Buffer
+	if _, err := Rewrite(&w, strings.NewReader(msg)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(w.String(), "\x1b&@") {
+		t.Fatalf("Rewrite() = %q, want ESC & @", w.String())
+	}
+	v, err := Check(bytes.NewReader(w.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(v) != 0 {
+		t.Errorf("rewritten message has violations: %v", v)
+	}
+	e, err := parseEntity(w.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	text, err := e.text("ISO-2022-JP")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "熙です\r\n"; text != want {
+		t.Errorf("body = %q, want %q", text, want)
+	}
+}
+
+func TestRewrite_Unchanged(t *testing.T) {
+	const msg = "From: Taro <taro@example.com>\n" +
+		"Subject: =?ISO-2022-JP?B?GyRCJDMkcyRLJEEkTxsoQg==?=\n" +
+		"\tfolded\n" +
+		"\n" +
+		"Hello\n"
+	var w bytes.Buffer
+	v, err := Rewrite(&w, strings.NewReader(msg))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(v) != 0 {
+		t.Errorf("unexpected violations: %v", v)
+	}
+	if w.String() != msg {
+		t.Errorf("Rewrite() = %q, want %q", w.String(), msg)
+	}
+}
+
+func TestViolation_String(t *testing.T) {
+	tests := []struct {
+		v    Violation
+		want string
+	}{
+		{v: Violation{Header: "Subject", Line: 1, Column: 6, Rune: '㈱'}, want: "header Subject: column 6: U+3231 '㈱'"},
+		{v: Violation{Part: "1.2", Line: 3, Column: 5, Rune: '髙'}, want: "part 1.2 body: line 3, column 5: U+9AD9 '髙'"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	stdmime "mime"
+	"strings"
+)
+
+// field is a header field that keeps its raw bytes, including the folding and the line break,
+// so that the fields that are not rewritten are written back as is.
+type field struct {
+	name string
+	raw  []byte
+}
+
+// value returns the unfolded value of the field.
+func (f *field) value() string {
+	v := f.raw[bytes.IndexByte(f.raw, ':')+1:]
+	v = bytes.ReplaceAll(v, []byte("\r"), nil)
+	v = bytes.ReplaceAll(v, []byte("\n"), nil)
+	return strings.TrimSpace(string(v))
+}
+
+// entity is a message or a body part of a multipart message.
+type entity struct {
+	header []*field
+	// eol is the line break of the header, which is used for the fields to be added.
+	eol string
+	// blank is the empty line between the header and the body, if any.
+	blank []byte
+	// body is the body of a single part entity.
+	body []byte
+
+	// preamble, delimiters, parts and epilogue are the body of a multipart entity.
+	// Each delimiter includes the line break before it and the delimiter line;
+	// delimiters[i] precedes parts[i], and the last one is the close delimiter if any.
+	preamble   []byte
+	delimiters [][]byte
+	parts      []*entity
+	epilogue   []byte
+}
+
+// parseEntity parses the header and the body of an entity. The body of a multipart entity
+// is split into the body parts.
+func parseEntity(b []byte) (*entity, error) {
+	ret := entity{eol: "\r\n"}
+	i := 0
+	for i < len(b) {
+		n := lineLen(b[i:])
+		line := b[i : i+n]
+		if i == 0 && !bytes.HasSuffix(line, []byte("\r\n")) && bytes.HasSuffix(line, []byte("\n")) {
+			ret.eol = "\n"
+		}
+		if len(bytes.TrimRight(line, "\r\n")) == 0 {
+			ret.blank = line
+			i += n
+			break
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			if len(ret.header) == 0 {
+				return nil, fmt.Errorf("mail: malformed header line %q", line)
+			}
+			f := ret.header[len(ret.header)-1]
+			f.raw = b[i-len(f.raw) : i+n]
+			i += n
+			continue
+		}
+		colon := bytes.IndexByte(line, ':')
+		if colon <= 0 {
+			return nil, fmt.Errorf("mail: malformed header line %q", line)
+		}
+		ret.header = append(ret.header, &field{
+			name: string(bytes.TrimRight(line[:colon], " \t")),
+			raw:  line,
+		})
+		i += n
+	}
+	ret.body = b[i:]
+	if t, params := ret.mediaType(); strings.HasPrefix(t, "multipart/") && params["boundary"] != "" {
+		if err := ret.split(params["boundary"]); err != nil {
+			return nil, err
+		}
+	}
+	return &ret, nil
+}
+
+// split splits the body into the body parts by the boundary. The last part extends to the end of
+// the body if the close delimiter is missing.
+func (e *entity) split(boundary string) error {
+	var (
+		b        = e.body
+		delim    = []byte("--" + boundary)
+		segments [][]byte
+		start    int
+		closed   bool
+	)
+	for i := 0; i < len(b) && !closed; {
+		n := lineLen(b[i:])
+		line := bytes.TrimRight(b[i:i+n], " \t\r\n")
+		if !bytes.HasPrefix(line, delim) {
+			i += n
+			continue
+		}
+		switch string(line[len(delim):]) {
+		case "--":
+			closed = true
+		case "":
+		default:
+			i += n
+			continue
+		}
+		// the line break before the delimiter line belongs to the delimiter
+		end := i
+		if end > start && b[end-1] == '\n' {
+			end--
+			if end > start && b[end-1] == '\r' {
+				end--
+			}
+		}
+		segments = append(segments, b[start:end])
+		e.delimiters = append(e.delimiters, b[end:i+n])
+		i += n
+		start = i
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+	if closed {
+		e.epilogue = b[start:]
+	} else {
+		segments = append(segments, b[start:])
+	}
+	e.preamble = segments[0]
+	for _, v := range segments[1:] {
+		p, err := parseEntity(v)
+		if err != nil {
+			return err
+		}
+		e.parts = append(e.parts, p)
+	}
+	e.body = nil
+	return nil
+}
+
+func lineLen(b []byte) int {
+	if i := bytes.IndexByte(b, '\n'); i >= 0 {
+		return i + 1
+	}
+	return len(b)
+}
+
+// get returns the unfolded value of the first field of the name, or an empty string.
+func (e *entity) get(name string) string {
+	for _, f := range e.header {
+		if strings.EqualFold(f.name, name) {
+			return f.value()
+		}
+	}
+	return ""
+}
+
+// set replaces the first field of the name with the value, or adds the field.
+func (e *entity) set(name, value string) {
+	f := &field{name: name, raw: []byte(name + ": " + value + e.eol)}
+	for i, v := range e.header {
+		if strings.EqualFold(v.name, name) {
+			e.header[i] = f
+			return
+		}
+	}
+	e.header = append(e.header, f)
+}
+
+// mediaType returns the media type and the parameters of the entity. As RFC 2045 says,
+// the entity without a valid Content-Type is plain text in US-ASCII.
+func (e *entity) mediaType() (string, map[string]string) {
+	t, params, err := stdmime.ParseMediaType(e.get("Content-Type"))
+	if err != nil {
+		return "text/plain", map[string]string{}
+	}
+	return t, params
+}
+
+// isAttachment reports whether the entity is an attachment rather than a part of the text.
+func (e *entity) isAttachment() bool {
+	d, _, err := stdmime.ParseMediaType(e.get("Content-Disposition"))
+	return err == nil && d == "attachment"
+}
+
+// bytes returns the entity written back.
+func (e *entity) bytes() []byte {
+	var b bytes.Buffer
+	e.writeTo(&b)
+	return b.Bytes()
+}
+
+func (e *entity) writeTo(b *bytes.Buffer) {
+	for _, f := range e.header {
+		b.Write(f.raw)
+	}
+	b.Write(e.blank)
+	if len(e.delimiters) == 0 {
+		b.Write(e.body)
+		return
+	}
+	b.Write(e.preamble)
+	for i, p := range e.parts {
+		b.Write(e.delimiters[i])
+		p.writeTo(b)
+	}
+	if len(e.delimiters) > len(e.parts) {
+		b.Write(e.delimiters[len(e.parts)])
+	}
+	b.Write(e.epilogue)
+}
@@ -0,0 +1,254 @@
+// Package mime implements RFC 2047 encoded words in ISO-2022-JP for mail headers limited to JIS X 0208.
+package mime
+
+import (
+	"encoding/base64"
+	"io"
+	stdmime "mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+	"golang.org/x/text/transform"
+)
+
+const (
+	// Charset is the charset of the encoded words.
+	Charset = "ISO-2022-JP"
+
+	wordPrefix = "=?" + Charset + "?B?"
+	wordSuffix = "?="
+
+	// maxWordLen is the maximum length of an encoded word.
+	maxWordLen = 75
+	// maxLineLen is the maximum length of a line containing encoded words.
+	maxLineLen = 76
+	// foldLineLen is the recommended maximum length of a line.
+	foldLineLen = 78
+)
+
+// Option represents an option for the encoder.
+type Option func(e *Encoder)
+
+// Discriminator is an encoder option to set the discriminator of valid characters.
+func Discriminator(d *jisx0208.Discriminator) Option {
+	return func(e *Encoder) {
+		e.discriminator = d
+	}
+}
+
+// Replacement is an encoder option to replace invalid characters with the replacement string,
+// which may be empty, instead of failing.
+func Replacement(s string) Option {
+	return func(e *Encoder) {
+		e.replacement = s
+		e.replace = true
+	}
+}
+
+// Encoder encodes header values into RFC 2047 encoded words in ISO-2022-JP.
+type Encoder struct {
+	discriminator *jisx0208.Discriminator
+	replacement   string
+	replace       bool
+}
+
+// NewEncoder returns an encoder. By default, it fails on characters out of JIS X 0208.
+func NewEncoder(options ...Option) *Encoder {
+	var ret Encoder
+	for _, option := range options {
+		option(&ret)
+	}
+	return &ret
+}
+
+// Encode returns the header value s as encoded words separated by folding white space.
+// It fails with a *jisx0208.EncodeError on characters out of JIS X 0208.
+func Encode(s string) (string, error) {
+	return NewEncoder().Encode(s)
+}
+
+// EncodeHeader returns the header field of the name and the value, folded by CRLF SP.
+// It fails with a *jisx0208.EncodeError on characters out of JIS X 0208.
+func EncodeHeader(name, value string) (string, error) {
+	return NewEncoder().EncodeHeader(name, value)
+}
+
+// Encode returns the header value s as encoded words separated by folding white space.
+// A value of printable ASCII characters is returned as is, folded at spaces.
+func (e *Encoder) Encode(s string) (string, error) {
+	words, err := e.encode(s, 1)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(words, "\r\n "), nil
+}
+
+// EncodeHeader returns the header field of the name and the value, folded by CRLF SP.
+// Encoded words are split at character boundaries so that no line exceeds 76 characters;
+// if the name is too long for an encoded word to follow it, the value starts on the next line.
+func (e *Encoder) EncodeHeader(name, value string) (string, error) {
+	prefix := name + ": "
+	words, err := e.encode(value, len(prefix))
+	if err != nil {
+		return "", err
+	}
+	if len(words) > 1 && words[0] == "" {
+		return name + ":\r\n " + strings.Join(words[1:], "\r\n "), nil
+	}
+	return prefix + strings.Join(words, "\r\n "), nil
+}
+
+// encode returns the encoded words, or the folded words if s does not need encoding.
+// The first line has the prefix of the given length. The first word is empty if no encoded
+// word fits in the first line.
+func (e *Encoder) encode(s string, prefix int) ([]string, error) {
+	s, err := e.sanitize(s)
+	if err != nil {
+		return nil, err
+	}
+	if !needsEncoding(s) {
+		first := foldLineLen - prefix
+		if first < 0 {
+			first = 0
+		}
+		return foldASCII(s, first), nil
+	}
+	var (
+		ret   []string
+		limit = maxLineLen - prefix
+	)
+	if limit > maxWordLen {
+		limit = maxWordLen
+	}
+	for len(s) > 0 {
+		n, word, err := encodeWord(s, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(word) > limit { // not even a character fits after the prefix
+			ret = append(ret, "")
+			limit = maxWordLen
+			continue
+		}
+		ret = append(ret, word)
+		s = s[n:]
+		limit = maxWordLen
+	}
+	return ret, nil
+}
+
+// sanitize validates s, or replaces the invalid characters of s if a replacement is given.
+func (e *Encoder) sanitize(s string) (string, error) {
+	enc := jisx0208.ISO2022JP
+	if e.replace {
+		enc = enc.WithReplacement(e.discriminator, e.replacement)
+	} else if e.discriminator != nil {
+		if err := validate(s, e.discriminator); err != nil {
+			return "", err
+		}
+	}
+	b, err := enc.NewEncoder().String(s)
+	if err != nil {
+		return "", err
+	}
+	if !e.replace {
+		return s, nil
+	}
+	return enc.NewDecoder().String(b)
+}
+
+func validate(s string, d *jisx0208.Discriminator) error {
+	for i, r := range s {
+		if r >= utf8.RuneSelf && !d.Is(r) {
+			return &jisx0208.EncodeError{Encoding: Charset, Offset: i, Rune: r}
+		}
+	}
+	return nil
+}
+
+// encodeWord returns the longest encoded word of the head of s that fits in limit bytes,
+// and the number of bytes of s that the word contains. At least one character is encoded.
+func encodeWord(s string, limit int) (int, string, error) {
+	// the number of bytes of ISO-2022-JP that fit in the word
+	maxBytes := (limit - len(wordPrefix) - len(wordSuffix)) / 4 * 3
+	var (
+		enc = jisx0208.ISO2022JP.NewEncoder()
+		n   int
+		b   string
+	)
+	for i, r := range s {
+		end := i + utf8.RuneLen(r)
+		v, err := enc.String(s[:end])
+		if err != nil {
+			return 0, "", err
+		}
+		if len(v) > maxBytes && n > 0 {
+			break
+		}
+		n, b = end, v
+	}
+	return n, wordPrefix + base64.StdEncoding.EncodeToString([]byte(b)) + wordSuffix, nil
+}
+
+// needsEncoding reports whether s contains characters other than printable ASCII, or a sequence
+// that looks like an encoded word.
+func needsEncoding(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return true
+		}
+	}
+	return strings.Contains(s, "=?")
+}
+
+// foldASCII splits s at spaces so that each line fits in the limit if possible.
+// The first line fits in first bytes, and the continuation lines start with a space.
+func foldASCII(s string, first int) []string {
+	var ret []string
+	limit := first
+	for len(s) > limit {
+		i := strings.LastIndexByte(s[:limit+1], ' ')
+		if i <= 0 {
+			break
+		}
+		ret = append(ret, s[:i])
+		s = s[i+1:]
+		limit = foldLineLen - 1
+	}
+	return append(ret, s)
+}
+
+// Decode decodes the encoded words in the header value s.
+// Words in ISO-2022-JP, Shift_JIS and EUC-JP must be in JIS X 0208.
+func Decode(s string) (string, error) {
+	d := stdmime.WordDecoder{
+		CharsetReader: charsetReader,
+	}
+	return d.DecodeHeader(s)
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	var enc *jisx0208.Encoding
+	switch strings.ToLower(charset) {
+	case "iso-2022-jp":
+		enc = jisx0208.ISO2022JP
+	case "shift_jis":
+		enc = jisx0208.ShiftJIS
+	case "euc-jp":
+		enc = jisx0208.EUCJP
+	default:
+		return nil, &UnsupportedCharsetError{Charset: charset}
+	}
+	return transform.NewReader(input, enc.NewDecoder()), nil
+}
+
+// UnsupportedCharsetError is the error returned for encoded words in an unsupported charset.
+type UnsupportedCharsetError struct {
+	Charset string
+}
+
+// Error returns the error message.
+func (e *UnsupportedCharsetError) Error() string {
+	return "mime: unsupported charset: " + e.Charset
+}
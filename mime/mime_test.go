@@ -0,0 +1,249 @@
+package mime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "ascii",
+			s:    "Hello, world",
+			want: "Hello, world",
+		},
+		{
+			name: "japanese",
+			s:    "こんにちは",
+			want: "=?ISO-2022-JP?B?GyRAJDMkcyRLJEEkTxsoQg==?=",
+		},
+		{
+			name: "looks like an encoded word",
+			s:    "=?x?",
+			want: "=?ISO-2022-JP?B?PT94Pw==?=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+			dec, err := Decode(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dec != tt.s {
+				t.Errorf("Decode(Encode()) = %q, want %q", dec, tt.s)
+			}
+		})
+	}
+}
+
+func TestEncodeHeader(t *testing.T) {
+	value := "人魚は、南の方の海にばかり棲んでいるのではありません。北の海にも棲んでいたのであります。"
+	got, err := EncodeHeader("Subject", value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(got, "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("EncodeHeader() = %q, want folded lines", got)
+	}
+	for i, v := range lines {
+		if len(v) > maxLineLen {
+			t.Errorf("line %d exceeds %d bytes: %q", i, maxLineLen, v)
+		}
+		if i > 0 && !strings.HasPrefix(v, " =?ISO-2022-JP?B?") {
+			t.Errorf("line %d is not a continuation of encoded word: %q", i, v)
+		}
+	}
+	if !strings.HasPrefix(got, "Subject: =?ISO-2022-JP?B?") {
+		t.Errorf("EncodeHeader() = %q", got)
+	}
+	dec, err := Decode(strings.TrimPrefix(got, "Subject: "))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dec != value {
+		t.Errorf("Decode() = %q, want %q", dec, value)
+	}
+}
+
+func TestEncodeHeader_Escapes(t *testing.T) {
+	// the encoded words of common kanji are readable by plain RFC 1468 decoders,
+	// which do not know the escape sequence ESC & @ of JIS X 0208-1990
+	for _, value := range []string{
+		"公開のお知らせ",
+		"会議の議事録について",
+		"偉い人の分かりやすい説明",
+		"人魚は、南の方の海にばかり棲んでいるのではありません。北の海にも棲んでいたのであります。",
+	} {
+		got, err := EncodeHeader("Subject", value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var text []byte
+		for _, m := range regexp.MustCompile(`=\?ISO-2022-JP\?B\?([^?]*)\?=`).FindAllStringSubmatch(got, -1) {
+			b, err := base64.StdEncoding.DecodeString(m[1])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bytes.Contains(b, []byte("\x1b&@")) {
+				t.Errorf("EncodeHeader(%q) word %q contains ESC & @", value, b)
+			}
+			text = append(text, b...)
+		}
+		dec, err := japanese.ISO2022JP.NewDecoder().Bytes(text)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(dec) != value {
+			t.Errorf("decoded %q, want %q", dec, value)
+		}
+	}
+}
+
+func TestEncodeHeader_LongName(t *testing.T) {
+	for _, n := range []int{40, 50, 66, 80} {
+		name := "X-" + strings.Repeat("A", n-2)
+		for _, value := range []string{"凜とした公開のお知らせ", "Hello world"} {
+			got, err := EncodeHeader(name, value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			lines := strings.Split(got, "\r\n")
+			for i, v := range lines {
+				if i == 0 && len(name)+2 > maxLineLen {
+					continue // the name itself is too long
+				}
+				if len(v) > maxLineLen && needsEncoding(value) {
+					t.Errorf("%d-byte name: line %d exceeds %d bytes: %q", n, i, maxLineLen, v)
+				}
+			}
+			if !strings.HasPrefix(got, name+":") {
+				t.Fatalf("EncodeHeader() = %q", got)
+			}
+			// unfolded as readers do
+			dec, err := Decode(strings.TrimLeft(strings.ReplaceAll(strings.TrimPrefix(got, name+":"), "\r\n", ""), " "))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dec != value {
+				t.Errorf("%d-byte name: Decode() = %q, want %q", n, dec, value)
+			}
+		}
+	}
+}
+
+func TestEncodeHeader_ASCII(t *testing.T) {
+	value := strings.Repeat("lorem ipsum ", 10)
+	got, err := EncodeHeader("Subject", value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, v := range strings.Split(got, "\r\n") {
+		if len(v) > foldLineLen {
+			t.Errorf("line %d exceeds %d bytes: %q", i, foldLineLen, v)
+		}
+	}
+	if unfolded := strings.ReplaceAll(got, "\r\n", ""); unfolded != "Subject: "+value {
+		t.Errorf("unfolded header = %q", unfolded)
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	_, err := Encode("髙島屋")
+	var got *jisx0208.EncodeError
+	if !errors.As(err, &got) {
+		t.Fatalf("want EncodeError, got %v", err)
+	}
+	if got.Offset != 0 || got.Rune != '髙' {
+		t.Errorf("got %+v", *got)
+	}
+	_, err = NewEncoder(Discriminator(jisx0208.NewDiscriminator(jisx0208.Disallow('屋')))).Encode("高島屋")
+	if !errors.As(err, &got) {
+		t.Fatalf("want EncodeError, got %v", err)
+	}
+	if got.Offset != 6 || got.Rune != '屋' {
+		t.Errorf("got %+v", *got)
+	}
+}
+
+func TestEncoder_Replacement(t *testing.T) {
+	e := NewEncoder(
+		Discriminator(jisx0208.NewDiscriminator(jisx0208.Disallow('屋'))),
+		Replacement("〓"),
+	)
+	got, err := e.Encode("髙島屋")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dec, err := Decode(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "〓島〓"; dec != want {
+		t.Errorf("Decode(Encode()) = %q, want %q", dec, want)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "iso-2022-jp",
+			s:    "=?iso-2022-jp?B?GyRCJDMkcyRLJEEkTxsoQg==?= <a@example.com>",
+			want: "こんにちは <a@example.com>",
+		},
+		{
+			name: "shift_jis",
+			s:    "=?Shift_JIS?B?grGC8YLJgr+CzQ==?=",
+			want: "こんにちは",
+		},
+		{
+			name: "utf-8",
+			s:    "=?UTF-8?B?6auZ?=",
+			want: "髙",
+		},
+		{
+			name:    "vendor extension",
+			s:       "=?Shift_JIS?B?+/w=?=",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported charset",
+			s:       "=?GB2312?B?xOO6ww==?=",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
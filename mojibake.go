@@ -0,0 +1,222 @@
+package jisx0208
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// Mojibake is a kind of mis-decoding, which decodes bytes in one encoding as another.
+type Mojibake int
+
+const (
+	// MojibakeUTF8AsShiftJIS is UTF-8 decoded as Shift_JIS, e.g. "縺ゅ≠" for "ああ".
+	MojibakeUTF8AsShiftJIS Mojibake = iota + 1
+	// MojibakeUTF8AsEUCJP is UTF-8 decoded as EUC-JP.
+	MojibakeUTF8AsEUCJP
+	// MojibakeUTF8AsLatin1 is UTF-8 decoded as Latin-1 or Windows-1252, e.g. "ã‚" for "あ".
+	MojibakeUTF8AsLatin1
+	// MojibakeShiftJISAsLatin1 is Shift_JIS decoded as Latin-1 or Windows-1252, e.g. "‚ ‚¢" for "あい".
+	MojibakeShiftJISAsLatin1
+	// MojibakeEUCJPAsLatin1 is EUC-JP decoded as Latin-1 or Windows-1252, e.g. "¤¢¤¤" for "あい".
+	MojibakeEUCJPAsLatin1
+	// MojibakeEUCJPAsShiftJIS is EUC-JP decoded as Shift_JIS, e.g. "､｢､､" for "あい".
+	MojibakeEUCJPAsShiftJIS
+)
+
+// String returns the description of the mis-decoding, e.g. "UTF-8 as Shift_JIS".
+func (m Mojibake) String() string {
+	switch m {
+	case MojibakeUTF8AsShiftJIS:
+		return "UTF-8 as Shift_JIS"
+	case MojibakeUTF8AsEUCJP:
+		return "UTF-8 as EUC-JP"
+	case MojibakeUTF8AsLatin1:
+		return "UTF-8 as Latin-1"
+	case MojibakeShiftJISAsLatin1:
+		return "Shift_JIS as Latin-1"
+	case MojibakeEUCJPAsLatin1:
+		return "EUC-JP as Latin-1"
+	case MojibakeEUCJPAsShiftJIS:
+		return "EUC-JP as Shift_JIS"
+	}
+	return "unknown"
+}
+
+// MojibakeRepair is a repair of a string by reversing a mis-decoding.
+type MojibakeRepair struct {
+	// Mojibake is the mis-decoding reversed.
+	Mojibake Mojibake
+	// Text is the repaired string.
+	Text string
+	// Confidence is the likelihood of the repaired string relative to the original string
+	// and the other repairs, in [0, 1].
+	Confidence float64
+}
+
+// mojibakes are the mis-decodings, each of which is reversed by encoding the string in the encoding
+// that decoded it and decoding the bytes in the original encoding.
+var mojibakes = []struct {
+	mojibake Mojibake
+	encode   func(s string) ([]byte, bool)
+	decode   func(b []byte) string
+}{
+	{mojibake: MojibakeUTF8AsShiftJIS, encode: encodeWith(japanese.ShiftJIS), decode: decodeUTF8},
+	{mojibake: MojibakeUTF8AsEUCJP, encode: encodeWith(japanese.EUCJP), decode: decodeUTF8},
+	{mojibake: MojibakeUTF8AsLatin1, encode: encodeLatin1, decode: decodeUTF8},
+	{mojibake: MojibakeShiftJISAsLatin1, encode: encodeLatin1, decode: decodeWith(japanese.ShiftJIS)},
+	{mojibake: MojibakeEUCJPAsLatin1, encode: encodeLatin1, decode: decodeWith(japanese.EUCJP)},
+	{mojibake: MojibakeEUCJPAsShiftJIS, encode: encodeWith(japanese.ShiftJIS), decode: decodeWith(japanese.EUCJP)},
+}
+
+// logProbMojibake is the log-probability of a string being mojibake of a kind, roughly estimated,
+// which keeps short strings such as a few halfwidth katakana from being repaired.
+const logProbMojibake = -5.0
+
+// DetectMojibake returns the repairs of s that are more plausible than s itself, in descending
+// order of confidence, or nil if s does not look like mojibake. Each mis-decoding of a Mojibake
+// kind is reversed if the bytes are decoded without more errors than U+FFFD in s, and the plausibility
+// of the strings is scored in the same way as Detect scores characters. The bytes lost in
+// the mis-decoding, which are U+FFFD in s, remain U+FFFD; a repair that loses more of the bytes,
+// e.g. the characters broken by the lost bytes, is rejected. Mis-decodings that lose most bytes,
+// such as Shift_JIS decoded as UTF-8, cannot be reversed.
+func DetectMojibake(s string) []MojibakeRepair {
+	base := textLogProb(s)
+	var ret []MojibakeRepair
+	for _, m := range mojibakes {
+		b, ok := m.encode(s)
+		if !ok {
+			continue
+		}
+		v := m.decode(b)
+		// each U+FFFD is at least a byte lost, and U+FFFD in s are a byte each in b,
+		// so that the share of the bytes lost is compared before the runs are collapsed
+		if v == s || strings.Count(v, "\uFFFD") > strings.Count(s, "\uFFFD") {
+			continue
+		}
+		v = collapseLost(v)
+		// the confidence holds the log-likelihood ratio to s until normalized
+		if p := textLogProb(v) + logProbMojibake - base; p > 0 {
+			ret = append(ret, MojibakeRepair{Mojibake: m.mojibake, Text: v, Confidence: p})
+		}
+	}
+	if len(ret) == 0 {
+		return nil
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Confidence > ret[j].Confidence
+	})
+	max := ret[0].Confidence
+	sum := math.Exp(-max) // s itself
+	for _, v := range ret {
+		sum += math.Exp(v.Confidence - max)
+	}
+	for i := range ret {
+		ret[i].Confidence = math.Exp(ret[i].Confidence-max) / sum
+	}
+	return ret
+}
+
+// Repair returns s with the most plausible mis-decoding reversed, and the confidence of the repair.
+// It returns s and 0 if s does not look like mojibake. See DetectMojibake for details.
+func Repair(s string) (string, float64) {
+	v := DetectMojibake(s)
+	if len(v) == 0 {
+		return s, 0
+	}
+	return v[0].Text, v[0].Confidence
+}
+
+// textLogProb returns the log-probability of the characters of s in Japanese text.
+func textLogProb(s string) float64 {
+	var ret float64
+	for _, r := range s {
+		switch {
+		case r < utf8.RuneSelf:
+		case Is(r):
+			ret += logProbOf(r)
+		case r >= '｡' && r <= 'ﾟ':
+			ret += logProbHalfwidth
+		case r == utf8.RuneError, r >= 0x80 && r < 0xA0: // C1 controls
+			ret += logProbInvalid
+		default:
+			if _, ok := cp932(r); ok {
+				ret += logProbVendor
+			} else {
+				ret += logProbOther
+			}
+		}
+	}
+	return ret
+}
+
+// lostByte is the byte written for U+FFFD, which is invalid in UTF-8, Shift_JIS and EUC-JP.
+const lostByte = 0xFF
+
+func encodeWith(e encoding.Encoding) func(s string) ([]byte, bool) {
+	return func(s string) ([]byte, bool) {
+		var ret []byte
+		for i, v := range strings.Split(s, "\uFFFD") {
+			if i > 0 {
+				ret = append(ret, lostByte)
+			}
+			b, err := e.NewEncoder().Bytes([]byte(v))
+			if err != nil {
+				return nil, false
+			}
+			ret = append(ret, b...)
+		}
+		return ret, true
+	}
+}
+
+// decodeWith returns the decoder of the encoding that replaces invalid bytes with U+FFFD.
+func decodeWith(e encoding.Encoding) func(b []byte) string {
+	return func(b []byte) string {
+		v, _ := e.NewDecoder().Bytes(b)
+		return string(v)
+	}
+}
+
+// decodeUTF8 returns b with each invalid byte replaced with U+FFFD.
+func decodeUTF8(b []byte) string {
+	var ret strings.Builder
+	for _, r := range string(b) {
+		ret.WriteRune(r)
+	}
+	return ret.String()
+}
+
+// collapseLost returns s with each run of U+FFFD replaced with one U+FFFD.
+func collapseLost(s string) string {
+	for strings.Contains(s, "\uFFFD\uFFFD") {
+		s = strings.ReplaceAll(s, "\uFFFD\uFFFD", "\uFFFD")
+	}
+	return s
+}
+
+// encodeLatin1 encodes s in Windows-1252, or in Latin-1 for the characters that Windows-1252
+// assigns other characters to, so that it reverses both decodings.
+func encodeLatin1(s string) ([]byte, bool) {
+	ret := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r == utf8.RuneError:
+			ret = append(ret, lostByte)
+		case r < 0x100:
+			ret = append(ret, byte(r))
+		default:
+			b, ok := charmap.Windows1252.EncodeRune(r)
+			if !ok {
+				return nil, false
+			}
+			ret = append(ret, b)
+		}
+	}
+	return ret, true
+}
@@ -0,0 +1,107 @@
+package jisx0208
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestRepair(t *testing.T) {
+	samples := []string{
+		"こんにちは、世界",
+		"文字化けを直します",
+		"吾輩は猫である。名前はまだ無い。",
+	}
+	mis := []struct {
+		mojibake Mojibake
+		from, to encoding.Encoding
+	}{
+		{mojibake: MojibakeUTF8AsShiftJIS, from: unicode.UTF8, to: japanese.ShiftJIS},
+		{mojibake: MojibakeUTF8AsLatin1, from: unicode.UTF8, to: charmap.ISO8859_1},
+		{mojibake: MojibakeShiftJISAsLatin1, from: japanese.ShiftJIS, to: charmap.ISO8859_1},
+		{mojibake: MojibakeEUCJPAsLatin1, from: japanese.EUCJP, to: charmap.ISO8859_1},
+		{mojibake: MojibakeEUCJPAsShiftJIS, from: japanese.EUCJP, to: japanese.ShiftJIS},
+	}
+	for _, s := range samples {
+		for _, m := range mis {
+			t.Run(m.mojibake.String()+"/"+s, func(t *testing.T) {
+				b, err := m.from.NewEncoder().Bytes([]byte(s))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				garbled, err := m.to.NewDecoder().Bytes(b)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got := DetectMojibake(string(garbled))
+				if strings.ContainsRune(string(garbled), utf8.RuneError) {
+					// the bytes lost in the mis-decoding may break the following characters
+					if len(got) > 0 && got[0].Mojibake != m.mojibake {
+						t.Errorf("DetectMojibake(%q) = %+v", garbled, got)
+					}
+					return
+				}
+				if len(got) == 0 {
+					t.Fatalf("DetectMojibake(%q) = nil", garbled)
+				}
+				if got[0].Mojibake != m.mojibake || got[0].Text != s || got[0].Confidence < 0.9 {
+					t.Errorf("DetectMojibake(%q) = %+v", garbled, got)
+				}
+				if v, c := Repair(string(garbled)); v != s || c != got[0].Confidence {
+					t.Errorf("Repair(%q) = %q, %v", garbled, v, c)
+				}
+			})
+		}
+	}
+}
+
+func TestRepair_Examples(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "縺ゅ≠", want: "ああ"},
+		{s: "繧ｳ", want: "コ"},
+		{s: "ã‚¢", want: "ア"},
+		{s: "縺ゅ≠ \uFFFD 縺ゅ≠", want: "ああ \uFFFD ああ"},
+	}
+	for _, tt := range tests {
+		if got, c := Repair(tt.s); got != tt.want || c <= 0.5 {
+			t.Errorf("Repair(%q) = %q, %v, want %q", tt.s, got, c, tt.want)
+		}
+	}
+}
+
+func TestDetectMojibake_NotMojibake(t *testing.T) {
+	tests := []string{
+		"",
+		// the repairs would lose more bytes than s has lost
+		"\uFFFDｱ\uFFFDｲ\uFFFDｳ",
+		"縺ゅ\uFFFD",
+		"Hello, world",
+		"こんにちは、世界",
+		"髙島屋",
+		"Café crème",
+		"ﾃｽﾄ",
+		"人魚は、南の方の海にばかり棲んでいるのではありません。",
+	}
+	for _, s := range tests {
+		if got := DetectMojibake(s); got != nil {
+			t.Errorf("DetectMojibake(%q) = %+v, want nil", s, got)
+		}
+		if got, c := Repair(s); got != s || c != 0 {
+			t.Errorf("Repair(%q) = %q, %v", s, got, c)
+		}
+	}
+}
+
+func TestMojibake_String(t *testing.T) {
+	if got, want := MojibakeEUCJPAsShiftJIS.String(), "EUC-JP as Shift_JIS"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
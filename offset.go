@@ -0,0 +1,193 @@
+package jisx0208
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Span is a range of a string in byte and rune offsets. The ends are exclusive.
+// Each invalid UTF-8 byte is counted as one rune, as in utf8.RuneCountInString.
+type Span struct {
+	Start     int
+	End       int
+	RuneStart int
+	RuneEnd   int
+}
+
+// Edit is a replacement made by ToValidWithOffsets.
+type Edit struct {
+	// Original is the replaced range of the input.
+	Original Span
+	// Sanitized is the range of the replacement in the output, which is empty if the replacement is empty.
+	Sanitized Span
+}
+
+// OffsetMap maps offsets between the input and the output of ToValidWithOffsets.
+type OffsetMap struct {
+	// Edits are the replacements in order of appearance.
+	Edits []Edit
+}
+
+// ToValidWithOffsets returns the same string as ToValid together with the map of offsets
+// between the input and the output.
+func ToValidWithOffsets(s, replacement string) (string, *OffsetMap) {
+	return toValidWithOffsets(s, replacement, Is)
+}
+
+// ToValidWithOffsets returns the same string as ToValid together with the map of offsets
+// between the input and the output.
+func (d *Discriminator) ToValidWithOffsets(s, replacement string) (string, *OffsetMap) {
+	return toValidWithOffsets(s, replacement, d.Is)
+}
+
+func toValidWithOffsets(s, replacement string, is func(rune) bool) (string, *OffsetMap) {
+	var (
+		b       strings.Builder
+		m       OffsetMap
+		last    int
+		orig    int // rune offset of last in s
+		clean   int // rune offset of the end of b
+		replLen = utf8.RuneCountInString(replacement)
+	)
+	scanInvalid(s, is, func(start, end int, r rune) {
+		if b.Cap() == 0 {
+			b.Grow(len(s) + len(replacement))
+		}
+		n := utf8.RuneCountInString(s[last:start])
+		orig += n
+		clean += n
+		b.WriteString(s[last:start])
+		e := Edit{
+			Original: Span{
+				Start:     start,
+				End:       end,
+				RuneStart: orig,
+				RuneEnd:   orig + utf8.RuneCountInString(s[start:end]),
+			},
+			Sanitized: Span{
+				Start:     b.Len(),
+				End:       b.Len() + len(replacement),
+				RuneStart: clean,
+				RuneEnd:   clean + replLen,
+			},
+		}
+		b.WriteString(replacement)
+		m.Edits = append(m.Edits, e)
+		last = end
+		orig = e.Original.RuneEnd
+		clean = e.Sanitized.RuneEnd
+	})
+	if b.Cap() == 0 {
+		return s, &m
+	}
+	b.WriteString(s[last:])
+	return b.String(), &m
+}
+
+// ToOriginal returns the byte offset in the input that corresponds to the byte offset off in the output.
+// An offset inside a replacement is mapped to the start of the replaced range,
+// and an offset at a deleted range to the end of it.
+func (m *OffsetMap) ToOriginal(off int) int {
+	return m.toOriginal(off, byteOffset)
+}
+
+// ToOriginalRune returns the rune offset in the input that corresponds to the rune offset off in the output.
+// An offset inside a replacement is mapped to the start of the replaced range,
+// and an offset at a deleted range to the end of it.
+func (m *OffsetMap) ToOriginalRune(off int) int {
+	return m.toOriginal(off, runeOffset)
+}
+
+// ToSanitized returns the byte offset in the output that corresponds to the byte offset off in the input.
+// An offset inside a replaced range is mapped to the start of the replacement.
+func (m *OffsetMap) ToSanitized(off int) int {
+	return m.toSanitized(off, byteOffset)
+}
+
+// ToSanitizedRune returns the rune offset in the output that corresponds to the rune offset off in the input.
+// An offset inside a replaced range is mapped to the start of the replacement.
+func (m *OffsetMap) ToSanitizedRune(off int) int {
+	return m.toSanitized(off, runeOffset)
+}
+
+// OriginalSpan projects the byte range [start, end) of the output onto the input.
+// The result covers every replaced range that the span overlaps, but not the deleted ranges at its ends.
+func (m *OffsetMap) OriginalSpan(start, end int) (int, int) {
+	return m.originalSpan(start, end, byteOffset)
+}
+
+// OriginalRuneSpan projects the rune range [start, end) of the output onto the input.
+// The result covers every replaced range that the span overlaps, but not the deleted ranges at its ends.
+func (m *OffsetMap) OriginalRuneSpan(start, end int) (int, int) {
+	return m.originalSpan(start, end, runeOffset)
+}
+
+func byteOffset(s Span) (int, int) {
+	return s.Start, s.End
+}
+
+func runeOffset(s Span) (int, int) {
+	return s.RuneStart, s.RuneEnd
+}
+
+func (m *OffsetMap) toOriginal(off int, unit func(Span) (int, int)) int {
+	// the last edit whose replacement starts at or before off
+	i := sort.Search(len(m.Edits), func(i int) bool {
+		start, _ := unit(m.Edits[i].Sanitized)
+		return start > off
+	}) - 1
+	if i < 0 {
+		return off
+	}
+	_, end := unit(m.Edits[i].Sanitized)
+	ostart, oend := unit(m.Edits[i].Original)
+	if off < end {
+		return ostart
+	}
+	return oend + off - end
+}
+
+// toOriginalEnd is like toOriginal, but maps an offset inside or at the end of a replacement
+// to the end of the replaced range, and an offset at a deletion to the position before it.
+func (m *OffsetMap) toOriginalEnd(off int, unit func(Span) (int, int)) int {
+	// the last edit whose replacement starts before off
+	i := sort.Search(len(m.Edits), func(i int) bool {
+		start, _ := unit(m.Edits[i].Sanitized)
+		return start >= off
+	}) - 1
+	if i < 0 {
+		return off
+	}
+	_, end := unit(m.Edits[i].Sanitized)
+	_, oend := unit(m.Edits[i].Original)
+	if off < end {
+		return oend
+	}
+	return oend + off - end
+}
+
+func (m *OffsetMap) toSanitized(off int, unit func(Span) (int, int)) int {
+	// the last edit whose replaced range starts at or before off
+	i := sort.Search(len(m.Edits), func(i int) bool {
+		start, _ := unit(m.Edits[i].Original)
+		return start > off
+	}) - 1
+	if i < 0 {
+		return off
+	}
+	_, end := unit(m.Edits[i].Original)
+	sstart, send := unit(m.Edits[i].Sanitized)
+	if off < end {
+		return sstart
+	}
+	return send + off - end
+}
+
+func (m *OffsetMap) originalSpan(start, end int, unit func(Span) (int, int)) (int, int) {
+	ostart := m.toOriginal(start, unit)
+	if end <= start {
+		return ostart, ostart
+	}
+	return ostart, m.toOriginalEnd(end, unit)
+}
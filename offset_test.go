@@ -0,0 +1,117 @@
+package jisx0208
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToValidWithOffsets(t *testing.T) {
+	s := "a髙b\xFF\xFEc"
+	got, m := ToValidWithOffsets(s, "[?]")
+	if want := "a[?]b[?]c"; got != want {
+		t.Fatalf("ToValidWithOffsets() = %q, want %q", got, want)
+	}
+	want := []Edit{
+		{
+			Original:  Span{Start: 1, End: 4, RuneStart: 1, RuneEnd: 2},
+			Sanitized: Span{Start: 1, End: 4, RuneStart: 1, RuneEnd: 4},
+		},
+		{
+			Original:  Span{Start: 5, End: 7, RuneStart: 3, RuneEnd: 5},
+			Sanitized: Span{Start: 5, End: 8, RuneStart: 5, RuneEnd: 8},
+		},
+	}
+	if !reflect.DeepEqual(m.Edits, want) {
+		t.Errorf("edits = %+v, want %+v", m.Edits, want)
+	}
+	for _, v := range []struct {
+		off, want int
+	}{
+		{off: 0, want: 0},
+		{off: 1, want: 1},
+		{off: 2, want: 1},
+		{off: 4, want: 4},
+		{off: 5, want: 5},
+		{off: 7, want: 5},
+		{off: 8, want: 7},
+		{off: 9, want: 8},
+	} {
+		if got := m.ToOriginal(v.off); got != v.want {
+			t.Errorf("ToOriginal(%d) = %d, want %d", v.off, got, v.want)
+		}
+	}
+	for _, v := range []struct {
+		off, want int
+	}{
+		{off: 0, want: 0},
+		{off: 1, want: 1},
+		{off: 2, want: 1},
+		{off: 4, want: 4},
+		{off: 6, want: 5},
+		{off: 7, want: 8},
+	} {
+		if got := m.ToSanitized(v.off); got != v.want {
+			t.Errorf("ToSanitized(%d) = %d, want %d", v.off, got, v.want)
+		}
+	}
+	// "b[?]" in the output is "b\xFF\xFE" in the input.
+	if start, end := m.OriginalSpan(4, 8); start != 4 || end != 7 {
+		t.Errorf("OriginalSpan(4, 8) = %d, %d, want 4, 7", start, end)
+	}
+	if start, end := m.OriginalRuneSpan(4, 8); start != 2 || end != 5 {
+		t.Errorf("OriginalRuneSpan(4, 8) = %d, %d, want 2, 5", start, end)
+	}
+	if got := m.ToOriginalRune(8); got != 5 {
+		t.Errorf("ToOriginalRune(8) = %d, want 5", got)
+	}
+	if got := m.ToSanitizedRune(5); got != 8 {
+		t.Errorf("ToSanitizedRune(5) = %d, want 8", got)
+	}
+}
+
+func TestToValidWithOffsets_Delete(t *testing.T) {
+	s := "人魚は髙﨑に"
+	got, m := ToValidWithOffsets(s, "")
+	if want := "人魚はに"; got != want {
+		t.Fatalf("ToValidWithOffsets() = %q, want %q", got, want)
+	}
+	// "は" keeps out of the deleted characters that follow it.
+	if start, end := m.OriginalSpan(6, 9); start != 6 || end != 9 {
+		t.Errorf("OriginalSpan(6, 9) = %d, %d, want 6, 9", start, end)
+	}
+	// "に" keeps out of the deleted characters that precede it.
+	if start, end := m.OriginalSpan(9, 12); start != 15 || end != 18 {
+		t.Errorf("OriginalSpan(9, 12) = %d, %d, want 15, 18", start, end)
+	}
+	if start, end := m.OriginalRuneSpan(3, 4); start != 5 || end != 6 {
+		t.Errorf("OriginalRuneSpan(3, 4) = %d, %d, want 5, 6", start, end)
+	}
+	if got := m.ToSanitized(12); got != 9 {
+		t.Errorf("ToSanitized(12) = %d, want 9", got)
+	}
+}
+
+func TestDiscriminator_ToValidWithOffsets(t *testing.T) {
+	d := NewDiscriminator(Allow('髙'), Disallow('魚'))
+	got, m := d.ToValidWithOffsets("人魚は髙", "□")
+	if want := "人□は髙"; got != want {
+		t.Fatalf("ToValidWithOffsets() = %q, want %q", got, want)
+	}
+	if len(m.Edits) != 1 {
+		t.Fatalf("edits = %+v, want 1 edit", m.Edits)
+	}
+	if got := m.ToOriginal(9); got != 9 {
+		t.Errorf("ToOriginal(9) = %d, want 9", got)
+	}
+}
+
+func TestToValidWithOffsets_Unchanged(t *testing.T) {
+	s := "人魚は"
+	got, m := ToValidWithOffsets(s, "□")
+	if got != s || len(m.Edits) != 0 {
+		t.Errorf("ToValidWithOffsets() = %q, %+v, want %q, no edits", got, m.Edits, s)
+	}
+	if got := m.ToOriginal(3); got != 3 {
+		t.Errorf("ToOriginal(3) = %d, want 3", got)
+	}
+}
@@ -0,0 +1,41 @@
+package qr
+
+import "strings"
+
+// Bits is a bit stream, most significant bit first.
+type Bits struct {
+	data []byte
+	n    int
+}
+
+// Append appends the n low bits of v.
+func (b *Bits) Append(v uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if b.n%8 == 0 {
+			b.data = append(b.data, 0)
+		}
+		if v>>i&1 == 1 {
+			b.data[b.n/8] |= 0x80 >> (b.n % 8)
+		}
+		b.n++
+	}
+}
+
+// Len returns the number of bits.
+func (b *Bits) Len() int {
+	return b.n
+}
+
+// Bytes returns the bits packed into bytes, padded with zero bits at the end.
+func (b *Bits) Bytes() []byte {
+	return b.data
+}
+
+// String returns the bits in 0 and 1.
+func (b *Bits) String() string {
+	var ret strings.Builder
+	for i := 0; i < b.n; i++ {
+		ret.WriteByte('0' + b.data[i/8]>>(7-i%8)&1)
+	}
+	return ret.String()
+}
@@ -0,0 +1,269 @@
+// Package qr implements the data encoding of QR Code with Kanji mode, which packs the Shift_JIS
+// code of a JIS X 0208 character into 13 bits.
+package qr
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// Mode is a mode of QR Code segments.
+type Mode int
+
+const (
+	// Numeric is the mode of digits 0-9, 10 bits per 3 digits.
+	Numeric Mode = iota + 1
+	// Alphanumeric is the mode of 0-9, A-Z, space and $%*+-./:, 11 bits per 2 characters.
+	Alphanumeric
+	// Byte is the mode of bytes, 8 bits per byte. Text is encoded in UTF-8, which Encode announces
+	// with ECI 26 before the first byte segment of non-ASCII text, since readers take the bytes
+	// for Shift_JIS or ISO-8859-1 by default.
+	Byte
+	// Kanji is the mode of the characters in JIS X 0208, 13 bits per character.
+	Kanji
+)
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case Numeric:
+		return "numeric"
+	case Alphanumeric:
+		return "alphanumeric"
+	case Byte:
+		return "byte"
+	case Kanji:
+		return "kanji"
+	}
+	return "unknown"
+}
+
+// indicator returns the mode indicator.
+func (m Mode) indicator() uint32 {
+	switch m {
+	case Numeric:
+		return 0b0001
+	case Alphanumeric:
+		return 0b0010
+	case Byte:
+		return 0b0100
+	}
+	return 0b1000
+}
+
+// countBits returns the number of bits of the character count indicator for the version.
+func (m Mode) countBits(version int) int {
+	var bits [3]int
+	switch m {
+	case Numeric:
+		bits = [3]int{10, 12, 14}
+	case Alphanumeric:
+		bits = [3]int{9, 11, 13}
+	case Byte:
+		bits = [3]int{8, 16, 16}
+	case Kanji:
+		bits = [3]int{8, 10, 12}
+	}
+	switch {
+	case version <= 9:
+		return bits[0]
+	case version <= 26:
+		return bits[1]
+	}
+	return bits[2]
+}
+
+// count returns the character count of s in the mode, which is the number of bytes for Byte mode.
+func (m Mode) count(s string) int {
+	if m == Byte {
+		return len(s)
+	}
+	return utf8.RuneCountInString(s)
+}
+
+// can reports whether the rune r can be encoded in the mode.
+func (m Mode) can(r rune) bool {
+	switch m {
+	case Numeric:
+		return r >= '0' && r <= '9'
+	case Alphanumeric:
+		return r < utf8.RuneSelf && strings.IndexByte(alphanumerics, byte(r)) >= 0
+	case Byte:
+		return true
+	case Kanji:
+		return IsKanji(r)
+	}
+	return false
+}
+
+const alphanumerics = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// IsKanji reports whether the rune r can be encoded in Kanji mode, that is, r is a double-byte
+// character in JIS X 0208, whose Shift_JIS code is in 0x8140-0x9FFC or 0xE040-0xEBBF.
+func IsKanji(r rune) bool {
+	_, ok := kanjiCode(r)
+	return ok
+}
+
+// CanKanji reports whether all the characters of s can be encoded in Kanji mode.
+func CanKanji(s string) bool {
+	for _, r := range s {
+		if !IsKanji(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// kanjiCode returns the 13-bit code of the rune r in Kanji mode.
+func kanjiCode(r rune) (uint32, bool) {
+	if r < utf8.RuneSelf {
+		return 0, false
+	}
+	k, ok := jisx0208.KutenOf(r)
+	if !ok {
+		return 0, false
+	}
+	c := uint32(k.ShiftJIS())
+	switch {
+	case c >= 0x8140 && c <= 0x9FFC:
+		c -= 0x8140
+	case c >= 0xE040 && c <= 0xEBBF:
+		c -= 0xC140
+	default:
+		return 0, false
+	}
+	return c>>8*0xC0 + c&0xFF, true
+}
+
+// Segment is a segment of QR Code data.
+type Segment struct {
+	Mode Mode
+	Text string
+}
+
+// Encode returns the bit stream of the segments for the version 1-40: the mode indicator,
+// the character count indicator and the data of each segment, preceded by the ECI header of UTF-8
+// if a byte segment has non-ASCII text. It does not add the terminator and the padding. It fails if a segment has a character that cannot be encoded in its mode,
+// or has more characters than the character count indicator allows.
+func Encode(segments []Segment, version int) (*Bits, error) {
+	if version < 1 || version > 40 {
+		return nil, fmt.Errorf("qr: invalid version %d", version)
+	}
+	var ret Bits
+	eci := -1
+	if i, ok := needsECI(segments); ok {
+		eci = i
+	}
+	for i, s := range segments {
+		if i == eci {
+			ret.Append(eciIndicator, 4)
+			ret.Append(eciUTF8, 8)
+		}
+		if err := s.appendBits(&ret, version); err != nil {
+			return nil, err
+		}
+	}
+	return &ret, nil
+}
+
+const (
+	// eciIndicator is the mode indicator of Extended Channel Interpretation.
+	eciIndicator = 0b0111
+	// eciUTF8 is the ECI designator of UTF-8, in 8 bits.
+	eciUTF8 = 26
+	// eciBits is the number of bits of the ECI header.
+	eciBits = 4 + 8
+)
+
+// needsECI returns the index of the first byte segment of non-ASCII text, whose bytes need
+// the ECI of UTF-8. The ECI stays in effect for the rest of the bit stream, and does not change
+// Kanji mode, which is always in Shift_JIS.
+func needsECI(segments []Segment) (int, bool) {
+	for i, s := range segments {
+		if s.Mode != Byte {
+			continue
+		}
+		for j := 0; j < len(s.Text); j++ {
+			if s.Text[j] >= utf8.RuneSelf {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func (s Segment) appendBits(b *Bits, version int) error {
+	for i, r := range s.Text {
+		if !s.Mode.can(r) {
+			return fmt.Errorf("qr: %U %q at offset %d cannot be encoded in %v mode", r, r, i, s.Mode)
+		}
+	}
+	n, bits := s.Mode.count(s.Text), s.Mode.countBits(version)
+	if n >= 1<<bits {
+		return fmt.Errorf("qr: %d characters exceed %v mode of version %d", n, s.Mode, version)
+	}
+	b.Append(s.Mode.indicator(), 4)
+	b.Append(uint32(n), bits)
+	switch s.Mode {
+	case Numeric:
+		for t := s.Text; len(t) > 0; {
+			k := 3
+			if len(t) < k {
+				k = len(t)
+			}
+			var v uint32
+			for _, c := range t[:k] {
+				v = v*10 + uint32(c-'0')
+			}
+			b.Append(v, k*3+1) // 10, 7 or 4 bits
+			t = t[k:]
+		}
+	case Alphanumeric:
+		for t := s.Text; len(t) > 0; {
+			if len(t) == 1 {
+				b.Append(uint32(strings.IndexByte(alphanumerics, t[0])), 6)
+				break
+			}
+			v := strings.IndexByte(alphanumerics, t[0])*45 + strings.IndexByte(alphanumerics, t[1])
+			b.Append(uint32(v), 11)
+			t = t[2:]
+		}
+	case Byte:
+		for i := 0; i < len(s.Text); i++ {
+			b.Append(uint32(s.Text[i]), 8)
+		}
+	case Kanji:
+		for _, r := range s.Text {
+			c, _ := kanjiCode(r)
+			b.Append(c, 13)
+		}
+	}
+	return nil
+}
+
+// BitLen returns the number of bits of the segments encoded for the version.
+func BitLen(segments []Segment, version int) int {
+	var ret int
+	if _, ok := needsECI(segments); ok {
+		ret += eciBits
+	}
+	for _, s := range segments {
+		ret += 4 + s.Mode.countBits(version)
+		n := s.Mode.count(s.Text)
+		switch s.Mode {
+		case Numeric:
+			ret += n/3*10 + [3]int{0, 4, 7}[n%3]
+		case Alphanumeric:
+			ret += n/2*11 + n%2*6
+		case Byte:
+			ret += n * 8
+		case Kanji:
+			ret += n * 13
+		}
+	}
+	return ret
+}
@@ -0,0 +1,406 @@
+package qr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+func TestIsKanji(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want bool
+	}{
+		{r: '点', want: true},  // 0x935F
+		{r: '茗', want: true},  // 0xE4AA
+		{r: '熙', want: true},  // 0xEAA4, the last of JIS X 0208
+		{r: '　', want: true},  // 0x8140
+		{r: 'A', want: false}, // ASCII
+		{r: 'ｱ', want: false}, // halfwidth katakana is single-byte
+		{r: '①', want: false}, // NEC special characters
+		{r: '髙', want: false}, // IBM extensions
+		{r: '😀', want: false},
+	}
+	for _, tt := range tests {
+		if got := IsKanji(tt.r); got != tt.want {
+			t.Errorf("IsKanji(%q) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestCanKanji(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{s: "東京都港区", want: true},
+		{s: "東京都港区1", want: false},
+		{s: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := CanKanji(tt.s); got != tt.want {
+			t.Errorf("CanKanji(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	// the examples of ISO/IEC 18004
+	tests := []struct {
+		name    string
+		segment Segment
+		want    string
+	}{
+		{
+			name:    "numeric",
+			segment: Segment{Mode: Numeric, Text: "01234567"},
+			want:    "0001 0000001000 0000001100 0101011001 1000011",
+		},
+		{
+			name:    "alphanumeric",
+			segment: Segment{Mode: Alphanumeric, Text: "AC-42"},
+			want:    "0010 000000101 00111001110 11100111001 000010",
+		},
+		{
+			name:    "byte",
+			segment: Segment{Mode: Byte, Text: "a"},
+			want:    "0100 00000001 01100001",
+		},
+		{
+			name:    "kanji",
+			segment: Segment{Mode: Kanji, Text: "点茗"},
+			want:    "1000 00000010 0110110011111 1101010101010",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode([]Segment{tt.segment}, 1)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := strings.ReplaceAll(tt.want, " ", "")
+			if got.String() != want {
+				t.Errorf("Encode() = %s, want %s", got, want)
+			}
+			if n := BitLen([]Segment{tt.segment}, 1); n != got.Len() {
+				t.Errorf("BitLen() = %d, want %d", n, got.Len())
+			}
+			if n := len(got.Bytes()); n != (got.Len()+7)/8 {
+				t.Errorf("len(Bytes()) = %d, want %d", n, (got.Len()+7)/8)
+			}
+		})
+	}
+}
+
+func TestEncode_ECI(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []Segment
+		want     string
+	}{
+		{
+			name:     "ASCII bytes",
+			segments: []Segment{{Mode: Byte, Text: "a"}, {Mode: Kanji, Text: "点"}},
+			want:     "0100 00000001 01100001 1000 00000001 0110110011111",
+		},
+		{
+			name:     "UTF-8 bytes",
+			segments: []Segment{{Mode: Kanji, Text: "点"}, {Mode: Byte, Text: "é"}, {Mode: Byte, Text: "é"}},
+			want:     "1000 00000001 0110110011111 0111 00011010 0100 00000010 11000011 10101001 0100 00000010 11000011 10101001",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.segments, 1)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := strings.ReplaceAll(tt.want, " ", ""); got.String() != want {
+				t.Errorf("Encode() = %s, want %s", got, want)
+			}
+			if n := BitLen(tt.segments, 1); n != got.Len() {
+				t.Errorf("BitLen() = %d, want %d", n, got.Len())
+			}
+		})
+	}
+}
+
+func TestEncode_Decode(t *testing.T) {
+	for _, s := range []string{
+		"髙橋太郎",
+		"abc漢def",
+		"東京都港区1-2-3 café",
+		"TEL:03-1234-5678 山田太郎",
+	} {
+		segments, err := Segments(s, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		b, err := Encode(segments, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := decode(t, b, 1); got != s {
+			t.Errorf("decode(Encode(%v)) = %q, want %q", segments, got, s)
+		}
+	}
+}
+
+// decode decodes the bit stream as a reader does: byte segments are in Shift_JIS unless
+// ECI 26 designates UTF-8.
+func decode(t *testing.T, b *Bits, version int) string {
+	t.Helper()
+	var (
+		ret strings.Builder
+		pos int
+		eci bool
+	)
+	read := func(n int) int {
+		if pos+n > b.Len() {
+			t.Fatalf("decode: %d bits at %d exceed %d bits", n, pos, b.Len())
+		}
+		v := 0
+		for i := 0; i < n; i++ {
+			v = v<<1 | int(b.Bytes()[(pos+i)/8]>>(7-(pos+i)%8)&1)
+		}
+		pos += n
+		return v
+	}
+	sjis := func(p []byte) string {
+		s, err := jisx0208.ShiftJIS.NewDecoder().Bytes(p)
+		if err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return string(s)
+	}
+	for pos < b.Len() {
+		var m Mode
+		switch read(4) {
+		case eciIndicator:
+			if v := read(8); v != eciUTF8 {
+				t.Fatalf("decode: ECI %d, want %d", v, eciUTF8)
+			}
+			eci = true
+			continue
+		case 0b0001:
+			m = Numeric
+		case 0b0010:
+			m = Alphanumeric
+		case 0b0100:
+			m = Byte
+		case 0b1000:
+			m = Kanji
+		default:
+			t.Fatalf("decode: unknown mode at %d", pos-4)
+		}
+		n := read(m.countBits(version))
+		switch m {
+		case Numeric:
+			for ; n > 0; n -= 3 {
+				k := 3
+				if n < k {
+					k = n
+				}
+				fmt.Fprintf(&ret, "%0*d", k, read(k*3+1))
+			}
+		case Alphanumeric:
+			for ; n > 1; n -= 2 {
+				v := read(11)
+				ret.WriteByte(alphanumerics[v/45])
+				ret.WriteByte(alphanumerics[v%45])
+			}
+			if n == 1 {
+				ret.WriteByte(alphanumerics[read(6)])
+			}
+		case Byte:
+			p := make([]byte, n)
+			for i := range p {
+				p[i] = byte(read(8))
+			}
+			if eci {
+				ret.Write(p)
+			} else {
+				ret.WriteString(sjis(p))
+			}
+		case Kanji:
+			for ; n > 0; n-- {
+				v := read(13)
+				c := v/0xC0<<8 | v%0xC0
+				if c += 0x8140; c > 0x9FFC {
+					c += 0xC140 - 0x8140
+				}
+				ret.WriteString(sjis([]byte{byte(c >> 8), byte(c)}))
+			}
+		}
+	}
+	return ret.String()
+}
+
+func TestEncode_CountBits(t *testing.T) {
+	tests := []struct {
+		version int
+		want    int
+	}{
+		{version: 1, want: 4 + 8 + 13},
+		{version: 9, want: 4 + 8 + 13},
+		{version: 10, want: 4 + 10 + 13},
+		{version: 26, want: 4 + 10 + 13},
+		{version: 27, want: 4 + 12 + 13},
+		{version: 40, want: 4 + 12 + 13},
+	}
+	for _, tt := range tests {
+		got, err := Encode([]Segment{{Mode: Kanji, Text: "漢"}}, tt.version)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Len() != tt.want {
+			t.Errorf("version %d: Len() = %d, want %d", tt.version, got.Len(), tt.want)
+		}
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []Segment
+		version  int
+	}{
+		{name: "invalid version", segments: []Segment{{Mode: Kanji, Text: "漢"}}, version: 41},
+		{name: "not kanji", segments: []Segment{{Mode: Kanji, Text: "漢a"}}, version: 1},
+		{name: "not numeric", segments: []Segment{{Mode: Numeric, Text: "1a"}}, version: 1},
+		{name: "lower case", segments: []Segment{{Mode: Alphanumeric, Text: "a"}}, version: 1},
+		{name: "too long", segments: []Segment{{Mode: Kanji, Text: strings.Repeat("漢", 256)}}, version: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Encode(tt.segments, tt.version); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		version int
+		want    []Segment
+	}{
+		{
+			name:    "empty",
+			s:       "",
+			version: 1,
+			want:    nil,
+		},
+		{
+			name:    "numeric",
+			s:       "0123456789",
+			version: 1,
+			want:    []Segment{{Mode: Numeric, Text: "0123456789"}},
+		},
+		{
+			name:    "alphanumeric",
+			s:       "HELLO WORLD",
+			version: 1,
+			want:    []Segment{{Mode: Alphanumeric, Text: "HELLO WORLD"}},
+		},
+		{
+			name:    "kanji",
+			s:       "東京都港区",
+			version: 1,
+			want:    []Segment{{Mode: Kanji, Text: "東京都港区"}},
+		},
+		{
+			name:    "kanji and alphanumeric",
+			s:       "東京都港区1-2-3",
+			version: 1,
+			want: []Segment{
+				{Mode: Kanji, Text: "東京都港区"},
+				{Mode: Alphanumeric, Text: "1-2-3"},
+			},
+		},
+		{
+			name:    "digits in bytes",
+			s:       "a0123456789b",
+			version: 1,
+			want: []Segment{
+				{Mode: Byte, Text: "a"},
+				{Mode: Numeric, Text: "0123456789"},
+				{Mode: Byte, Text: "b"},
+			},
+		},
+		{
+			name:    "short digits in bytes",
+			s:       "a1b",
+			version: 1,
+			want:    []Segment{{Mode: Byte, Text: "a1b"}},
+		},
+		{
+			name:    "a kanji in bytes",
+			s:       "abc漢def",
+			version: 1,
+			want:    []Segment{{Mode: Byte, Text: "abc漢def"}},
+		},
+		{
+			name:    "out of JIS X 0208",
+			s:       "髙橋太郎",
+			version: 1,
+			want: []Segment{
+				{Mode: Byte, Text: "髙"},
+				{Mode: Kanji, Text: "橋太郎"},
+			},
+		},
+		{
+			name:    "split",
+			s:       strings.Repeat("あ", 300),
+			version: 1,
+			want: []Segment{
+				{Mode: Kanji, Text: strings.Repeat("あ", 255)},
+				{Mode: Kanji, Text: strings.Repeat("あ", 45)},
+			},
+		},
+		{
+			name:    "not split",
+			s:       strings.Repeat("あ", 300),
+			version: 10,
+			want:    []Segment{{Mode: Kanji, Text: strings.Repeat("あ", 300)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Segments(tt.s, tt.version)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %v, want %v", got, tt.want)
+			}
+			if _, err := Encode(got, tt.version); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSegments_Optimal(t *testing.T) {
+	inputs := []string{
+		"東京都港区1-2-3",
+		"TEL:03-1234-5678 山田太郎",
+		"https://example.com/商品?id=12345",
+		"ＡＢＣ123abc漢字",
+	}
+	for _, s := range inputs {
+		got, err := Segments(s, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		n := BitLen(got, 1)
+		if b := BitLen([]Segment{{Mode: Byte, Text: s}}, 1); n > b {
+			t.Errorf("Segments(%q) = %d bits, longer than %d bits in byte mode", s, n, b)
+		}
+	}
+}
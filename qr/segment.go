@@ -0,0 +1,120 @@
+package qr
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+var modes = [...]Mode{Numeric, Alphanumeric, Byte, Kanji}
+
+// Segments returns the segmentation of s into numeric, alphanumeric, byte and kanji segments
+// that minimizes the length of the bit stream for the version 1-40. The length of a segment is
+// estimated by the fractional bits per character, e.g. 10/3 bits for a digit, so that the choice
+// of each character is made by dynamic programming over the modes. Segments longer than the
+// character count indicator allows are split. The ECI header that Encode adds for byte segments
+// of non-ASCII text is not counted in the estimate.
+func Segments(s string, version int) ([]Segment, error) {
+	if version < 1 || version > 40 {
+		return nil, fmt.Errorf("qr: invalid version %d", version)
+	}
+	if s == "" {
+		return nil, nil
+	}
+	// costs are in sixths of a bit, so that the bits per character are integers
+	var header [len(modes)]int
+	for i, m := range modes {
+		header[i] = (4 + m.countBits(version)) * 6
+	}
+	const inf = int(^uint(0) >> 2)
+	var (
+		runes = []rune(s)
+		cost  [len(modes)]int // of the prefix ending in each mode
+		from  = make([][len(modes)]int, len(runes))
+	)
+	for i := range cost {
+		cost[i] = inf
+	}
+	for i, r := range runes {
+		var next [len(modes)]int
+		for j, m := range modes {
+			next[j] = inf
+			if !m.can(r) {
+				continue
+			}
+			if i == 0 {
+				next[j], from[i][j] = header[j], j
+			}
+			for k := range modes {
+				c := cost[k]
+				if i == 0 || c == inf {
+					continue
+				}
+				if k != j {
+					c += header[j]
+				}
+				if c < next[j] {
+					next[j], from[i][j] = c, k
+				}
+			}
+			next[j] += charCost(m, r)
+		}
+		cost = next
+	}
+	end := 0
+	for j := range cost {
+		if cost[j] < cost[end] {
+			end = j
+		}
+	}
+	// backtrack the modes of the characters
+	chars := make([]Mode, len(runes))
+	for i, j := len(runes)-1, end; i >= 0; i-- {
+		chars[i] = modes[j]
+		j = from[i][j]
+	}
+	var ret []Segment
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if chars[i] != chars[i-1] {
+			ret = appendSegment(ret, chars[i-1], string(runes[start:i]), version)
+			start = i
+		}
+	}
+	return appendSegment(ret, chars[len(runes)-1], string(runes[start:]), version), nil
+}
+
+// charCost returns the bits of the rune r in the mode, in sixths of a bit.
+func charCost(m Mode, r rune) int {
+	switch m {
+	case Numeric:
+		return 20
+	case Alphanumeric:
+		return 33
+	case Byte:
+		return 48 * utf8.RuneLen(r)
+	}
+	return 78
+}
+
+// appendSegment appends the segment of the text in the mode, split by the maximum character count.
+func appendSegment(segments []Segment, m Mode, text string, version int) []Segment {
+	max := 1<<m.countBits(version) - 1
+	for text != "" {
+		n, i := 0, 0
+		for i < len(text) {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			if m == Byte {
+				n += size
+			} else {
+				n++
+			}
+			if n > max {
+				break
+			}
+			i += size
+		}
+		segments = append(segments, Segment{Mode: m, Text: text[:i]})
+		text = text[i:]
+	}
+	return segments
+}
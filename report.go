@@ -0,0 +1,91 @@
+package jisx0208
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Report is the statistics of the replacement made by ToValidWithReport.
+type Report struct {
+	// Replaced is the number of replaced runes, not including invalid UTF-8 byte sequences.
+	Replaced int
+	// Runes is the histogram of the replaced runes.
+	Runes map[rune]int
+	// InvalidUTF8 is the number of runs of invalid UTF-8 bytes, each of which is replaced once.
+	InvalidUTF8 int
+	// Changed reports whether the output differs from the input.
+	Changed bool
+}
+
+// ToValidWithReport returns the same string as ToValid together with a report of the replacement.
+func ToValidWithReport(s, replacement string) (string, Report) {
+	return toValidWithReport(s, replacement, Is)
+}
+
+// ToValidWithReport returns the same string as ToValid together with a report of the replacement.
+func (d *Discriminator) ToValidWithReport(s, replacement string) (string, Report) {
+	return toValidWithReport(s, replacement, d.Is)
+}
+
+func toValidWithReport(s, replacement string, is func(rune) bool) (string, Report) {
+	var (
+		b    strings.Builder
+		rep  Report
+		last int
+	)
+	scanInvalid(s, is, func(start, end int, r rune) {
+		if b.Cap() == 0 {
+			b.Grow(len(s) + len(replacement))
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(replacement)
+		last = end
+		if r == runeInvalidUTF8 {
+			rep.InvalidUTF8++
+			return
+		}
+		if rep.Runes == nil {
+			rep.Runes = map[rune]int{}
+		}
+		rep.Replaced++
+		rep.Runes[r]++
+	})
+	if b.Cap() == 0 {
+		return s, rep
+	}
+	b.WriteString(s[last:])
+	ret := b.String()
+	rep.Changed = ret != s
+	return ret, rep
+}
+
+// runeInvalidUTF8 is the rune that scanInvalid passes for a run of invalid UTF-8 bytes.
+const runeInvalidUTF8 = -1
+
+// scanInvalid calls f with the byte range of each part of s that toValid replaces:
+// a rune r that is not accepted by is, or a run of invalid UTF-8 bytes,
+// in which case r is runeInvalidUTF8. As in toValid, ASCII bytes are never replaced.
+func scanInvalid(s string, is func(rune) bool, f func(start, end int, r rune)) {
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, wid := utf8.DecodeRuneInString(s[i:])
+		if wid != 1 {
+			if !is(r) {
+				f(i, i+wid, r)
+			}
+			i += wid
+			continue
+		}
+		start := i
+		for i < len(s) && s[i] >= utf8.RuneSelf {
+			if _, wid := utf8.DecodeRuneInString(s[i:]); wid != 1 {
+				break
+			}
+			i++
+		}
+		f(start, i, runeInvalidUTF8)
+	}
+}
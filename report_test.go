@@ -0,0 +1,87 @@
+package jisx0208
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToValidWithReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		replacement string
+		want        string
+		report      Report
+	}{
+		{
+			name:        "unchanged",
+			s:           "人魚は、南の方の海にばかり棲んでいるのではありません。\n",
+			replacement: "□",
+			want:        "人魚は、南の方の海にばかり棲んでいるのではありません。\n",
+			report:      Report{},
+		},
+		{
+			name:        "replace",
+			s:           "髙﨑は\xFF\xFE髙い",
+			replacement: "□",
+			want:        "□□は□□い",
+			report: Report{
+				Replaced:    3,
+				Runes:       map[rune]int{'髙': 2, '﨑': 1},
+				InvalidUTF8: 1,
+				Changed:     true,
+			},
+		},
+		{
+			name:        "invalid utf8 runs",
+			s:           "\xFFa\xFF人\xFF",
+			replacement: "",
+			want:        "a人",
+			report: Report{
+				InvalidUTF8: 3,
+				Changed:     true,
+			},
+		},
+		{
+			name:        "replaced with itself",
+			s:           "髙",
+			replacement: "髙",
+			want:        "髙",
+			report: Report{
+				Replaced: 1,
+				Runes:    map[rune]int{'髙': 1},
+				Changed:  false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rep := ToValidWithReport(tt.s, tt.replacement)
+			if got != tt.want {
+				t.Errorf("ToValidWithReport() = %v, want %v", got, tt.want)
+			}
+			if want := ToValid(tt.s, tt.replacement); got != want {
+				t.Errorf("ToValidWithReport() = %v, ToValid() = %v", got, want)
+			}
+			if !reflect.DeepEqual(rep, tt.report) {
+				t.Errorf("report = %+v, want %+v", rep, tt.report)
+			}
+		})
+	}
+}
+
+func TestDiscriminator_ToValidWithReport(t *testing.T) {
+	d := NewDiscriminator(Allow('髙'), Disallow('魚'))
+	got, rep := d.ToValidWithReport("人魚は髙﨑", "□")
+	if want := "人□は髙□"; got != want {
+		t.Errorf("ToValidWithReport() = %v, want %v", got, want)
+	}
+	want := Report{
+		Replaced: 2,
+		Runes:    map[rune]int{'魚': 1, '﨑': 1},
+		Changed:  true,
+	}
+	if !reflect.DeepEqual(rep, want) {
+		t.Errorf("report = %+v, want %+v", rep, want)
+	}
+}
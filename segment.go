@@ -0,0 +1,85 @@
+package jisx0208
+
+// SegmentKind is the kind of a segment.
+type SegmentKind int
+
+const (
+	// SegmentValid is a span of valid characters.
+	SegmentValid SegmentKind = iota
+	// SegmentOutOfSet is a span of characters that are not in JIS X 0208.
+	SegmentOutOfSet
+	// SegmentDisallowed is a span of JIS X 0208 characters that are disallowed by the discriminator.
+	SegmentDisallowed
+	// SegmentInvalidUTF8 is a span of invalid UTF-8 bytes.
+	SegmentInvalidUTF8
+)
+
+// String returns the name of the segment kind.
+func (k SegmentKind) String() string {
+	switch k {
+	case SegmentValid:
+		return "valid"
+	case SegmentOutOfSet:
+		return "out-of-set"
+	case SegmentDisallowed:
+		return "disallowed"
+	case SegmentInvalidUTF8:
+		return "invalid-utf8"
+	}
+	return "unknown"
+}
+
+// Segment is a contiguous span of the same kind, in byte offsets. End is exclusive.
+type Segment struct {
+	Kind  SegmentKind
+	Start int
+	End   int
+}
+
+// Segments splits the string s into contiguous spans of valid characters,
+// characters not in JIS X 0208 and invalid UTF-8 bytes.
+func Segments(s string) []Segment {
+	return segments(s, Is, func(rune) SegmentKind {
+		return SegmentOutOfSet
+	})
+}
+
+// Segments splits the string s into contiguous spans of valid characters, characters not in JIS X 0208,
+// JIS X 0208 characters disallowed by the discriminator and invalid UTF-8 bytes.
+func (d *Discriminator) Segments(s string) []Segment {
+	return segments(s, d.Is, func(r rune) SegmentKind {
+		if Is(r) {
+			return SegmentDisallowed
+		}
+		return SegmentOutOfSet
+	})
+}
+
+func segments(s string, is func(rune) bool, kind func(rune) SegmentKind) []Segment {
+	var (
+		ret  []Segment
+		last int
+	)
+	add := func(k SegmentKind, start, end int) {
+		if n := len(ret); n > 0 && ret[n-1].Kind == k && ret[n-1].End == start {
+			ret[n-1].End = end
+			return
+		}
+		ret = append(ret, Segment{Kind: k, Start: start, End: end})
+	}
+	scanInvalid(s, is, func(start, end int, r rune) {
+		if last < start {
+			add(SegmentValid, last, start)
+		}
+		if r == runeInvalidUTF8 {
+			add(SegmentInvalidUTF8, start, end)
+		} else {
+			add(kind(r), start, end)
+		}
+		last = end
+	})
+	if last < len(s) {
+		add(SegmentValid, last, len(s))
+	}
+	return ret
+}
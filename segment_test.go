@@ -0,0 +1,70 @@
+package jisx0208
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []Segment
+	}{
+		{
+			name: "empty",
+			s:    "",
+			want: nil,
+		},
+		{
+			name: "valid",
+			s:    "人魚は",
+			want: []Segment{
+				{Kind: SegmentValid, Start: 0, End: 9},
+			},
+		},
+		{
+			name: "mixed",
+			s:    "髙﨑は\xFF\xFE🙅",
+			want: []Segment{
+				{Kind: SegmentOutOfSet, Start: 0, End: 6},
+				{Kind: SegmentValid, Start: 6, End: 9},
+				{Kind: SegmentInvalidUTF8, Start: 9, End: 11},
+				{Kind: SegmentOutOfSet, Start: 11, End: 15},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Segments(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiscriminator_Segments(t *testing.T) {
+	d := NewDiscriminator(Allow('髙'), Disallow('魚', 'は'))
+	want := []Segment{
+		{Kind: SegmentValid, Start: 0, End: 3},
+		{Kind: SegmentDisallowed, Start: 3, End: 9},
+		{Kind: SegmentOutOfSet, Start: 9, End: 12},
+		{Kind: SegmentValid, Start: 12, End: 15},
+	}
+	if got := d.Segments("人魚は﨑髙"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segments() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSegmentKind_String(t *testing.T) {
+	for k, want := range map[SegmentKind]string{
+		SegmentValid:       "valid",
+		SegmentOutOfSet:    "out-of-set",
+		SegmentDisallowed:  "disallowed",
+		SegmentInvalidUTF8: "invalid-utf8",
+	} {
+		if got := k.String(); got != want {
+			t.Errorf("String() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+module makecp932
+
+go 1.19
+
+replace github.com/ikawaha/jisx0208 => ./../..
+
+require (
+	github.com/ikawaha/jisx0208 v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.14.0
+)
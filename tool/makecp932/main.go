@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := MakeCP932Table(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "CP932 table construction failed: %v\n", err)
+		os.Exit(1)
+	}
+}
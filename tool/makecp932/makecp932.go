@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/ikawaha/jisx0208"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// CodeRange is a range of Shift_JIS codes.
+type CodeRange struct {
+	Start uint16
+	End   uint16
+}
+
+// CP932 vendor extensions, in order of preference for duplicated characters.
+var (
+	// NECSpecial is the NEC special characters (13区).
+	NECSpecial = CodeRange{Start: 0x8740, End: 0x879C}
+	// IBMExtension is the IBM extended characters (115〜119区).
+	IBMExtension = CodeRange{Start: 0xFA40, End: 0xFC4B}
+	// NECSelectedIBMExtension is the NEC selected IBM extended characters (89〜92区).
+	NECSelectedIBMExtension = CodeRange{Start: 0xED40, End: 0xEEFC}
+)
+
+// Char is a CP932 extended character.
+type Char struct {
+	Rune rune
+	SJIS uint16
+}
+
+// Chars returns the extended characters that are not in JIS X 0208, sorted by rune.
+func Chars() ([]Char, error) {
+	var ret []Char
+	seen := map[rune]bool{}
+	dec := japanese.ShiftJIS.NewDecoder()
+	for _, v := range []CodeRange{NECSpecial, IBMExtension, NECSelectedIBMExtension} {
+		for c := v.Start; c <= v.End; c++ {
+			if c&0xFF < 0x40 || c&0xFF == 0x7F || c&0xFF > 0xFC {
+				continue
+			}
+			b, err := dec.Bytes([]byte{byte(c >> 8), byte(c)})
+			if err != nil {
+				return nil, err
+			}
+			r, _ := utf8.DecodeRune(b)
+			if r == utf8.RuneError || jisx0208.Is(r) || seen[r] {
+				continue
+			}
+			seen[r] = true
+			ret = append(ret, Char{Rune: r, SJIS: c})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Rune < ret[j].Rune
+	})
+	return ret, nil
+}
+
+// MakeCP932Table writes out the CP932 extension table in Go source code format.
+func MakeCP932Table(w io.Writer) error {
+	chars, err := Chars()
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by tool/makecp932; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package jisx0208")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// cp932Table is the CP932 vendor extended characters that are not in JIS X 0208, sorted by rune.")
+	fmt.Fprintln(&b, "var cp932Table = []cp932Char{")
+	for _, v := range chars {
+		fmt.Fprintf(&b, "\t{r: 0x%04X, sjis: 0x%04X}, // %c\n", v.Rune, v.SJIS, v.Rune)
+	}
+	fmt.Fprintln(&b, "}")
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	src := flag.String("src", jisx0213URL, "JIS X 0213:2004 code table (path or URL)")
+	flag.Parse()
+	if err := MakeJISX0213Table(os.Stdout, *src); err != nil {
+		fmt.Fprintf(os.Stderr, "JIS X 0213 table construction failed: %v\n", err)
+		os.Exit(1)
+	}
+}
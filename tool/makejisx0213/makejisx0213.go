@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ikawaha/jisx0208"
+)
+
+// jisx0213URL is the code table published by the JIS X 0213 project.
+// Each line has the form "3-2121<TAB>U+3000<TAB># comment", where the
+// prefix 3 means plane 1 and 4 means plane 2.
+const jisx0213URL = "https://x0213.org/codetable/jisx0213-2004-std.txt"
+
+// Char is a JIS X 0213 character.
+type Char struct {
+	Rune rune
+	Men  int
+	Ku   int
+	Ten  int
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(path, "https://") {
+		return os.Open(path)
+	}
+	res, err := http.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// ReadTable reads the JIS X 0213 code table and returns the characters
+// that are not in JIS X 0208. Code points mapped to a sequence of runes are skipped.
+func ReadTable(r io.Reader) ([]Char, error) {
+	var ret []Char
+	seen := map[rune]bool{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.HasPrefix(fields[1], "U+") || strings.Contains(fields[1], "+U") {
+			continue
+		}
+		code := strings.SplitN(fields[0], "-", 2)
+		if len(code) != 2 {
+			return nil, fmt.Errorf("invalid code: %q", line)
+		}
+		men := 1
+		if code[0] == "4" {
+			men = 2
+		}
+		jis, err := strconv.ParseUint(code[1], 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %q, %w", line, err)
+		}
+		cp, err := strconv.ParseUint(fields[1][2:], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code point: %q, %w", line, err)
+		}
+		r := rune(cp)
+		if jisx0208.Is(r) || seen[r] {
+			continue
+		}
+		seen[r] = true
+		ret = append(ret, Char{
+			Rune: r,
+			Men:  men,
+			Ku:   int(jis>>8) - 0x20,
+			Ten:  int(jis&0xFF) - 0x20,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Rune < ret[j].Rune
+	})
+	return ret, nil
+}
+
+// MakeJISX0213Table writes out the JIS X 0213 table in Go source code format.
+func MakeJISX0213Table(w io.Writer, src string) error {
+	r, err := open(src)
+	if err != nil {
+		return fmt.Errorf("cannot open table src: %w", err)
+	}
+	defer r.Close()
+	chars, err := ReadTable(r)
+	if err != nil {
+		return fmt.Errorf("invalid table src: %w", err)
+	}
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by tool/makejisx0213; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package jisx0208")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// jisx0213Table is the JIS X 0213:2004 characters that are not in JIS X 0208, sorted by rune.")
+	fmt.Fprintln(&b, "var jisx0213Table = []jisx0213Char{")
+	for _, v := range chars {
+		fmt.Fprintf(&b, "\t{r: 0x%04X, men: %d, ku: %d, ten: %d},", v.Rune, v.Men, v.Ku, v.Ten)
+		if unicode.IsGraphic(v.Rune) && !unicode.In(v.Rune, unicode.M, unicode.Zs) {
+			fmt.Fprintf(&b, " // %c", v.Rune)
+		}
+		fmt.Fprintln(&b)
+	}
+	fmt.Fprintln(&b, "}")
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
@@ -0,0 +1,10 @@
+module makekuten
+
+go 1.19
+
+require github.com/PuerkitoBio/goquery v1.7.0
+
+require (
+	github.com/andybalholm/cascadia v1.1.0 // indirect
+	golang.org/x/net v0.0.0-20200202094626-16171245cfb2 // indirect
+)
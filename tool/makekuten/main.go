@@ -0,0 +1,16 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	src := flag.String("src", jisx0208htmlURL, "JIS X 0208 code table (path or URL)")
+	flag.Parse()
+	if err := MakeKutenTable(os.Stdout, *src); err != nil {
+		fmt.Fprintf(os.Stderr, "kuten table construction failed: %v\n", err)
+		os.Exit(1)
+	}
+}
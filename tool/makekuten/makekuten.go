@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	jisx0208htmlURL = "https://www.asahi-net.or.jp/~ax2s-kmtn/ref/jisx0208.html"
+)
+
+// Table is the JIS X 0208 code table indexed by ku-1 and ten-1.
+type Table struct {
+	Runes [94][94]rune
+	// Assigned is the editions in which the characters were assigned to their code points,
+	// added or moved, after JIS C 6226-1978.
+	Assigned map[rune]int
+	// GlyphChanges is the latest editions in which the glyphs of the characters were changed.
+	GlyphChanges map[rune]int
+}
+
+// editions is the editions of the class names of the cells, in which the cells were changed.
+var editions = map[string][]int{
+	"cha_jis83":   {1983},
+	"cha_jis90":   {1990},
+	"cha_jis8390": {1983, 1990},
+}
+
+// moved1983 is the kanji that JIS C 6226-1983 moved to other code points: the 22 pairs swapped
+// between level 1 and level 2, and the four simplified forms that took the code points of
+// the traditional forms moved to row 84. The reference table marks them in the same way as
+// glyph changes.
+var moved1983 = []rune("鯵鰺鴬鶯蛎蠣撹攪竃竈潅灌諌諫頚頸砿礦蕊蘂靭靱賎賤壷壺砺礪梼檮涛濤迩邇蝿蠅桧檜侭儘薮藪篭籠尭槙遥瑶")
+
+// assigned reports whether the change of the cell in the edition is an assignment of the character
+// to the code point: an addition in the non-kanji rows or row 84, or a move of a kanji.
+func assigned(r rune, ku, edition int) bool {
+	if ku <= 8 || ku == 84 {
+		return true
+	}
+	if edition != 1983 {
+		return false
+	}
+	for _, v := range moved1983 {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(path, "https://") {
+		return os.Open(path)
+	}
+	res, err := http.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// ReadTable reads the code table from the HTML. Each row of the table has the headers:
+// offset, JIS code, Shift_JIS code and EUC-JP code of the first cell, followed by 16 cells.
+// The first row of each ku has the ku header in addition.
+func ReadTable(r io.Reader) (*Table, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document src: %w", err)
+	}
+	var (
+		ret  = Table{Assigned: map[rune]int{}, GlyphChanges: map[rune]int{}}
+		errs []string
+	)
+	doc.Find("table.basic tr").Each(func(_ int, s *goquery.Selection) {
+		th := s.Find("th.v")
+		if th.Length() != 4 && th.Length() != 5 {
+			return
+		}
+		jis, err := strconv.ParseUint(strings.TrimSpace(th.Eq(th.Length()-3).Text()), 16, 16)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return
+		}
+		s.Find("td").Each(func(i int, s *goquery.Selection) {
+			class := s.AttrOr("class", "")
+			if !strings.HasPrefix(class, "cha") || s.Text() == "" {
+				return
+			}
+			r, _ := utf8.DecodeRuneInString(s.Text())
+			code := int(jis) + i
+			ku, ten := code>>8-0x20, code&0xFF-0x20
+			if ku < 1 || ku > 94 || ten < 1 || ten > 94 {
+				errs = append(errs, fmt.Sprintf("invalid code: %X, %c", code, r))
+				return
+			}
+			ret.Runes[ku-1][ten-1] = r
+			for _, v := range editions[class] {
+				if assigned(r, ku, v) {
+					ret.Assigned[r] = v
+				} else {
+					ret.GlyphChanges[r] = v
+				}
+			}
+		})
+	})
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return &ret, nil
+}
+
+// MakeKutenTable writes out the kuten table in Go source code format.
+func MakeKutenTable(w io.Writer, src string) error {
+	r, err := open(src)
+	if err != nil {
+		return fmt.Errorf("cannot open table src: %w", err)
+	}
+	defer r.Close()
+	table, err := ReadTable(r)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by tool/makekuten; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package jisx0208")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// kutenTable is the JIS X 0208 code table indexed by ku-1 and ten-1. Unassigned code points are 0.")
+	fmt.Fprintln(&b, "var kutenTable = [94][94]rune{")
+	for i, row := range table.Runes {
+		fmt.Fprintf(&b, "\t{ // %d区\n", i+1)
+		for j, r := range row {
+			if j%10 == 0 {
+				fmt.Fprint(&b, "\t\t")
+			}
+			if r == 0 {
+				fmt.Fprint(&b, "0,")
+			} else {
+				fmt.Fprintf(&b, "%q,", r)
+			}
+			if j%10 == 9 || j == len(row)-1 {
+				fmt.Fprintln(&b)
+			} else {
+				fmt.Fprint(&b, " ")
+			}
+		}
+		fmt.Fprintln(&b, "\t},")
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// editionTable is the editions in which the characters were assigned to their code points,")
+	fmt.Fprintln(&b, "// added or moved, after JIS C 6226-1978.")
+	writeEditions(&b, "editionTable", table.Runes, table.Assigned)
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// glyphChangeTable is the latest editions in which the glyphs of the characters were changed.")
+	writeEditions(&b, "glyphChangeTable", table.Runes, table.GlyphChanges)
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// writeEditions writes out the map of the editions of the characters in the order of the code table.
+func writeEditions(b *bytes.Buffer, name string, runes [94][94]rune, editions map[rune]int) {
+	fmt.Fprintf(b, "var %s = map[rune]Edition{\n", name)
+	for _, row := range runes {
+		for _, r := range row {
+			if v, ok := editions[r]; ok {
+				fmt.Fprintf(b, "\t%q: Edition%d,\n", r, v)
+			}
+		}
+	}
+	fmt.Fprintln(b, "}")
+}
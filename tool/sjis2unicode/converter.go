@@ -862,6 +862,24 @@ func NewJIS0208RuneMapper() (*RuneMapper, error) {
 
 }
 
+// NewJIS0208TrailByteRuneMapper returns the mapper of the double-byte characters whose second byte
+// is one of the trail bytes, e.g. 0x5C for ソ (0x835C).
+func NewJIS0208TrailByteRuneMapper(trail ...byte) (*RuneMapper, error) {
+	ret := RuneMapper{}
+	for _, v := range JISX0208SJIS {
+		for i := v.Start; i <= v.End; i++ {
+			lower := byte(i & 0xFF)
+			if bytes.IndexByte(trail, lower) < 0 {
+				continue
+			}
+			if err := ret.AddCode(SJISCode{byte(i >> 8), lower}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &ret, nil
+}
+
 func NewJIS0208Level1RuneMapper() (*RuneMapper, error) {
 	ret := RuneMapper{}
 	for _, v := range JISX0208SJISLevel1 {
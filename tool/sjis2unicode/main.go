@@ -1,38 +1,56 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 )
 
 func main() {
-	mapper, err := NewJIS0208RuneMapper()
-	//mapper, err := NewJIS0208Level1RuneMapper()
-	//mapper, err := NewJIS0208Level2RuneMapper()
+	table := flag.String("table", "jisx0208", "table to dump: jisx0208, level1, level2 or damemoji")
+	name := flag.String("name", "RangeTable", "variable name of the table")
+	pkg := flag.String("package", "", "write a complete source file of the package")
+	doc := flag.String("doc", "", "doc comment of the table")
+	flag.Parse()
+
+	var (
+		mapper *RuneMapper
+		err    error
+	)
+	switch *table {
+	case "jisx0208":
+		mapper, err = NewJIS0208RuneMapper()
+	case "level1":
+		mapper, err = NewJIS0208Level1RuneMapper()
+	case "level2":
+		mapper, err = NewJIS0208Level2RuneMapper()
+	case "damemoji":
+		mapper, err = NewJIS0208TrailByteRuneMapper(0x5C, 0x7C)
+	default:
+		err = fmt.Errorf("unknown table: %s", *table)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "sjis to unicode mapping construction failed: %v", err)
 		os.Exit(1)
 	}
-	for k, v := range *mapper {
-		if len(v) > 1 {
-			fmt.Printf("%#0X(%d): %c\n", k, k, k)
-			for _, vv := range v {
-				fmt.Printf("%v\n", vv)
+	if *table == "jisx0208" {
+		for k, v := range *mapper {
+			if len(v) > 1 {
+				fmt.Fprintf(os.Stderr, "%#0X(%d): %c\n", k, k, k)
+				for _, vv := range v {
+					fmt.Fprintf(os.Stderr, "%v\n", vv)
+				}
 			}
 		}
 	}
 
-	//<---
-	//r := bufio.NewReader(transform.NewReader(bytes.NewReader(sjis.SJISCode{0x81, 0x80}), japanese.ShiftJIS.NewDecoder()))
-	//c, _, err := r.ReadRune()
-	//if err != nil {
-	//	panic(err)
-	//}
-	//fmt.Printf("!!!%c\n", c)
-	//<---
-
+	if *pkg != "" {
+		fmt.Printf("// Code generated by tool/sjis2unicode -table %s; DO NOT EDIT.\n\n", *table)
+		fmt.Printf("package %s\n\nimport \"unicode\"\n\n", *pkg)
+	}
+	if *doc != "" {
+		fmt.Printf("// %s\n", *doc)
+	}
 	runes := mapper.Runes()
-	//fmt.Printf("runes: %d\n", len(runes))
-	table := RangeTable(runes)
-	DumpRangeTable(os.Stdout, table)
+	DumpRangeTable(os.Stdout, *name, RangeTable(runes))
 }
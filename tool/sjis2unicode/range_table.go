@@ -46,18 +46,18 @@ func RangeTable(runes []rune) *unicode.RangeTable {
 	return &ret
 }
 
-// DumpRangeTable write out the range table in Go source code format.
-func DumpRangeTable(w io.Writer, table *unicode.RangeTable) {
-	fmt.Fprintln(w, "var RangeTable = &unicode.RangeTable{")
+// DumpRangeTable write out the range table of the name in Go source code format.
+func DumpRangeTable(w io.Writer, name string, table *unicode.RangeTable) {
+	fmt.Fprintf(w, "var %s = &unicode.RangeTable{\n", name)
 	if len(table.R16) > 0 {
-		fmt.Println("\tR16: []unicode.Range16{")
+		fmt.Fprintln(w, "\tR16: []unicode.Range16{")
 		for _, v := range table.R16 {
 			fmt.Fprintf(w, "\t\t{Lo: 0x%X, Hi: 0x%X, Stride: 1},\n", v.Lo, v.Hi)
 		}
 		fmt.Fprintln(w, "\t},")
 	}
 	if len(table.R32) > 0 {
-		fmt.Println("\tR32: []unicode.Range32{")
+		fmt.Fprintln(w, "\tR32: []unicode.Range32{")
 		for _, v := range table.R32 {
 			fmt.Fprintf(w, "\t\t{Lo: 0x%X, Hi: 0x%X, Stride: 1},\n", v.Lo, v.Hi)
 		}